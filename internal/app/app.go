@@ -34,8 +34,10 @@ type App struct {
 	logger     *zap.Logger
 	container  *container.Container
 	db         *sql.DB
+	watcher    *casbinPkg.Watcher
 	grpcServer *grpc.Server
 	httpServer *http.Server
+	stopReaper context.CancelFunc
 }
 
 // New creates a new App instance
@@ -80,13 +82,26 @@ func (a *App) Initialize() error {
 		a.db = db
 
 		// Initialize Casbin enforcer
-		casbinEnforcer, err := casbinPkg.NewEnforcer(db, "configs/rbac_model.conf", a.logger)
+		casbinEnforcer, err := casbinPkg.NewEnforcer(db, a.config.Casbin.ModelPath, a.logger)
 		if err != nil {
 			return fmt.Errorf("failed to initialize Casbin enforcer: %w", err)
 		}
 
 		a.logger.Info("Casbin enforcer initialized successfully")
 
+		// Keep policies in sync with other instances
+		if a.config.Casbin.WatcherEnabled {
+			watcher, err := casbinPkg.NewWatcher(db, a.config.Database.GetDSN(), a.logger)
+			if err != nil {
+				return fmt.Errorf("failed to start Casbin watcher: %w", err)
+			}
+			a.watcher = watcher
+
+			if err := casbinEnforcer.EnableWatcher(watcher); err != nil {
+				return fmt.Errorf("failed to enable Casbin watcher: %w", err)
+			}
+		}
+
 		// Create dependency container
 		c, err := container.NewContainer(a.config, db, a.logger, casbinEnforcer)
 		if err != nil {
@@ -94,6 +109,11 @@ func (a *App) Initialize() error {
 		}
 		a.container = c
 
+		// Project roles that exist without Casbin rules, e.g. on a freshly migrated database
+		if err := c.Services.Authorization.ProjectExistingRoles(context.Background()); err != nil {
+			return fmt.Errorf("failed to project existing roles into Casbin: %w", err)
+		}
+
 		// Setup gRPC server with panic recovery interceptors
 		a.grpcServer = grpc.NewServer(
 			grpc.ChainUnaryInterceptor(
@@ -129,6 +149,16 @@ func (a *App) Run() error {
 		}
 	})
 
+	// Remove role assignments and pending access requests as they expire
+	reaperCtx, stopReaper := context.WithCancel(context.Background())
+	a.stopReaper = stopReaper
+	middleware.RecoverGoroutine(a.logger, "assignment-reaper", func() {
+		a.container.Services.AssignmentReaper.Run(reaperCtx, a.config.Assignment.ReapInterval)
+	})
+	middleware.RecoverGoroutine(a.logger, "access-request-expiry", func() {
+		a.container.Services.AccessRequest.Run(reaperCtx, a.config.Assignment.ReapInterval)
+	})
+
 	// Setup Gin HTTP Server
 	if err := a.setupGinServer(); err != nil {
 		return fmt.Errorf("failed to setup Gin server: %w", err)
@@ -143,7 +173,7 @@ func (a *App) Run() error {
 // setupGinServer sets up the Gin HTTP server with all routes
 func (a *App) setupGinServer() error {
 	// Setup Gin router with all routes and middleware
-	ginRouter := router.SetupGinRouter(a.config, a.container.GinHandler, a.logger)
+	ginRouter := router.SetupGinRouter(a.config, a.container.GinHandler, a.container.CasbinEnforcer.SyncMetrics(), a.logger)
 
 	// Create HTTP server
 	httpAddress := a.config.Server.GetHTTPServerAddress()
@@ -214,6 +244,18 @@ func (a *App) Shutdown(ctx context.Context) error {
 		a.logger.Info("gRPC server stopped")
 	}
 
+	// Stop background jobs
+	if a.stopReaper != nil {
+		a.stopReaper()
+		a.logger.Info("Assignment reaper and access request expiry stopped")
+	}
+
+	// Stop policy watcher
+	if a.watcher != nil {
+		a.watcher.Close()
+		a.logger.Info("Casbin watcher stopped")
+	}
+
 	// Close container resources
 	if a.container != nil {
 		if err := a.container.Close(); err != nil {
@@ -22,6 +22,9 @@ type RegisterResponse struct {
 type LoginRequest struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	// ActiveRoles are the roles to activate in the session; empty for every role no dynamic
+	// separation-of-duties constraint keeps apart
+	ActiveRoles []string `json:"active_roles,omitempty"`
 }
 
 // LoginResponse represents login output
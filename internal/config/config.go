@@ -12,11 +12,15 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Log      LogConfig
-	Swagger  SwaggerConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	JWT           JWTConfig
+	Log           LogConfig
+	Swagger       SwaggerConfig
+	Casbin        CasbinConfig
+	Assignment    AssignmentConfig
+	AccessRequest AccessRequestConfig
+	AccessReview  AccessReviewConfig
 }
 
 // ServerConfig holds server configuration
@@ -61,6 +65,36 @@ type SwaggerConfig struct {
 	AuthRealm    string
 }
 
+// CasbinConfig holds Casbin policy configuration
+type CasbinConfig struct {
+	// ModelPath is the Casbin model file; configs/rbac_deny_model.conf enables deny policies
+	ModelPath string
+	// WatcherEnabled syncs policy changes between instances via Postgres LISTEN/NOTIFY
+	WatcherEnabled bool
+}
+
+// AssignmentConfig holds role assignment expiry configuration
+type AssignmentConfig struct {
+	// ReapInterval is how often expired assignments are removed; zero disables the reaper
+	ReapInterval time.Duration
+	// NotifyRole is the role whose users are notified, with the user, when an assignment expires
+	NotifyRole string
+}
+
+// AccessRequestConfig holds access request workflow configuration
+type AccessRequestConfig struct {
+	// PendingTTL is how long a request may wait for a decision before it expires
+	PendingTTL time.Duration
+	// DefaultApproverRole is the role whose users decide requests for roles without approvers
+	DefaultApproverRole string
+}
+
+// AccessReviewConfig holds access review campaign configuration
+type AccessReviewConfig struct {
+	// ManagerRole is the role whose users create and close campaigns
+	ManagerRole string
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Try to load .env file (optional)
@@ -102,6 +136,21 @@ func Load() (*Config, error) {
 			AuthPassword: getEnv("SWAGGER_AUTH_PASSWORD", "changeme"),
 			AuthRealm:    getEnv("SWAGGER_AUTH_REALM", "IAM Service API Documentation"),
 		},
+		Casbin: CasbinConfig{
+			ModelPath:      getEnv("CASBIN_MODEL_PATH", "configs/rbac_model.conf"),
+			WatcherEnabled: getBoolEnv("CASBIN_WATCHER_ENABLED", true),
+		},
+		Assignment: AssignmentConfig{
+			ReapInterval: getTimeDurationEnv("ASSIGNMENT_REAP_INTERVAL", time.Minute),
+			NotifyRole:   getEnv("ASSIGNMENT_EXPIRY_NOTIFY_ROLE", "admin"),
+		},
+		AccessRequest: AccessRequestConfig{
+			PendingTTL:          getTimeDurationEnv("ACCESS_REQUEST_TTL", 168*time.Hour),
+			DefaultApproverRole: getEnv("ACCESS_REQUEST_DEFAULT_APPROVER_ROLE", "admin"),
+		},
+		AccessReview: AccessReviewConfig{
+			ManagerRole: getEnv("ACCESS_REVIEW_MANAGER_ROLE", "admin"),
+		},
 	}
 
 	return config, nil
@@ -145,6 +194,14 @@ func getDurationEnv(key string, defaultValue int) time.Duration {
 	return time.Duration(value)
 }
 
+func getTimeDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
 func getBoolEnv(key string, defaultValue bool) bool {
 	valueStr := os.Getenv(key)
 	if valueStr == "" {
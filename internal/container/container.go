@@ -50,6 +50,14 @@ type DAORegistry struct {
 	APIResource    dao.APIResourceDAO
 	CMSRole        dao.CMSRoleDAO
 	UserCMSRole    dao.UserCMSRoleDAO
+	CMSTab         dao.CMSTabDAO
+	CMSTabAPI      dao.CMSTabAPIDAO
+	CMSFieldPerm   dao.CMSFieldPermissionDAO
+	AccessRequest  dao.AccessRequestDAO
+	SoDConstraint  dao.SoDConstraintDAO
+	AccessReview   dao.AccessReviewDAO
+	RelationTuple  dao.RelationTupleDAO
+	ResourceScope  dao.ResourceScopeDAO
 }
 
 // ServiceRegistry holds all services
@@ -60,6 +68,13 @@ type ServiceRegistry struct {
 	Role          service.RoleService
 	Permission    service.PermissionService
 	Casbin        service.CasbinService
+	AccessRequest service.AccessRequestService
+	SoD           service.SoDService
+	AccessReview  service.AccessReviewService
+	Relation      service.RelationService
+
+	// Background jobs
+	AssignmentReaper service.AssignmentReaper
 }
 
 // NewContainer creates and wires all dependencies
@@ -116,6 +131,14 @@ func (c *Container) initializeDAOs() {
 		APIResource:    dao.NewAPIResourceDAO(c.DB),
 		CMSRole:        dao.NewCMSRoleDAO(c.DB),
 		UserCMSRole:    dao.NewUserCMSRoleDAO(c.DB),
+		CMSTab:         dao.NewCMSTabDAO(c.DB),
+		CMSTabAPI:      dao.NewCMSTabAPIDAO(c.DB),
+		CMSFieldPerm:   dao.NewCMSFieldPermissionDAO(c.DB),
+		AccessRequest:  dao.NewAccessRequestDAO(c.DB),
+		SoDConstraint:  dao.NewSoDConstraintDAO(c.DB),
+		AccessReview:   dao.NewAccessReviewDAO(c.DB),
+		RelationTuple:  dao.NewRelationTupleDAO(c.DB),
+		ResourceScope:  dao.NewResourceScopeDAO(c.DB),
 	}
 }
 
@@ -124,34 +147,112 @@ func (c *Container) initializeServices() error {
 	c.Services = &ServiceRegistry{}
 
 	// Application services (for current handlers)
+	c.Services.SoD = service.NewSoDService(
+		repository.NewSoDConstraintRepository(c.DAOs.SoDConstraint),
+		repository.NewRoleRepository(c.DAOs.Role, c.DAOs.RolePermission),
+		repository.NewCMSRepository(
+			c.DAOs.CMSRole,
+			c.DAOs.UserCMSRole,
+			c.DAOs.CMSTab,
+			c.DAOs.CMSTabAPI,
+			c.DAOs.CMSFieldPerm,
+		),
+		repository.NewResourceScopeRepository(c.DAOs.ResourceScope),
+		c.CasbinEnforcer,
+	)
+
+	c.Services.Relation = service.NewRelationService(
+		repository.NewRelationTupleRepository(c.DAOs.RelationTuple),
+	)
+
 	c.Services.Auth = service.NewAuthService(
 		repository.NewUserRepository(c.DAOs.User),
 		repository.NewAuthorizationRepository(c.DAOs.UserRole, c.DAOs.RolePermission, c.DAOs.Permission),
 		c.JWTManager,
 		c.PasswordManager,
+		c.Services.SoD,
 	)
 
 	c.Services.Authorization = service.NewAuthorizationService(
 		repository.NewAuthorizationRepository(c.DAOs.UserRole, c.DAOs.RolePermission, c.DAOs.Permission),
 		repository.NewUserRepository(c.DAOs.User),
 		repository.NewRoleRepository(c.DAOs.Role, c.DAOs.RolePermission),
+		c.Services.SoD,
+		c.CasbinEnforcer,
 	)
 
 	c.Services.Role = service.NewRoleService(
 		repository.NewRoleRepository(c.DAOs.Role, c.DAOs.RolePermission),
 		repository.NewAuthorizationRepository(c.DAOs.UserRole, c.DAOs.RolePermission, c.DAOs.Permission),
+		c.CasbinEnforcer,
 	)
 
 	c.Services.Permission = service.NewPermissionService(
 		repository.NewPermissionRepository(c.DAOs.Permission),
+		c.CasbinEnforcer,
 	)
 
 	c.Services.Casbin = service.NewCasbinService(
 		c.CasbinEnforcer,
-		repository.NewCMSRepository(c.DAOs.CMSRole, c.DAOs.UserCMSRole),
+		repository.NewCMSRepository(
+			c.DAOs.CMSRole,
+			c.DAOs.UserCMSRole,
+			c.DAOs.CMSTab,
+			c.DAOs.CMSTabAPI,
+			c.DAOs.CMSFieldPerm,
+		),
 		repository.NewAPIResourceRepository(c.DAOs.APIResource),
 		repository.NewUserRepository(c.DAOs.User),
 		repository.NewRoleRepository(c.DAOs.Role, c.DAOs.RolePermission),
+		c.Services.SoD,
+		c.Services.Relation,
+		repository.NewResourceScopeRepository(c.DAOs.ResourceScope),
+	)
+
+	c.Services.AccessRequest = service.NewAccessRequestService(
+		repository.NewAccessRequestRepository(c.DAOs.AccessRequest),
+		repository.NewUserRepository(c.DAOs.User),
+		repository.NewRoleRepository(c.DAOs.Role, c.DAOs.RolePermission),
+		repository.NewCMSRepository(
+			c.DAOs.CMSRole,
+			c.DAOs.UserCMSRole,
+			c.DAOs.CMSTab,
+			c.DAOs.CMSTabAPI,
+			c.DAOs.CMSFieldPerm,
+		),
+		c.Services.Authorization,
+		c.Services.Casbin,
+		c.CasbinEnforcer,
+		c.Config.AccessRequest.PendingTTL,
+		c.Config.AccessRequest.DefaultApproverRole,
+		c.Logger,
+	)
+
+	c.Services.AccessReview = service.NewAccessReviewService(
+		repository.NewAccessReviewRepository(c.DAOs.AccessReview),
+		repository.NewUserRepository(c.DAOs.User),
+		c.Services.Authorization,
+		c.Services.Casbin,
+		c.CasbinEnforcer,
+		c.Config.AccessReview.ManagerRole,
+		c.Logger,
+	)
+
+	c.Services.AssignmentReaper = service.NewAssignmentReaper(
+		repository.NewUserRepository(c.DAOs.User),
+		repository.NewAuthorizationRepository(c.DAOs.UserRole, c.DAOs.RolePermission, c.DAOs.Permission),
+		repository.NewCMSRepository(
+			c.DAOs.CMSRole,
+			c.DAOs.UserCMSRole,
+			c.DAOs.CMSTab,
+			c.DAOs.CMSTabAPI,
+			c.DAOs.CMSFieldPerm,
+		),
+		repository.NewResourceScopeRepository(c.DAOs.ResourceScope),
+		c.CasbinEnforcer,
+		service.NewLogAssignmentNotifier(c.Logger),
+		c.Config.Assignment.NotifyRole,
+		c.Logger,
 	)
 
 	return nil
@@ -165,6 +266,10 @@ func (c *Container) initializeHandlers() {
 		c.Services.Role,
 		c.Services.Permission,
 		c.Services.Casbin,
+		c.Services.AccessRequest,
+		c.Services.SoD,
+		c.Services.AccessReview,
+		c.Services.Relation,
 		c.Logger,
 	)
 
@@ -174,6 +279,10 @@ func (c *Container) initializeHandlers() {
 		c.Services.Role,
 		c.Services.Permission,
 		c.Services.Casbin,
+		c.Services.AccessRequest,
+		c.Services.SoD,
+		c.Services.AccessReview,
+		c.Services.Relation,
 		c.Logger,
 	)
 }
@@ -0,0 +1,444 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// AccessRequestDAO defines the data access operations for access requests, their approvers and
+// their decision audit trail
+type AccessRequestDAO interface {
+	Create(ctx context.Context, request *domain.AccessRequest, submitted *domain.AccessRequestDecision) error
+	FindByID(ctx context.Context, id string) (*domain.AccessRequest, error)
+	List(ctx context.Context, filter domain.AccessRequestFilter, limit, offset int) ([]*domain.AccessRequest, error)
+	Count(ctx context.Context, filter domain.AccessRequestFilter) (int, error)
+	Decide(ctx context.Context, decision *domain.AccessRequestDecision) (bool, error)
+	Reopen(ctx context.Context, decision *domain.AccessRequestDecision, now time.Time) error
+	HoldsRolePermanently(ctx context.Context, kind domain.RoleKind, userID, roleID string, now time.Time) (bool, error)
+	ExpirePending(ctx context.Context, now time.Time, newID func() string) ([]string, error)
+	ListDecisions(ctx context.Context, requestID string) ([]*domain.AccessRequestDecision, error)
+	SetApprovers(ctx context.Context, kind domain.RoleKind, roleID string, approverIDs []string) error
+	ListApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error)
+}
+
+type accessRequestDAO struct {
+	db *sql.DB
+}
+
+// NewAccessRequestDAO creates a new instance of AccessRequestDAO
+func NewAccessRequestDAO(db *sql.DB) AccessRequestDAO {
+	return &accessRequestDAO{db: db}
+}
+
+// accessRequestColumns selects a request with the name of its role, which is empty once the role
+// was deleted
+const accessRequestColumns = `
+	SELECT ar.id, ar.requester_id, ar.role_kind, ar.role_id,
+	       COALESCE(CASE WHEN ar.role_kind = 'role' THEN r.name ELSE cr.name END, ''),
+	       ar.justification, ar.duration_seconds, ar.status, COALESCE(ar.decided_by, ''),
+	       ar.decided_at, ar.decision_comment, ar.expires_at, ar.created_at, ar.updated_at
+	FROM access_requests ar
+	LEFT JOIN roles r ON ar.role_kind = 'role' AND r.id = ar.role_id
+	LEFT JOIN cms_roles cr ON ar.role_kind = 'cms_role' AND cr.id = ar.role_id
+`
+
+func (d *accessRequestDAO) Create(ctx context.Context, request *domain.AccessRequest, submitted *domain.AccessRequestDecision) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access request:", err)
+		}
+	}()
+
+	query := `
+		INSERT INTO access_requests (id, requester_id, role_kind, role_id, justification, duration_seconds,
+		                             status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		request.ID,
+		request.RequesterID,
+		request.RoleKind,
+		request.RoleID,
+		request.Justification,
+		nullableDurationSeconds(request.Duration),
+		request.Status,
+		request.ExpiresAt,
+		request.CreatedAt,
+		request.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err := insertAccessRequestDecision(ctx, tx, submitted); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *accessRequestDAO) FindByID(ctx context.Context, id string) (*domain.AccessRequest, error) {
+	request, err := scanAccessRequest(d.db.QueryRowContext(ctx, accessRequestColumns+` WHERE ar.id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return request, err
+}
+
+func (d *accessRequestDAO) List(ctx context.Context, filter domain.AccessRequestFilter, limit, offset int) ([]*domain.AccessRequest, error) {
+	where, args := accessRequestConditions(filter)
+	query := fmt.Sprintf(`%s %s ORDER BY ar.created_at DESC, ar.id LIMIT $%d OFFSET $%d`,
+		accessRequestColumns, where, len(args)+1, len(args)+2)
+	rows, err := d.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var requests []*domain.AccessRequest
+	for rows.Next() {
+		request, err := scanAccessRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, rows.Err()
+}
+
+func (d *accessRequestDAO) Count(ctx context.Context, filter domain.AccessRequestFilter) (int, error) {
+	where, args := accessRequestConditions(filter)
+	var count int
+	err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM access_requests ar `+where, args...).Scan(&count)
+	return count, err
+}
+
+// Decide moves a pending request to the decision's status and records the decision. It reports
+// false, and changes nothing, when the request is no longer pending.
+func (d *accessRequestDAO) Decide(ctx context.Context, decision *domain.AccessRequestDecision) (bool, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access request decision:", err)
+		}
+	}()
+
+	query := `
+		UPDATE access_requests
+		SET status = $2, decided_by = $3, decided_at = $4, decision_comment = $5, updated_at = $4
+		WHERE id = $1 AND status = 'pending'
+	`
+	result, err := tx.ExecContext(ctx, query,
+		decision.RequestID,
+		decision.Status,
+		decision.ActorID,
+		decision.CreatedAt,
+		decision.Comment,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	if err := insertAccessRequestDecision(ctx, tx, decision); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// Reopen undoes a decision whose effect could not be applied: the request is pending again and
+// the decision is removed from the audit trail
+func (d *accessRequestDAO) Reopen(ctx context.Context, decision *domain.AccessRequestDecision, now time.Time) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access request reopening:", err)
+		}
+	}()
+
+	query := `
+		UPDATE access_requests
+		SET status = 'pending', decided_by = NULL, decided_at = NULL, decision_comment = '', updated_at = $4
+		WHERE id = $1 AND status = $2 AND decided_by = $3
+	`
+	if _, err := tx.ExecContext(ctx, query, decision.RequestID, decision.Status, decision.ActorID, now); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM access_request_decisions WHERE id = $1`, decision.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// HoldsRolePermanently reports whether the user holds the role through an assignment that is in
+// effect at now and never expires
+func (d *accessRequestDAO) HoldsRolePermanently(ctx context.Context, kind domain.RoleKind, userID, roleID string, now time.Time) (bool, error) {
+	var query string
+	switch kind {
+	case domain.RoleKindRole:
+		query = `SELECT EXISTS(SELECT 1 FROM user_roles
+			WHERE user_id = $1 AND role_id = $2 AND expires_at IS NULL AND (starts_at IS NULL OR starts_at <= $3))`
+	case domain.RoleKindCMSRole:
+		query = `SELECT EXISTS(SELECT 1 FROM user_cms_roles
+			WHERE user_id = $1 AND cms_role_id = $2 AND expires_at IS NULL AND (starts_at IS NULL OR starts_at <= $3))`
+	default:
+		return false, fmt.Errorf("invalid role kind: %s", kind)
+	}
+	var held bool
+	err := d.db.QueryRowContext(ctx, query, userID, roleID, now).Scan(&held)
+	return held, err
+}
+
+// ExpirePending expires the pending requests whose deadline passed at or before now, records an
+// expiry for each and returns their IDs. newID generates the IDs of the audit entries.
+func (d *accessRequestDAO) ExpirePending(ctx context.Context, now time.Time, newID func() string) ([]string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access request expiry:", err)
+		}
+	}()
+
+	query := `
+		UPDATE access_requests
+		SET status = 'expired', decided_at = $1, updated_at = $1
+		WHERE status = 'pending' AND expires_at <= $1
+		RETURNING id
+	`
+	rows, err := tx.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		decision := &domain.AccessRequestDecision{
+			ID:        newID(),
+			RequestID: id,
+			Status:    domain.AccessRequestExpired,
+			CreatedAt: now,
+		}
+		if err := insertAccessRequestDecision(ctx, tx, decision); err != nil {
+			return nil, err
+		}
+	}
+	return ids, tx.Commit()
+}
+
+func (d *accessRequestDAO) ListDecisions(ctx context.Context, requestID string) ([]*domain.AccessRequestDecision, error) {
+	query := `
+		SELECT id, request_id, actor_id, status, comment, created_at
+		FROM access_request_decisions
+		WHERE request_id = $1
+		ORDER BY created_at, id
+	`
+	rows, err := d.db.QueryContext(ctx, query, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var decisions []*domain.AccessRequestDecision
+	for rows.Next() {
+		decision := &domain.AccessRequestDecision{}
+		err := rows.Scan(
+			&decision.ID,
+			&decision.RequestID,
+			&decision.ActorID,
+			&decision.Status,
+			&decision.Comment,
+			&decision.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, rows.Err()
+}
+
+// SetApprovers replaces the approvers of a role
+func (d *accessRequestDAO) SetApprovers(ctx context.Context, kind domain.RoleKind, roleID string, approverIDs []string) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access request approvers:", err)
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM access_request_approvers WHERE role_kind = $1 AND role_id = $2`, kind, roleID); err != nil {
+		return err
+	}
+	for _, approverID := range approverIDs {
+		query := `
+			INSERT INTO access_request_approvers (role_kind, role_id, approver_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, query, kind, roleID, approverID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *accessRequestDAO) ListApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error) {
+	query := `
+		SELECT approver_id
+		FROM access_request_approvers
+		WHERE role_kind = $1 AND role_id = $2
+		ORDER BY approver_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, kind, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var approverIDs []string
+	for rows.Next() {
+		var approverID string
+		if err := rows.Scan(&approverID); err != nil {
+			return nil, err
+		}
+		approverIDs = append(approverIDs, approverID)
+	}
+	return approverIDs, rows.Err()
+}
+
+func insertAccessRequestDecision(ctx context.Context, tx *sql.Tx, decision *domain.AccessRequestDecision) error {
+	query := `
+		INSERT INTO access_request_decisions (id, request_id, actor_id, status, comment, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := tx.ExecContext(ctx, query,
+		decision.ID,
+		decision.RequestID,
+		decision.ActorID,
+		decision.Status,
+		decision.Comment,
+		decision.CreatedAt,
+	)
+	return err
+}
+
+// accessRequestConditions builds the WHERE clause of a filter
+func accessRequestConditions(filter domain.AccessRequestFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	add := func(column string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if filter.Status != "" {
+		add("ar.status", filter.Status)
+	}
+	if filter.RequesterID != "" {
+		add("ar.requester_id", filter.RequesterID)
+	}
+	if filter.RoleKind != "" {
+		add("ar.role_kind", filter.RoleKind)
+	}
+	if filter.RoleID != "" {
+		add("ar.role_id", filter.RoleID)
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// rowScanner is a *sql.Row or *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAccessRequest scans a row selected with accessRequestColumns
+func scanAccessRequest(row rowScanner) (*domain.AccessRequest, error) {
+	request := &domain.AccessRequest{}
+	var durationSeconds sql.NullInt64
+	var decidedAt sql.NullTime
+	err := row.Scan(
+		&request.ID,
+		&request.RequesterID,
+		&request.RoleKind,
+		&request.RoleID,
+		&request.RoleName,
+		&request.Justification,
+		&durationSeconds,
+		&request.Status,
+		&request.DecidedBy,
+		&decidedAt,
+		&request.DecisionComment,
+		&request.ExpiresAt,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if durationSeconds.Valid {
+		request.Duration = time.Duration(durationSeconds.Int64) * time.Second
+	}
+	if decidedAt.Valid {
+		request.DecidedAt = &decidedAt.Time
+	}
+	return request, nil
+}
+
+func nullableDurationSeconds(duration time.Duration) sql.NullInt64 {
+	seconds := int64(duration / time.Second)
+	return sql.NullInt64{Int64: seconds, Valid: seconds > 0}
+}
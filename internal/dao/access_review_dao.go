@@ -0,0 +1,416 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// AccessReviewDAO defines the data access operations for access review campaigns and their items
+type AccessReviewDAO interface {
+	ListAssignments(ctx context.Context, scope domain.AccessReviewScope) ([]*domain.AccessReviewItem, error)
+	Create(ctx context.Context, campaign *domain.AccessReviewCampaign, items []*domain.AccessReviewItem) error
+	FindByID(ctx context.Context, id string) (*domain.AccessReviewCampaign, error)
+	List(ctx context.Context, status domain.AccessReviewStatus, limit, offset int) ([]*domain.AccessReviewCampaign, error)
+	Count(ctx context.Context, status domain.AccessReviewStatus) (int, error)
+	Close(ctx context.Context, id, closedBy string, closedAt time.Time) (bool, error)
+	FindItemByID(ctx context.Context, campaignID, itemID string) (*domain.AccessReviewItem, error)
+	ListItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, limit, offset int) ([]*domain.AccessReviewItem, error)
+	ListAllItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) ([]*domain.AccessReviewItem, error)
+	CountItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) (int, error)
+	Decide(ctx context.Context, item *domain.AccessReviewItem) (bool, error)
+	RecordRevocation(ctx context.Context, itemID string, revokedAt *time.Time, revokeError string) error
+	Summarize(ctx context.Context, campaignID string) (*domain.AccessReviewSummary, error)
+}
+
+type accessReviewDAO struct {
+	db *sql.DB
+}
+
+// NewAccessReviewDAO creates a new instance of AccessReviewDAO
+func NewAccessReviewDAO(db *sql.DB) AccessReviewDAO {
+	return &accessReviewDAO{db: db}
+}
+
+const accessReviewCampaignColumns = `
+	SELECT id, name, description, scope, COALESCE(default_reviewer_id, ''), status, due_at,
+	       created_by, COALESCE(closed_by, ''), closed_at, created_at, updated_at
+	FROM access_review_campaigns
+`
+
+const accessReviewItemColumns = `
+	SELECT id, campaign_id, user_id, role_kind, role_id, role_name, scope_type, scope_id, starts_at, expires_at,
+	       reviewer_id, decision, comment, decided_at, revoked_at, revoke_error
+	FROM access_review_items
+`
+
+// ListAssignments returns the current role and CMS role assignments in scope, global and scoped,
+// ordered by user
+func (d *accessReviewDAO) ListAssignments(ctx context.Context, scope domain.AccessReviewScope) ([]*domain.AccessReviewItem, error) {
+	kinds := make([]string, len(scope.RoleKinds))
+	for i, kind := range scope.RoleKinds {
+		kinds[i] = string(kind)
+	}
+	query := `
+		SELECT user_id, role_kind, role_id, role_name, scope_type, scope_id, starts_at, expires_at
+		FROM (
+			SELECT ur.user_id, 'role' AS role_kind, ur.role_id, r.name AS role_name,
+			       '' AS scope_type, '' AS scope_id, ur.starts_at, ur.expires_at
+			FROM user_roles ur
+			JOIN roles r ON r.id = ur.role_id
+			UNION ALL
+			SELECT ucr.user_id, 'cms_role', ucr.cms_role_id, cr.name, '', '', ucr.starts_at, ucr.expires_at
+			FROM user_cms_roles ucr
+			JOIN cms_roles cr ON cr.id = ucr.cms_role_id
+			UNION ALL
+			SELECT ucrs.user_id, 'cms_role', ucrs.cms_role_id, cr.name, ucrs.scope_type, ucrs.scope_id,
+			       ucrs.starts_at, ucrs.expires_at
+			FROM user_cms_role_scopes ucrs
+			JOIN cms_roles cr ON cr.id = ucrs.cms_role_id
+		) assignments
+		WHERE (cardinality($1::text[]) = 0 OR role_kind = ANY($1))
+		  AND (cardinality($2::text[]) = 0 OR role_id = ANY($2))
+		  AND (cardinality($3::text[]) = 0 OR user_id = ANY($3))
+		ORDER BY user_id, role_kind, role_name, scope_type, scope_id
+	`
+	rows, err := d.db.QueryContext(ctx, query,
+		pq.Array(kinds), pq.Array(nonNilStrings(scope.RoleIDs)), pq.Array(nonNilStrings(scope.UserIDs)))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var items []*domain.AccessReviewItem
+	for rows.Next() {
+		item := &domain.AccessReviewItem{}
+		err := rows.Scan(&item.UserID, &item.RoleKind, &item.RoleID, &item.RoleName,
+			&item.Scope.Type, &item.Scope.ID, &item.StartsAt, &item.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// Create stores a campaign together with its snapshot
+func (d *accessReviewDAO) Create(ctx context.Context, campaign *domain.AccessReviewCampaign, items []*domain.AccessReviewItem) error {
+	scope, err := json.Marshal(campaign.Scope)
+	if err != nil {
+		return err
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back access review campaign:", err)
+		}
+	}()
+
+	query := `
+		INSERT INTO access_review_campaigns (id, name, description, scope, default_reviewer_id, status,
+		                                     due_at, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		campaign.ID,
+		campaign.Name,
+		campaign.Description,
+		scope,
+		campaign.DefaultReviewerID,
+		campaign.Status,
+		campaign.DueAt,
+		campaign.CreatedBy,
+		campaign.CreatedAt,
+		campaign.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		query := `
+			INSERT INTO access_review_items (id, campaign_id, user_id, role_kind, role_id, role_name,
+			                                 scope_type, scope_id, starts_at, expires_at, reviewer_id, decision)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`
+		_, err := tx.ExecContext(ctx, query,
+			item.ID,
+			item.CampaignID,
+			item.UserID,
+			item.RoleKind,
+			item.RoleID,
+			item.RoleName,
+			item.Scope.Type,
+			item.Scope.ID,
+			item.StartsAt,
+			item.ExpiresAt,
+			item.ReviewerID,
+			item.Decision,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *accessReviewDAO) FindByID(ctx context.Context, id string) (*domain.AccessReviewCampaign, error) {
+	campaign, err := scanAccessReviewCampaign(d.db.QueryRowContext(ctx, accessReviewCampaignColumns+` WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return campaign, err
+}
+
+func (d *accessReviewDAO) List(ctx context.Context, status domain.AccessReviewStatus, limit, offset int) ([]*domain.AccessReviewCampaign, error) {
+	where, args := accessReviewCampaignConditions(status)
+	query := fmt.Sprintf(`%s %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`,
+		accessReviewCampaignColumns, where, len(args)+1, len(args)+2)
+	rows, err := d.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var campaigns []*domain.AccessReviewCampaign
+	for rows.Next() {
+		campaign, err := scanAccessReviewCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, campaign)
+	}
+	return campaigns, rows.Err()
+}
+
+func (d *accessReviewDAO) Count(ctx context.Context, status domain.AccessReviewStatus) (int, error) {
+	where, args := accessReviewCampaignConditions(status)
+	var count int
+	err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM access_review_campaigns `+where, args...).Scan(&count)
+	return count, err
+}
+
+// Close marks an open campaign closed. It reports false when the campaign was not open.
+func (d *accessReviewDAO) Close(ctx context.Context, id, closedBy string, closedAt time.Time) (bool, error) {
+	query := `
+		UPDATE access_review_campaigns
+		SET status = 'closed', closed_by = $2, closed_at = $3, updated_at = $3
+		WHERE id = $1 AND status = 'open'
+	`
+	result, err := d.db.ExecContext(ctx, query, id, closedBy, closedAt)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	return rows > 0, err
+}
+
+func (d *accessReviewDAO) FindItemByID(ctx context.Context, campaignID, itemID string) (*domain.AccessReviewItem, error) {
+	item, err := scanAccessReviewItem(d.db.QueryRowContext(ctx,
+		accessReviewItemColumns+` WHERE campaign_id = $1 AND id = $2`, campaignID, itemID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return item, err
+}
+
+func (d *accessReviewDAO) ListItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, limit, offset int) ([]*domain.AccessReviewItem, error) {
+	where, args := accessReviewItemConditions(campaignID, filter)
+	query := fmt.Sprintf(`%s %s ORDER BY user_id, role_kind, role_name, scope_type, scope_id, id LIMIT $%d OFFSET $%d`,
+		accessReviewItemColumns, where, len(args)+1, len(args)+2)
+	return d.queryItems(ctx, query, append(args, limit, offset)...)
+}
+
+// ListAllItems returns every item of a campaign matching filter, for closing and reporting
+func (d *accessReviewDAO) ListAllItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) ([]*domain.AccessReviewItem, error) {
+	where, args := accessReviewItemConditions(campaignID, filter)
+	query := fmt.Sprintf(`%s %s ORDER BY user_id, role_kind, role_name, scope_type, scope_id, id`, accessReviewItemColumns, where)
+	return d.queryItems(ctx, query, args...)
+}
+
+func (d *accessReviewDAO) CountItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) (int, error) {
+	where, args := accessReviewItemConditions(campaignID, filter)
+	var count int
+	err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM access_review_items `+where, args...).Scan(&count)
+	return count, err
+}
+
+// Decide records the item's decision while its campaign is open. It reports false when the
+// campaign is closed.
+func (d *accessReviewDAO) Decide(ctx context.Context, item *domain.AccessReviewItem) (bool, error) {
+	query := `
+		UPDATE access_review_items i
+		SET decision = $3, comment = $4, decided_at = $5
+		FROM access_review_campaigns c
+		WHERE i.campaign_id = $1 AND i.id = $2 AND c.id = i.campaign_id AND c.status = 'open'
+	`
+	result, err := d.db.ExecContext(ctx, query, item.CampaignID, item.ID, item.Decision, item.Comment, item.DecidedAt)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	return rows > 0, err
+}
+
+// RecordRevocation stores the outcome of applying a revoke decision
+func (d *accessReviewDAO) RecordRevocation(ctx context.Context, itemID string, revokedAt *time.Time, revokeError string) error {
+	_, err := d.db.ExecContext(ctx,
+		`UPDATE access_review_items SET revoked_at = $2, revoke_error = $3 WHERE id = $1`,
+		itemID, revokedAt, revokeError)
+	return err
+}
+
+func (d *accessReviewDAO) Summarize(ctx context.Context, campaignID string) (*domain.AccessReviewSummary, error) {
+	query := `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE decision = 'pending'),
+		       COUNT(*) FILTER (WHERE decision = 'keep'),
+		       COUNT(*) FILTER (WHERE decision = 'revoke'),
+		       COUNT(*) FILTER (WHERE decision = 'revoke' AND revoke_error <> '')
+		FROM access_review_items
+		WHERE campaign_id = $1
+	`
+	summary := &domain.AccessReviewSummary{}
+	err := d.db.QueryRowContext(ctx, query, campaignID).Scan(
+		&summary.Total,
+		&summary.Pending,
+		&summary.Kept,
+		&summary.Revoked,
+		&summary.Failed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func (d *accessReviewDAO) queryItems(ctx context.Context, query string, args ...interface{}) ([]*domain.AccessReviewItem, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var items []*domain.AccessReviewItem
+	for rows.Next() {
+		item, err := scanAccessReviewItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func accessReviewCampaignConditions(status domain.AccessReviewStatus) (string, []interface{}) {
+	if status == "" {
+		return "", nil
+	}
+	return "WHERE status = $1", []interface{}{status}
+}
+
+func accessReviewItemConditions(campaignID string, filter domain.AccessReviewItemFilter) (string, []interface{}) {
+	conditions := []string{"campaign_id = $1"}
+	args := []interface{}{campaignID}
+	add := func(column string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if filter.ReviewerID != "" {
+		add("reviewer_id", filter.ReviewerID)
+	}
+	if filter.UserID != "" {
+		add("user_id", filter.UserID)
+	}
+	if filter.Decision != "" {
+		add("decision", filter.Decision)
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func scanAccessReviewCampaign(row rowScanner) (*domain.AccessReviewCampaign, error) {
+	campaign := &domain.AccessReviewCampaign{}
+	var scope []byte
+	err := row.Scan(
+		&campaign.ID,
+		&campaign.Name,
+		&campaign.Description,
+		&scope,
+		&campaign.DefaultReviewerID,
+		&campaign.Status,
+		&campaign.DueAt,
+		&campaign.CreatedBy,
+		&campaign.ClosedBy,
+		&campaign.ClosedAt,
+		&campaign.CreatedAt,
+		&campaign.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(scope, &campaign.Scope); err != nil {
+		return nil, fmt.Errorf("invalid access review scope: %w", err)
+	}
+	return campaign, nil
+}
+
+func scanAccessReviewItem(row rowScanner) (*domain.AccessReviewItem, error) {
+	item := &domain.AccessReviewItem{}
+	err := row.Scan(
+		&item.ID,
+		&item.CampaignID,
+		&item.UserID,
+		&item.RoleKind,
+		&item.RoleID,
+		&item.RoleName,
+		&item.Scope.Type,
+		&item.Scope.ID,
+		&item.StartsAt,
+		&item.ExpiresAt,
+		&item.ReviewerID,
+		&item.Decision,
+		&item.Comment,
+		&item.DecidedAt,
+		&item.RevokedAt,
+		&item.RevokeError,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// nonNilStrings returns an empty slice for nil, which pq.Array would send as NULL
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/tvttt/iam-services/internal/domain"
+	"log"
+)
+
+// CMSFieldPermissionDAO defines the data access operations for CMS role field permissions
+type CMSFieldPermissionDAO interface {
+	Upsert(ctx context.Context, perm *domain.CMSFieldPermission) error
+	FindByRole(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error)
+	FindByRolesAndResource(ctx context.Context, cmsRoleIDs []string, resourceType string) ([]*domain.CMSFieldPermission, error)
+	Delete(ctx context.Context, cmsRoleID, resourceType string) error
+}
+
+type cmsFieldPermissionDAO struct {
+	db *sql.DB
+}
+
+// NewCMSFieldPermissionDAO creates a new instance of CMSFieldPermissionDAO
+func NewCMSFieldPermissionDAO(db *sql.DB) CMSFieldPermissionDAO {
+	return &cmsFieldPermissionDAO{db: db}
+}
+
+func (d *cmsFieldPermissionDAO) Upsert(ctx context.Context, perm *domain.CMSFieldPermission) error {
+	query := `
+		INSERT INTO cms_role_field_permissions (id, cms_role_id, resource_type, readable_fields, writable_fields, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (cms_role_id, resource_type)
+		DO UPDATE SET readable_fields = EXCLUDED.readable_fields, writable_fields = EXCLUDED.writable_fields, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+	return d.db.QueryRowContext(ctx, query,
+		perm.ID,
+		perm.CMSRoleID,
+		perm.ResourceType,
+		pq.Array(perm.ReadableFields),
+		pq.Array(perm.WritableFields),
+		perm.CreatedAt,
+		perm.UpdatedAt,
+	).Scan(&perm.ID, &perm.CreatedAt)
+}
+
+func (d *cmsFieldPermissionDAO) FindByRole(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error) {
+	query := `
+		SELECT id, cms_role_id, resource_type, readable_fields, writable_fields, created_at, updated_at
+		FROM cms_role_field_permissions
+		WHERE cms_role_id = $1
+		ORDER BY resource_type
+	`
+	return d.query(ctx, query, cmsRoleID)
+}
+
+func (d *cmsFieldPermissionDAO) FindByRolesAndResource(ctx context.Context, cmsRoleIDs []string, resourceType string) ([]*domain.CMSFieldPermission, error) {
+	query := `
+		SELECT id, cms_role_id, resource_type, readable_fields, writable_fields, created_at, updated_at
+		FROM cms_role_field_permissions
+		WHERE cms_role_id = ANY($1) AND resource_type = $2
+		ORDER BY cms_role_id
+	`
+	return d.query(ctx, query, pq.Array(cmsRoleIDs), resourceType)
+}
+
+func (d *cmsFieldPermissionDAO) Delete(ctx context.Context, cmsRoleID, resourceType string) error {
+	query := `DELETE FROM cms_role_field_permissions WHERE cms_role_id = $1 AND resource_type = $2`
+	_, err := d.db.ExecContext(ctx, query, cmsRoleID, resourceType)
+	return err
+}
+
+func (d *cmsFieldPermissionDAO) query(ctx context.Context, query string, args ...interface{}) ([]*domain.CMSFieldPermission, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var perms []*domain.CMSFieldPermission
+	for rows.Next() {
+		perm := &domain.CMSFieldPermission{}
+		err := rows.Scan(
+			&perm.ID,
+			&perm.CMSRoleID,
+			&perm.ResourceType,
+			pq.Array(&perm.ReadableFields),
+			pq.Array(&perm.WritableFields),
+			&perm.CreatedAt,
+			&perm.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		perms = append(perms, perm)
+	}
+	return perms, rows.Err()
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/lib/pq"
 	"github.com/tvttt/iam-services/internal/domain"
@@ -16,7 +17,9 @@ type CMSRoleDAO interface {
 	FindByName(ctx context.Context, name string) (*domain.CMSRole, error)
 	List(ctx context.Context, limit, offset int) ([]*domain.CMSRole, error)
 	Update(ctx context.Context, role *domain.CMSRole) error
+	UpdateTx(ctx context.Context, tx *sql.Tx, role *domain.CMSRole) error
 	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *sql.Tx, id string) error
 	Count(ctx context.Context) (int, error)
 }
 
@@ -31,19 +34,20 @@ func NewCMSRoleDAO(db *sql.DB) CMSRoleDAO {
 
 func (d *cmsRoleDAO) Create(ctx context.Context, role *domain.CMSRole) error {
 	query := `
-		INSERT INTO cms_roles (id, name, description, tabs, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO cms_roles (id, name, description, tabs, tab_actions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
-	tabs := make([]string, len(role.Tabs))
-	for i, tab := range role.Tabs {
-		tabs[i] = string(tab)
+	tabs, tabActions, err := encodeCMSTabActions(role)
+	if err != nil {
+		return err
 	}
 
-	_, err := d.db.ExecContext(ctx, query,
+	_, err = d.db.ExecContext(ctx, query,
 		role.ID,
 		role.Name,
 		role.Description,
 		pq.Array(tabs),
+		tabActions,
 		role.CreatedAt,
 		role.UpdatedAt,
 	)
@@ -52,18 +56,20 @@ func (d *cmsRoleDAO) Create(ctx context.Context, role *domain.CMSRole) error {
 
 func (d *cmsRoleDAO) FindByID(ctx context.Context, id string) (*domain.CMSRole, error) {
 	query := `
-		SELECT id, name, description, tabs, created_at, updated_at
+		SELECT id, name, description, tabs, tab_actions, created_at, updated_at
 		FROM cms_roles
 		WHERE id = $1
 	`
 	role := &domain.CMSRole{}
 	var tabs []string
+	var tabActions []byte
 
 	err := d.db.QueryRowContext(ctx, query, id).Scan(
 		&role.ID,
 		&role.Name,
 		&role.Description,
 		pq.Array(&tabs),
+		&tabActions,
 		&role.CreatedAt,
 		&role.UpdatedAt,
 	)
@@ -74,9 +80,8 @@ func (d *cmsRoleDAO) FindByID(ctx context.Context, id string) (*domain.CMSRole,
 		return nil, err
 	}
 
-	role.Tabs = make([]domain.CMSTab, len(tabs))
-	for i, tab := range tabs {
-		role.Tabs[i] = domain.CMSTab(tab)
+	if err := decodeCMSTabActions(role, tabs, tabActions); err != nil {
+		return nil, err
 	}
 
 	return role, nil
@@ -84,18 +89,20 @@ func (d *cmsRoleDAO) FindByID(ctx context.Context, id string) (*domain.CMSRole,
 
 func (d *cmsRoleDAO) FindByName(ctx context.Context, name string) (*domain.CMSRole, error) {
 	query := `
-		SELECT id, name, description, tabs, created_at, updated_at
+		SELECT id, name, description, tabs, tab_actions, created_at, updated_at
 		FROM cms_roles
 		WHERE name = $1
 	`
 	role := &domain.CMSRole{}
 	var tabs []string
+	var tabActions []byte
 
 	err := d.db.QueryRowContext(ctx, query, name).Scan(
 		&role.ID,
 		&role.Name,
 		&role.Description,
 		pq.Array(&tabs),
+		&tabActions,
 		&role.CreatedAt,
 		&role.UpdatedAt,
 	)
@@ -106,9 +113,8 @@ func (d *cmsRoleDAO) FindByName(ctx context.Context, name string) (*domain.CMSRo
 		return nil, err
 	}
 
-	role.Tabs = make([]domain.CMSTab, len(tabs))
-	for i, tab := range tabs {
-		role.Tabs[i] = domain.CMSTab(tab)
+	if err := decodeCMSTabActions(role, tabs, tabActions); err != nil {
+		return nil, err
 	}
 
 	return role, nil
@@ -116,7 +122,7 @@ func (d *cmsRoleDAO) FindByName(ctx context.Context, name string) (*domain.CMSRo
 
 func (d *cmsRoleDAO) List(ctx context.Context, limit, offset int) ([]*domain.CMSRole, error) {
 	query := `
-		SELECT id, name, description, tabs, created_at, updated_at
+		SELECT id, name, description, tabs, tab_actions, created_at, updated_at
 		FROM cms_roles
 		ORDER BY name
 		LIMIT $1 OFFSET $2
@@ -136,12 +142,14 @@ func (d *cmsRoleDAO) List(ctx context.Context, limit, offset int) ([]*domain.CMS
 	for rows.Next() {
 		role := &domain.CMSRole{}
 		var tabs []string
+		var tabActions []byte
 
 		err := rows.Scan(
 			&role.ID,
 			&role.Name,
 			&role.Description,
 			pq.Array(&tabs),
+			&tabActions,
 			&role.CreatedAt,
 			&role.UpdatedAt,
 		)
@@ -149,9 +157,8 @@ func (d *cmsRoleDAO) List(ctx context.Context, limit, offset int) ([]*domain.CMS
 			return nil, err
 		}
 
-		role.Tabs = make([]domain.CMSTab, len(tabs))
-		for i, tab := range tabs {
-			role.Tabs[i] = domain.CMSTab(tab)
+		if err := decodeCMSTabActions(role, tabs, tabActions); err != nil {
+			return nil, err
 		}
 
 		roles = append(roles, role)
@@ -160,21 +167,31 @@ func (d *cmsRoleDAO) List(ctx context.Context, limit, offset int) ([]*domain.CMS
 }
 
 func (d *cmsRoleDAO) Update(ctx context.Context, role *domain.CMSRole) error {
+	return d.update(ctx, d.db, role)
+}
+
+// UpdateTx updates a CMS role within tx
+func (d *cmsRoleDAO) UpdateTx(ctx context.Context, tx *sql.Tx, role *domain.CMSRole) error {
+	return d.update(ctx, tx, role)
+}
+
+func (d *cmsRoleDAO) update(ctx context.Context, db execer, role *domain.CMSRole) error {
 	query := `
 		UPDATE cms_roles
-		SET name = $2, description = $3, tabs = $4, updated_at = $5
+		SET name = $2, description = $3, tabs = $4, tab_actions = $5, updated_at = $6
 		WHERE id = $1
 	`
-	tabs := make([]string, len(role.Tabs))
-	for i, tab := range role.Tabs {
-		tabs[i] = string(tab)
+	tabs, tabActions, err := encodeCMSTabActions(role)
+	if err != nil {
+		return err
 	}
 
-	_, err := d.db.ExecContext(ctx, query,
+	_, err = db.ExecContext(ctx, query,
 		role.ID,
 		role.Name,
 		role.Description,
 		pq.Array(tabs),
+		tabActions,
 		role.UpdatedAt,
 	)
 	return err
@@ -186,9 +203,57 @@ func (d *cmsRoleDAO) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+// DeleteTx deletes a CMS role within tx; user assignments and field permissions cascade
+func (d *cmsRoleDAO) DeleteTx(ctx context.Context, tx *sql.Tx, id string) error {
+	query := `DELETE FROM cms_roles WHERE id = $1`
+	_, err := tx.ExecContext(ctx, query, id)
+	return err
+}
+
 func (d *cmsRoleDAO) Count(ctx context.Context) (int, error) {
 	query := `SELECT COUNT(*) FROM cms_roles`
 	var count int
 	err := d.db.QueryRowContext(ctx, query).Scan(&count)
 	return count, err
 }
+
+// encodeCMSTabActions returns the tabs array and tab_actions JSON stored for a role.
+// The tabs column is derived from Permissions so both columns always agree.
+func encodeCMSTabActions(role *domain.CMSRole) ([]string, []byte, error) {
+	tabActions := make(map[string][]domain.CMSAction, len(role.Permissions))
+	tabs := make([]string, 0, len(role.Permissions))
+	for _, perm := range role.Permissions {
+		tabs = append(tabs, string(perm.Tab))
+		tabActions[string(perm.Tab)] = perm.Actions
+	}
+
+	data, err := json.Marshal(tabActions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tabs, data, nil
+}
+
+// decodeCMSTabActions fills Tabs and Permissions from the stored columns.
+// Tabs without recorded actions are treated as view-only, matching legacy rows; a recorded
+// empty list grants no actions.
+func decodeCMSTabActions(role *domain.CMSRole, tabs []string, data []byte) error {
+	tabActions := make(map[string][]domain.CMSAction)
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &tabActions); err != nil {
+			return err
+		}
+	}
+
+	role.Tabs = make([]domain.CMSTab, len(tabs))
+	role.Permissions = make([]domain.CMSTabPermission, len(tabs))
+	for i, tab := range tabs {
+		actions, ok := tabActions[tab]
+		if !ok || actions == nil {
+			actions = []domain.CMSAction{domain.CMSActionView}
+		}
+		role.Tabs[i] = domain.CMSTab(tab)
+		role.Permissions[i] = domain.CMSTabPermission{Tab: domain.CMSTab(tab), Actions: actions}
+	}
+	return nil
+}
@@ -32,6 +32,8 @@ type CMSTabAPIDAO interface {
 	Delete(ctx context.Context, id string) error
 	DeleteByTab(ctx context.Context, tabName string) error
 	Exists(ctx context.Context, tabName, apiPath, method string) (bool, error)
+	UpsertTx(ctx context.Context, tx *sql.Tx, tabAPI *CMSTabAPI) error
+	DeleteByKeyTx(ctx context.Context, tx *sql.Tx, tabName, apiPath, method string) error
 }
 
 type cmsTabAPIDAO struct {
@@ -303,3 +305,49 @@ func (d *cmsTabAPIDAO) Exists(ctx context.Context, tabName, apiPath, method stri
 
 	return exists, nil
 }
+
+// UpsertTx creates a mapping within tx, updating the description when the mapping already exists
+func (d *cmsTabAPIDAO) UpsertTx(ctx context.Context, tx *sql.Tx, tabAPI *CMSTabAPI) error {
+	if tabAPI.ID == "" {
+		tabAPI.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	tabAPI.CreatedAt = now
+	tabAPI.UpdatedAt = now
+
+	query := `
+		INSERT INTO cms_tab_apis (id, tab_name, api_path, api_method, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tab_name, api_path, api_method)
+		DO UPDATE SET description = EXCLUDED.description, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := tx.ExecContext(ctx, query,
+		tabAPI.ID,
+		tabAPI.TabName,
+		tabAPI.APIPath,
+		tabAPI.APIMethod,
+		tabAPI.Description,
+		tabAPI.CreatedAt,
+		tabAPI.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to upsert cms tab-api mapping: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByKeyTx deletes the mapping of a tab to an API endpoint within tx
+func (d *cmsTabAPIDAO) DeleteByKeyTx(ctx context.Context, tx *sql.Tx, tabName, apiPath, method string) error {
+	query := `DELETE FROM cms_tab_apis WHERE tab_name = $1 AND api_path = $2 AND api_method = $3`
+
+	_, err := tx.ExecContext(ctx, query, tabName, apiPath, method)
+	if err != nil {
+		return fmt.Errorf("failed to delete cms tab-api mapping: %w", err)
+	}
+
+	return nil
+}
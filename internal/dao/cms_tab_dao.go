@@ -0,0 +1,150 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"log"
+)
+
+// CMSTabDAO defines the data access operations for the CMS tab registry
+type CMSTabDAO interface {
+	Create(ctx context.Context, tab *domain.CMSTabDefinition) error
+	FindByKey(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error)
+	List(ctx context.Context) ([]*domain.CMSTabDefinition, error)
+	Update(ctx context.Context, tab *domain.CMSTabDefinition) error
+	Delete(ctx context.Context, key domain.CMSTab) error
+	CountRolesUsingTab(ctx context.Context, key domain.CMSTab) (int, error)
+}
+
+type cmsTabDAO struct {
+	db *sql.DB
+}
+
+// NewCMSTabDAO creates a new instance of CMSTabDAO
+func NewCMSTabDAO(db *sql.DB) CMSTabDAO {
+	return &cmsTabDAO{db: db}
+}
+
+func (d *cmsTabDAO) Create(ctx context.Context, tab *domain.CMSTabDefinition) error {
+	query := `
+		INSERT INTO cms_tabs (key, display_name, icon, parent_key, sort_order, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := d.db.ExecContext(ctx, query,
+		tab.Key,
+		tab.DisplayName,
+		tab.Icon,
+		nullableTabKey(tab.ParentKey),
+		tab.SortOrder,
+		tab.CreatedAt,
+		tab.UpdatedAt,
+	)
+	return err
+}
+
+func (d *cmsTabDAO) FindByKey(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error) {
+	query := `
+		SELECT key, display_name, icon, parent_key, sort_order, created_at, updated_at
+		FROM cms_tabs
+		WHERE key = $1
+	`
+	tab := &domain.CMSTabDefinition{}
+	var parentKey sql.NullString
+
+	err := d.db.QueryRowContext(ctx, query, key).Scan(
+		&tab.Key,
+		&tab.DisplayName,
+		&tab.Icon,
+		&parentKey,
+		&tab.SortOrder,
+		&tab.CreatedAt,
+		&tab.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tab.ParentKey = domain.CMSTab(parentKey.String)
+	return tab, nil
+}
+
+func (d *cmsTabDAO) List(ctx context.Context) ([]*domain.CMSTabDefinition, error) {
+	query := `
+		SELECT key, display_name, icon, parent_key, sort_order, created_at, updated_at
+		FROM cms_tabs
+		ORDER BY sort_order, key
+	`
+	rows, err := d.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var tabs []*domain.CMSTabDefinition
+	for rows.Next() {
+		tab := &domain.CMSTabDefinition{}
+		var parentKey sql.NullString
+
+		err := rows.Scan(
+			&tab.Key,
+			&tab.DisplayName,
+			&tab.Icon,
+			&parentKey,
+			&tab.SortOrder,
+			&tab.CreatedAt,
+			&tab.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tab.ParentKey = domain.CMSTab(parentKey.String)
+		tabs = append(tabs, tab)
+	}
+	return tabs, rows.Err()
+}
+
+func (d *cmsTabDAO) Update(ctx context.Context, tab *domain.CMSTabDefinition) error {
+	query := `
+		UPDATE cms_tabs
+		SET display_name = $2, icon = $3, parent_key = $4, sort_order = $5, updated_at = $6
+		WHERE key = $1
+	`
+	_, err := d.db.ExecContext(ctx, query,
+		tab.Key,
+		tab.DisplayName,
+		tab.Icon,
+		nullableTabKey(tab.ParentKey),
+		tab.SortOrder,
+		tab.UpdatedAt,
+	)
+	return err
+}
+
+func (d *cmsTabDAO) Delete(ctx context.Context, key domain.CMSTab) error {
+	query := `DELETE FROM cms_tabs WHERE key = $1`
+	_, err := d.db.ExecContext(ctx, query, key)
+	return err
+}
+
+func (d *cmsTabDAO) CountRolesUsingTab(ctx context.Context, key domain.CMSTab) (int, error) {
+	query := `SELECT COUNT(*) FROM cms_roles WHERE $1 = ANY(tabs)`
+	var count int
+	err := d.db.QueryRowContext(ctx, query, key).Scan(&count)
+	return count, err
+}
+
+// nullableTabKey stores an empty parent key as NULL so the foreign key is not checked
+func nullableTabKey(key domain.CMSTab) sql.NullString {
+	return sql.NullString{String: string(key), Valid: key != ""}
+}
@@ -0,0 +1,334 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// RelationTupleDAO defines the data access operations for relation tuples and the namespace
+// configs they are evaluated with
+type RelationTupleDAO interface {
+	SaveNamespace(ctx context.Context, namespace *domain.RelationNamespace) error
+	FindNamespace(ctx context.Context, name string) (*domain.RelationNamespace, error)
+	ListNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error)
+	DeleteNamespace(ctx context.Context, name string) error
+	ListRelationsInUse(ctx context.Context, namespace string) ([]string, error)
+
+	Write(ctx context.Context, writes, deletes []*domain.RelationTuple) error
+	FindByObjects(ctx context.Context, namespace string, objectIDs []string) ([]*domain.RelationTuple, error)
+	List(ctx context.Context, filter domain.RelationTupleFilter, limit, offset int) ([]*domain.RelationTuple, error)
+	Count(ctx context.Context, filter domain.RelationTupleFilter) (int, error)
+	ListObjectIDs(ctx context.Context, namespace string) ([]string, error)
+}
+
+type relationTupleDAO struct {
+	db *sql.DB
+}
+
+// NewRelationTupleDAO creates a new instance of RelationTupleDAO
+func NewRelationTupleDAO(db *sql.DB) RelationTupleDAO {
+	return &relationTupleDAO{db: db}
+}
+
+// SaveNamespace creates a namespace or replaces its relations
+func (d *relationTupleDAO) SaveNamespace(ctx context.Context, namespace *domain.RelationNamespace) error {
+	relations, err := json.Marshal(namespace.Relations)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO relation_namespaces (name, relations, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (name) DO UPDATE SET relations = EXCLUDED.relations, updated_at = EXCLUDED.updated_at
+		RETURNING created_at
+	`
+	return d.db.QueryRowContext(ctx, query,
+		namespace.Name,
+		relations,
+		namespace.CreatedAt,
+		namespace.UpdatedAt,
+	).Scan(&namespace.CreatedAt)
+}
+
+func (d *relationTupleDAO) FindNamespace(ctx context.Context, name string) (*domain.RelationNamespace, error) {
+	query := `
+		SELECT name, relations, created_at, updated_at
+		FROM relation_namespaces
+		WHERE name = $1
+	`
+	namespace, err := scanRelationNamespace(d.db.QueryRowContext(ctx, query, name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return namespace, err
+}
+
+func (d *relationTupleDAO) ListNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error) {
+	query := `
+		SELECT name, relations, created_at, updated_at
+		FROM relation_namespaces
+		ORDER BY name
+	`
+	rows, err := d.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var namespaces []*domain.RelationNamespace
+	for rows.Next() {
+		namespace, err := scanRelationNamespace(rows)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces, rows.Err()
+}
+
+func (d *relationTupleDAO) DeleteNamespace(ctx context.Context, name string) error {
+	_, err := d.db.ExecContext(ctx, `DELETE FROM relation_namespaces WHERE name = $1`, name)
+	return err
+}
+
+// ListRelationsInUse returns the relations of a namespace that tuples refer to, as their relation
+// or as the relation of their userset subject
+func (d *relationTupleDAO) ListRelationsInUse(ctx context.Context, namespace string) ([]string, error) {
+	query := `
+		SELECT relation FROM relation_tuples WHERE namespace = $1
+		UNION
+		SELECT subject_relation FROM relation_tuples WHERE subject_namespace = $1 AND subject_relation <> ''
+		ORDER BY 1
+	`
+	rows, err := d.db.QueryContext(ctx, query, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var relations []string
+	for rows.Next() {
+		var relation string
+		if err := rows.Scan(&relation); err != nil {
+			return nil, err
+		}
+		relations = append(relations, relation)
+	}
+	return relations, rows.Err()
+}
+
+// Write inserts and deletes tuples atomically. Writing an existing tuple or deleting a missing one
+// changes nothing.
+func (d *relationTupleDAO) Write(ctx context.Context, writes, deletes []*domain.RelationTuple) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back relation tuples:", err)
+		}
+	}()
+
+	for _, tuple := range deletes {
+		query := `
+			DELETE FROM relation_tuples
+			WHERE namespace = $1 AND object_id = $2 AND relation = $3
+			  AND subject_namespace = $4 AND subject_id = $5 AND subject_relation = $6
+		`
+		_, err := tx.ExecContext(ctx, query,
+			tuple.Namespace,
+			tuple.ObjectID,
+			tuple.Relation,
+			tuple.Subject.Namespace,
+			tuple.Subject.ObjectID,
+			tuple.Subject.Relation,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	for _, tuple := range writes {
+		query := `
+			INSERT INTO relation_tuples (namespace, object_id, relation, subject_namespace, subject_id, subject_relation)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT DO NOTHING
+		`
+		_, err := tx.ExecContext(ctx, query,
+			tuple.Namespace,
+			tuple.ObjectID,
+			tuple.Relation,
+			tuple.Subject.Namespace,
+			tuple.Subject.ObjectID,
+			tuple.Subject.Relation,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// FindByObjects returns the tuples of every relation on the given objects of a namespace
+func (d *relationTupleDAO) FindByObjects(ctx context.Context, namespace string, objectIDs []string) ([]*domain.RelationTuple, error) {
+	query := `
+		SELECT namespace, object_id, relation, subject_namespace, subject_id, subject_relation
+		FROM relation_tuples
+		WHERE namespace = $1 AND object_id = ANY($2)
+		ORDER BY object_id, relation, subject_namespace, subject_id, subject_relation
+	`
+	rows, err := d.db.QueryContext(ctx, query, namespace, pq.Array(objectIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+	return scanRelationTuples(rows)
+}
+
+// List returns the tuples matching filter; a zero limit returns all of them
+func (d *relationTupleDAO) List(ctx context.Context, filter domain.RelationTupleFilter, limit, offset int) ([]*domain.RelationTuple, error) {
+	where, args := relationTupleConditions(filter)
+	query := `
+		SELECT namespace, object_id, relation, subject_namespace, subject_id, subject_relation
+		FROM relation_tuples
+	` + where + ` ORDER BY namespace, object_id, relation, subject_namespace, subject_id, subject_relation`
+	if limit > 0 {
+		query += fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+		args = append(args, limit, offset)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+	return scanRelationTuples(rows)
+}
+
+func scanRelationTuples(rows *sql.Rows) ([]*domain.RelationTuple, error) {
+	var tuples []*domain.RelationTuple
+	for rows.Next() {
+		tuple := &domain.RelationTuple{}
+		err := rows.Scan(
+			&tuple.Namespace,
+			&tuple.ObjectID,
+			&tuple.Relation,
+			&tuple.Subject.Namespace,
+			&tuple.Subject.ObjectID,
+			&tuple.Subject.Relation,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples, rows.Err()
+}
+
+func (d *relationTupleDAO) Count(ctx context.Context, filter domain.RelationTupleFilter) (int, error) {
+	where, args := relationTupleConditions(filter)
+	var count int
+	err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM relation_tuples `+where, args...).Scan(&count)
+	return count, err
+}
+
+// ListObjectIDs returns the objects of a namespace with at least one tuple
+func (d *relationTupleDAO) ListObjectIDs(ctx context.Context, namespace string) ([]string, error) {
+	query := `
+		SELECT DISTINCT object_id
+		FROM relation_tuples
+		WHERE namespace = $1
+		ORDER BY object_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func relationTupleConditions(filter domain.RelationTupleFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	add := func(column string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if filter.Namespace != "" {
+		add("namespace", filter.Namespace)
+	}
+	if filter.ObjectID != "" {
+		add("object_id", filter.ObjectID)
+	}
+	if filter.Relation != "" {
+		add("relation", filter.Relation)
+	}
+	if filter.Subject != nil {
+		add("subject_namespace", filter.Subject.Namespace)
+		add("subject_id", filter.Subject.ObjectID)
+		add("subject_relation", filter.Subject.Relation)
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func scanRelationNamespace(row rowScanner) (*domain.RelationNamespace, error) {
+	namespace := &domain.RelationNamespace{}
+	var relations []byte
+	err := row.Scan(
+		&namespace.Name,
+		&relations,
+		&namespace.CreatedAt,
+		&namespace.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(relations, &namespace.Relations); err != nil {
+		return nil, fmt.Errorf("invalid relations of namespace %s: %w", namespace.Name, err)
+	}
+	return namespace, nil
+}
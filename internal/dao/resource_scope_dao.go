@@ -0,0 +1,300 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// ResourceScopeDAO defines the data access operations for the resource scope hierarchy and the
+// CMS roles assigned within scopes
+type ResourceScopeDAO interface {
+	Create(ctx context.Context, scope *domain.ResourceScopeDefinition) error
+	FindByKey(ctx context.Context, scope domain.ResourceScope) (*domain.ResourceScopeDefinition, error)
+	List(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error)
+	CountChildren(ctx context.Context, scope domain.ResourceScope) (int, error)
+	Delete(ctx context.Context, scope domain.ResourceScope) error
+
+	AssignCMSRole(ctx context.Context, assignment *domain.ScopedCMSRoleAssignment) error
+	RemoveCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error
+	ListUserCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error)
+	ListCMSRoleNamesInScope(ctx context.Context, userID string, scope domain.ResourceScope, now time.Time) ([]string, error)
+	ListExpiredCMSRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, now time.Time) (bool, error)
+}
+
+type resourceScopeDAO struct {
+	db *sql.DB
+}
+
+// NewResourceScopeDAO creates a new instance of ResourceScopeDAO
+func NewResourceScopeDAO(db *sql.DB) ResourceScopeDAO {
+	return &resourceScopeDAO{db: db}
+}
+
+func (d *resourceScopeDAO) Create(ctx context.Context, scope *domain.ResourceScopeDefinition) error {
+	var parentType, parentID *string
+	if scope.Parent != nil {
+		parentType, parentID = &scope.Parent.Type, &scope.Parent.ID
+	}
+	query := `
+		INSERT INTO resource_scopes (scope_type, scope_id, name, parent_type, parent_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := d.db.ExecContext(ctx, query,
+		scope.Type,
+		scope.ID,
+		scope.Name,
+		parentType,
+		parentID,
+		scope.CreatedAt,
+	)
+	return err
+}
+
+func (d *resourceScopeDAO) FindByKey(ctx context.Context, key domain.ResourceScope) (*domain.ResourceScopeDefinition, error) {
+	query := `
+		SELECT scope_type, scope_id, name, parent_type, parent_id, created_at
+		FROM resource_scopes
+		WHERE scope_type = $1 AND scope_id = $2
+	`
+	scope, err := scanResourceScope(d.db.QueryRowContext(ctx, query, key.Type, key.ID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return scope, err
+}
+
+// List returns the scopes of a type, or all scopes when scopeType is empty
+func (d *resourceScopeDAO) List(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error) {
+	query := `
+		SELECT scope_type, scope_id, name, parent_type, parent_id, created_at
+		FROM resource_scopes
+		WHERE $1 = '' OR scope_type = $1
+		ORDER BY scope_type, scope_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, scopeType)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var scopes []*domain.ResourceScopeDefinition
+	for rows.Next() {
+		scope, err := scanResourceScope(rows)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, rows.Err()
+}
+
+func (d *resourceScopeDAO) CountChildren(ctx context.Context, scope domain.ResourceScope) (int, error) {
+	query := `SELECT COUNT(*) FROM resource_scopes WHERE parent_type = $1 AND parent_id = $2`
+	var count int
+	err := d.db.QueryRowContext(ctx, query, scope.Type, scope.ID).Scan(&count)
+	return count, err
+}
+
+func (d *resourceScopeDAO) Delete(ctx context.Context, scope domain.ResourceScope) error {
+	query := `DELETE FROM resource_scopes WHERE scope_type = $1 AND scope_id = $2`
+	_, err := d.db.ExecContext(ctx, query, scope.Type, scope.ID)
+	return err
+}
+
+func (d *resourceScopeDAO) AssignCMSRole(ctx context.Context, assignment *domain.ScopedCMSRoleAssignment) error {
+	query := `
+		INSERT INTO user_cms_role_scopes (user_id, cms_role_id, scope_type, scope_id, starts_at, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, cms_role_id, scope_type, scope_id) DO UPDATE
+		SET starts_at = EXCLUDED.starts_at, expires_at = EXCLUDED.expires_at
+	`
+	_, err := d.db.ExecContext(ctx, query,
+		assignment.UserID,
+		assignment.CMSRoleID,
+		assignment.Scope.Type,
+		assignment.Scope.ID,
+		assignment.StartsAt,
+		assignment.ExpiresAt,
+		assignment.CreatedAt,
+	)
+	return err
+}
+
+func (d *resourceScopeDAO) RemoveCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error {
+	query := `
+		DELETE FROM user_cms_role_scopes
+		WHERE user_id = $1 AND cms_role_id = $2 AND scope_type = $3 AND scope_id = $4
+	`
+	_, err := d.db.ExecContext(ctx, query, userID, cmsRoleID, scope.Type, scope.ID)
+	return err
+}
+
+// ListUserCMSRoles returns a user's scoped CMS role assignments, or everyone's for an empty user ID
+func (d *resourceScopeDAO) ListUserCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error) {
+	query := `
+		SELECT a.user_id, a.cms_role_id, r.name, a.scope_type, a.scope_id, a.starts_at, a.expires_at, a.created_at
+		FROM user_cms_role_scopes a
+		INNER JOIN cms_roles r ON r.id = a.cms_role_id
+		WHERE $1 = '' OR a.user_id = $1
+		ORDER BY a.scope_type, a.scope_id, r.name
+	`
+	rows, err := d.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var assignments []*domain.ScopedCMSRoleAssignment
+	for rows.Next() {
+		assignment := &domain.ScopedCMSRoleAssignment{}
+		err := rows.Scan(
+			&assignment.UserID,
+			&assignment.CMSRoleID,
+			&assignment.CMSRoleName,
+			&assignment.Scope.Type,
+			&assignment.Scope.ID,
+			&assignment.StartsAt,
+			&assignment.ExpiresAt,
+			&assignment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// ListCMSRoleNamesInScope returns the CMS roles a user holds at now within the scope or any of
+// its registered ancestors
+func (d *resourceScopeDAO) ListCMSRoleNamesInScope(ctx context.Context, userID string, scope domain.ResourceScope, now time.Time) ([]string, error) {
+	query := `
+		WITH RECURSIVE scopes(scope_type, scope_id, depth) AS (
+			SELECT $2::varchar, $3::varchar, 0
+			UNION
+			SELECT s.parent_type, s.parent_id, scopes.depth + 1
+			FROM resource_scopes s
+			INNER JOIN scopes ON s.scope_type = scopes.scope_type AND s.scope_id = scopes.scope_id
+			WHERE s.parent_type IS NOT NULL AND scopes.depth < $5
+		)
+		SELECT DISTINCT r.name
+		FROM user_cms_role_scopes a
+		INNER JOIN scopes ON a.scope_type = scopes.scope_type AND a.scope_id = scopes.scope_id
+		INNER JOIN cms_roles r ON r.id = a.cms_role_id
+		WHERE a.user_id = $1
+			AND (a.starts_at IS NULL OR a.starts_at <= $4)
+			AND (a.expires_at IS NULL OR a.expires_at > $4)
+		ORDER BY r.name
+	`
+	rows, err := d.db.QueryContext(ctx, query, userID, scope.Type, scope.ID, now, domain.MaxResourceScopeDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// ListExpiredCMSRoles returns the scoped assignments whose window closed at or before now, oldest
+// first
+func (d *resourceScopeDAO) ListExpiredCMSRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	query := `
+		SELECT a.user_id, a.cms_role_id, r.name, a.scope_type, a.scope_id, a.expires_at
+		FROM user_cms_role_scopes a
+		INNER JOIN cms_roles r ON r.id = a.cms_role_id
+		WHERE a.expires_at IS NOT NULL AND a.expires_at <= $1
+		ORDER BY a.expires_at
+	`
+	rows, err := d.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var assignments []*domain.ExpiredAssignment
+	for rows.Next() {
+		assignment := &domain.ExpiredAssignment{Domain: domain.DomainCMS, Scope: &domain.ResourceScope{}}
+		err := rows.Scan(
+			&assignment.UserID,
+			&assignment.RoleID,
+			&assignment.RoleName,
+			&assignment.Scope.Type,
+			&assignment.Scope.ID,
+			&assignment.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// RemoveExpiredCMSRole deletes a scoped assignment only if it is still expired at now. It reports
+// whether the assignment was deleted.
+func (d *resourceScopeDAO) RemoveExpiredCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, now time.Time) (bool, error) {
+	query := `
+		DELETE FROM user_cms_role_scopes
+		WHERE user_id = $1 AND cms_role_id = $2 AND scope_type = $3 AND scope_id = $4
+			AND expires_at IS NOT NULL AND expires_at <= $5
+	`
+	result, err := d.db.ExecContext(ctx, query, userID, cmsRoleID, scope.Type, scope.ID, now)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func scanResourceScope(row rowScanner) (*domain.ResourceScopeDefinition, error) {
+	scope := &domain.ResourceScopeDefinition{}
+	var parentType, parentID sql.NullString
+	err := row.Scan(
+		&scope.Type,
+		&scope.ID,
+		&scope.Name,
+		&parentType,
+		&parentID,
+		&scope.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if parentType.Valid && parentID.Valid {
+		scope.Parent = &domain.ResourceScope{Type: parentType.String, ID: parentID.String}
+	}
+	return scope, nil
+}
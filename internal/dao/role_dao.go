@@ -15,10 +15,17 @@ type RoleDAO interface {
 	FindByName(ctx context.Context, name string) (*domain.Role, error)
 	List(ctx context.Context, limit, offset int) ([]*domain.Role, error)
 	Update(ctx context.Context, role *domain.Role) error
+	UpdateTx(ctx context.Context, tx *sql.Tx, role *domain.Role) error
 	Delete(ctx context.Context, id string) error
+	DeleteTx(ctx context.Context, tx *sql.Tx, id string) error
 	Count(ctx context.Context) (int, error)
 }
 
+// execer runs a statement on the database or within a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
 type roleDAO struct {
 	db *sql.DB
 }
@@ -126,12 +133,21 @@ func (d *roleDAO) List(ctx context.Context, limit, offset int) ([]*domain.Role,
 }
 
 func (d *roleDAO) Update(ctx context.Context, role *domain.Role) error {
+	return d.update(ctx, d.db, role)
+}
+
+// UpdateTx updates a role within tx
+func (d *roleDAO) UpdateTx(ctx context.Context, tx *sql.Tx, role *domain.Role) error {
+	return d.update(ctx, tx, role)
+}
+
+func (d *roleDAO) update(ctx context.Context, db execer, role *domain.Role) error {
 	query := `
 		UPDATE roles
 		SET name = $2, description = $3, updated_at = $4
 		WHERE id = $1
 	`
-	_, err := d.db.ExecContext(ctx, query,
+	_, err := db.ExecContext(ctx, query,
 		role.ID,
 		role.Name,
 		role.Description,
@@ -146,6 +162,13 @@ func (d *roleDAO) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+// DeleteTx deletes a role within tx
+func (d *roleDAO) DeleteTx(ctx context.Context, tx *sql.Tx, id string) error {
+	query := `DELETE FROM roles WHERE id = $1`
+	_, err := tx.ExecContext(ctx, query, id)
+	return err
+}
+
 func (d *roleDAO) Count(ctx context.Context) (int, error) {
 	query := `SELECT COUNT(*) FROM roles`
 	var count int
@@ -0,0 +1,182 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// SoDConstraintDAO defines the data access operations for separation-of-duties constraints
+type SoDConstraintDAO interface {
+	Create(ctx context.Context, constraint *domain.SoDConstraint) error
+	FindByID(ctx context.Context, id string) (*domain.SoDConstraint, error)
+	List(ctx context.Context) ([]*domain.SoDConstraint, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type sodConstraintDAO struct {
+	db *sql.DB
+}
+
+// NewSoDConstraintDAO creates a new instance of SoDConstraintDAO
+func NewSoDConstraintDAO(db *sql.DB) SoDConstraintDAO {
+	return &sodConstraintDAO{db: db}
+}
+
+func (d *sodConstraintDAO) Create(ctx context.Context, constraint *domain.SoDConstraint) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Println("Error rolling back SoD constraint:", err)
+		}
+	}()
+
+	query := `
+		INSERT INTO sod_constraints (id, name, description, mode, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		constraint.ID,
+		constraint.Name,
+		constraint.Description,
+		constraint.Mode,
+		constraint.CreatedAt,
+		constraint.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range constraint.Roles {
+		query := `
+			INSERT INTO sod_constraint_roles (constraint_id, role_kind, role_id)
+			VALUES ($1, $2, $3)
+		`
+		if _, err := tx.ExecContext(ctx, query, constraint.ID, role.Kind, role.RoleID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *sodConstraintDAO) FindByID(ctx context.Context, id string) (*domain.SoDConstraint, error) {
+	query := `
+		SELECT id, name, description, mode, created_at, updated_at
+		FROM sod_constraints
+		WHERE id = $1
+	`
+	constraint := &domain.SoDConstraint{}
+	err := d.db.QueryRowContext(ctx, query, id).Scan(
+		&constraint.ID,
+		&constraint.Name,
+		&constraint.Description,
+		&constraint.Mode,
+		&constraint.CreatedAt,
+		&constraint.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := d.listRoles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	constraint.Roles = roles[id]
+	return constraint, nil
+}
+
+func (d *sodConstraintDAO) List(ctx context.Context) ([]*domain.SoDConstraint, error) {
+	query := `
+		SELECT id, name, description, mode, created_at, updated_at
+		FROM sod_constraints
+		ORDER BY name
+	`
+	rows, err := d.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var constraints []*domain.SoDConstraint
+	for rows.Next() {
+		constraint := &domain.SoDConstraint{}
+		err := rows.Scan(
+			&constraint.ID,
+			&constraint.Name,
+			&constraint.Description,
+			&constraint.Mode,
+			&constraint.CreatedAt,
+			&constraint.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	roles, err := d.listRoles(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, constraint := range constraints {
+		constraint.Roles = roles[constraint.ID]
+	}
+	return constraints, nil
+}
+
+// listRoles returns the roles of one constraint, or of all of them when constraintID is empty,
+// keyed by constraint. Role names are empty once the role was deleted.
+func (d *sodConstraintDAO) listRoles(ctx context.Context, constraintID string) (map[string][]domain.SoDRole, error) {
+	query := `
+		SELECT scr.constraint_id, scr.role_kind, scr.role_id,
+		       COALESCE(CASE WHEN scr.role_kind = 'role' THEN r.name ELSE cr.name END, '')
+		FROM sod_constraint_roles scr
+		LEFT JOIN roles r ON scr.role_kind = 'role' AND r.id = scr.role_id
+		LEFT JOIN cms_roles cr ON scr.role_kind = 'cms_role' AND cr.id = scr.role_id
+		WHERE $1 = '' OR scr.constraint_id = $1
+		ORDER BY scr.role_kind, scr.role_id
+	`
+	rows, err := d.db.QueryContext(ctx, query, constraintID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	roles := make(map[string][]domain.SoDRole)
+	for rows.Next() {
+		var id string
+		var role domain.SoDRole
+		if err := rows.Scan(&id, &role.Kind, &role.RoleID, &role.RoleName); err != nil {
+			return nil, err
+		}
+		roles[id] = append(roles[id], role)
+	}
+	return roles, rows.Err()
+}
+
+func (d *sodConstraintDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.db.ExecContext(ctx, `DELETE FROM sod_constraints WHERE id = $1`, id)
+	return err
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/tvttt/iam-services/internal/domain"
@@ -13,6 +14,8 @@ import (
 type UserCMSRoleDAO interface {
 	AssignCMSRole(ctx context.Context, userCMSRole *domain.UserCMSRole) error
 	RemoveCMSRole(ctx context.Context, userID, cmsRoleID string) error
+	ListExpiredCMSRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredCMSRoleTx(ctx context.Context, tx *sql.Tx, userID, cmsRoleID string, now time.Time) (bool, error)
 	GetUserCMSRoles(ctx context.Context, userID string) ([]*domain.CMSRole, error)
 	GetCMSRoleUsers(ctx context.Context, cmsRoleID string) ([]string, error)
 	HasCMSRole(ctx context.Context, userID, cmsRoleID string) (bool, error)
@@ -30,13 +33,16 @@ func NewUserCMSRoleDAO(db *sql.DB) UserCMSRoleDAO {
 
 func (d *userCMSRoleDAO) AssignCMSRole(ctx context.Context, userCMSRole *domain.UserCMSRole) error {
 	query := `
-		INSERT INTO user_cms_roles (user_id, cms_role_id, created_at)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (user_id, cms_role_id) DO NOTHING
+		INSERT INTO user_cms_roles (user_id, cms_role_id, starts_at, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, cms_role_id) DO UPDATE
+		SET starts_at = EXCLUDED.starts_at, expires_at = EXCLUDED.expires_at
 	`
 	_, err := d.db.ExecContext(ctx, query,
 		userCMSRole.UserID,
 		userCMSRole.CMSRoleID,
+		userCMSRole.StartsAt,
+		userCMSRole.ExpiresAt,
 		userCMSRole.CreatedAt,
 	)
 	return err
@@ -50,13 +56,15 @@ func (d *userCMSRoleDAO) RemoveCMSRole(ctx context.Context, userID, cmsRoleID st
 
 func (d *userCMSRoleDAO) GetUserCMSRoles(ctx context.Context, userID string) ([]*domain.CMSRole, error) {
 	query := `
-		SELECT r.id, r.name, r.description, r.tabs, r.created_at, r.updated_at
+		SELECT r.id, r.name, r.description, r.tabs, r.tab_actions, r.created_at, r.updated_at
 		FROM cms_roles r
 		INNER JOIN user_cms_roles ucr ON r.id = ucr.cms_role_id
 		WHERE ucr.user_id = $1
+			AND (ucr.starts_at IS NULL OR ucr.starts_at <= $2)
+			AND (ucr.expires_at IS NULL OR ucr.expires_at > $2)
 		ORDER BY r.name
 	`
-	rows, err := d.db.QueryContext(ctx, query, userID)
+	rows, err := d.db.QueryContext(ctx, query, userID, time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -71,12 +79,14 @@ func (d *userCMSRoleDAO) GetUserCMSRoles(ctx context.Context, userID string) ([]
 	for rows.Next() {
 		role := &domain.CMSRole{}
 		var tabs []string
+		var tabActions []byte
 
 		err := rows.Scan(
 			&role.ID,
 			&role.Name,
 			&role.Description,
 			pq.Array(&tabs),
+			&tabActions,
 			&role.CreatedAt,
 			&role.UpdatedAt,
 		)
@@ -84,9 +94,8 @@ func (d *userCMSRoleDAO) GetUserCMSRoles(ctx context.Context, userID string) ([]
 			return nil, err
 		}
 
-		role.Tabs = make([]domain.CMSTab, len(tabs))
-		for i, tab := range tabs {
-			role.Tabs[i] = domain.CMSTab(tab)
+		if err := decodeCMSTabActions(role, tabs, tabActions); err != nil {
+			return nil, err
 		}
 
 		roles = append(roles, role)
@@ -135,3 +144,46 @@ func (d *userCMSRoleDAO) RemoveAllCMSRolesFromUser(ctx context.Context, userID s
 	_, err := d.db.ExecContext(ctx, query, userID)
 	return err
 }
+
+// ListExpiredCMSRoles returns the assignments whose window closed at or before now, oldest first
+func (d *userCMSRoleDAO) ListExpiredCMSRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	query := `
+		SELECT a.user_id, a.cms_role_id, r.name, a.expires_at
+		FROM user_cms_roles a
+		INNER JOIN cms_roles r ON r.id = a.cms_role_id
+		WHERE a.expires_at IS NOT NULL AND a.expires_at <= $1
+		ORDER BY a.expires_at
+	`
+	rows, err := d.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var assignments []*domain.ExpiredAssignment
+	for rows.Next() {
+		assignment := &domain.ExpiredAssignment{Domain: domain.DomainCMS}
+		if err := rows.Scan(&assignment.UserID, &assignment.RoleID, &assignment.RoleName, &assignment.ExpiresAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// RemoveExpiredCMSRole deletes an assignment only if it is still expired at now, so an assignment
+// renewed in the meantime is kept. It reports whether the assignment was deleted.
+func (d *userCMSRoleDAO) RemoveExpiredCMSRoleTx(ctx context.Context, tx *sql.Tx, userID, cmsRoleID string, now time.Time) (bool, error) {
+	query := `DELETE FROM user_cms_roles WHERE user_id = $1 AND cms_role_id = $2 AND expires_at IS NOT NULL AND expires_at <= $3`
+	result, err := tx.ExecContext(ctx, query, userID, cmsRoleID, now)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
@@ -3,8 +3,11 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"log"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/tvttt/iam-services/internal/domain"
 )
 
@@ -12,6 +15,7 @@ import (
 type UserDAO interface {
 	Create(ctx context.Context, user *domain.User) error
 	FindByID(ctx context.Context, id string) (*domain.User, error)
+	FindExistingIDs(ctx context.Context, ids []string) ([]string, error)
 	FindByUsername(ctx context.Context, username string) (*domain.User, error)
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
@@ -71,6 +75,32 @@ func (d *userDAO) FindByID(ctx context.Context, id string) (*domain.User, error)
 	return user, nil
 }
 
+// FindExistingIDs returns those of ids that are user IDs, in one query
+func (d *userDAO) FindExistingIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := d.db.QueryContext(ctx, `SELECT id FROM users WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var existing []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		existing = append(existing, id)
+	}
+	return existing, rows.Err()
+}
+
 func (d *userDAO) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
 	query := `
 		SELECT id, username, email, password_hash, full_name, is_active, created_at, updated_at
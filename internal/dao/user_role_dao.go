@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/tvttt/iam-services/internal/domain"
 	"log"
@@ -12,6 +13,8 @@ import (
 type UserRoleDAO interface {
 	AssignRole(ctx context.Context, userRole *domain.UserRole) error
 	RemoveRole(ctx context.Context, userID, roleID string) error
+	ListExpiredRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredRoleTx(ctx context.Context, tx *sql.Tx, userID, roleID string, now time.Time) (bool, error)
 	GetUserRoles(ctx context.Context, userID string) ([]*domain.Role, error)
 	GetRoleUsers(ctx context.Context, roleID string) ([]*domain.User, error)
 	HasRole(ctx context.Context, userID, roleID string) (bool, error)
@@ -28,13 +31,16 @@ func NewUserRoleDAO(db *sql.DB) UserRoleDAO {
 
 func (d *userRoleDAO) AssignRole(ctx context.Context, userRole *domain.UserRole) error {
 	query := `
-		INSERT INTO user_roles (user_id, role_id, created_at)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (user_id, role_id) DO NOTHING
+		INSERT INTO user_roles (user_id, role_id, starts_at, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, role_id) DO UPDATE
+		SET starts_at = EXCLUDED.starts_at, expires_at = EXCLUDED.expires_at
 	`
 	_, err := d.db.ExecContext(ctx, query,
 		userRole.UserID,
 		userRole.RoleID,
+		userRole.StartsAt,
+		userRole.ExpiresAt,
 		userRole.CreatedAt,
 	)
 	return err
@@ -130,3 +136,46 @@ func (d *userRoleDAO) HasRole(ctx context.Context, userID, roleID string) (bool,
 	err := d.db.QueryRowContext(ctx, query, userID, roleID).Scan(&exists)
 	return exists, err
 }
+
+// ListExpiredRoles returns the assignments whose window closed at or before now, oldest first
+func (d *userRoleDAO) ListExpiredRoles(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	query := `
+		SELECT a.user_id, a.role_id, r.name, a.expires_at
+		FROM user_roles a
+		INNER JOIN roles r ON r.id = a.role_id
+		WHERE a.expires_at IS NOT NULL AND a.expires_at <= $1
+		ORDER BY a.expires_at
+	`
+	rows, err := d.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Println("Error closing rows:", err)
+		}
+	}()
+
+	var assignments []*domain.ExpiredAssignment
+	for rows.Next() {
+		assignment := &domain.ExpiredAssignment{Domain: domain.DomainUser}
+		if err := rows.Scan(&assignment.UserID, &assignment.RoleID, &assignment.RoleName, &assignment.ExpiresAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// RemoveExpiredRoleTx deletes an assignment within tx only if it is still expired at now, so an
+// assignment renewed in the meantime is kept. It reports whether the assignment was deleted.
+func (d *userRoleDAO) RemoveExpiredRoleTx(ctx context.Context, tx *sql.Tx, userID, roleID string, now time.Time) (bool, error) {
+	query := `DELETE FROM user_roles WHERE user_id = $1 AND role_id = $2 AND expires_at IS NOT NULL AND expires_at <= $3`
+	result, err := tx.ExecContext(ctx, query, userID, roleID, now)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// AccessRequestStatus is the state of an access request
+type AccessRequestStatus string
+
+const (
+	// AccessRequestPending waits for an approver's decision
+	AccessRequestPending AccessRequestStatus = "pending"
+	// AccessRequestApproved was approved and the role assigned
+	AccessRequestApproved AccessRequestStatus = "approved"
+	// AccessRequestDenied was denied by an approver
+	AccessRequestDenied AccessRequestStatus = "denied"
+	// AccessRequestExpired was not decided in time
+	AccessRequestExpired AccessRequestStatus = "expired"
+)
+
+// IsValid reports whether the status is known
+func (s AccessRequestStatus) IsValid() bool {
+	switch s {
+	case AccessRequestPending, AccessRequestApproved, AccessRequestDenied, AccessRequestExpired:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrAccessRequestNotPending is returned when deciding a request that was already decided or expired
+	ErrAccessRequestNotPending = errors.New("access request is not pending")
+	// ErrNotAccessRequestApprover is returned when a user may not decide a request
+	ErrNotAccessRequestApprover = errors.New("user is not an approver for this role")
+	// ErrNotAccessRequestAdmin is returned when a user may not change the approvers of a role
+	ErrNotAccessRequestAdmin = errors.New("user may not change access request approvers")
+)
+
+// AccessRequest is a user's request to be granted a role
+type AccessRequest struct {
+	ID          string   `json:"id"`
+	RequesterID string   `json:"requester_id"`
+	RoleKind    RoleKind `json:"role_kind"`
+	RoleID      string   `json:"role_id"`
+	// RoleName is empty once the role was deleted
+	RoleName      string `json:"role_name"`
+	Justification string `json:"justification"`
+	// Duration is how long the role is granted once approved; zero grants it permanently
+	Duration        time.Duration       `json:"duration"`
+	Status          AccessRequestStatus `json:"status"`
+	DecidedBy       string              `json:"decided_by,omitempty"`
+	DecidedAt       *time.Time          `json:"decided_at,omitempty"`
+	DecisionComment string              `json:"decision_comment,omitempty"`
+	// ExpiresAt is when the request expires if still pending
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AccessRequestDecision is an audit entry: a request entering a status
+type AccessRequestDecision struct {
+	ID        string `json:"id"`
+	RequestID string `json:"request_id"`
+	// ActorID is the requester for submissions, the approver for decisions and empty for expiries
+	ActorID   string              `json:"actor_id"`
+	Status    AccessRequestStatus `json:"status"`
+	Comment   string              `json:"comment,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// AccessRequestFilter narrows an access request listing; empty fields match everything
+type AccessRequestFilter struct {
+	Status      AccessRequestStatus
+	RequesterID string
+	RoleKind    RoleKind
+	RoleID      string
+}
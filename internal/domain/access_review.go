@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AccessReviewStatus is the state of an access review campaign
+type AccessReviewStatus string
+
+const (
+	// AccessReviewOpen is collecting decisions
+	AccessReviewOpen AccessReviewStatus = "open"
+	// AccessReviewClosed was closed and its revocations applied
+	AccessReviewClosed AccessReviewStatus = "closed"
+)
+
+// AccessReviewDecision is a reviewer's verdict on an assignment
+type AccessReviewDecision string
+
+const (
+	// AccessReviewPending was not reviewed yet; the assignment is kept at close
+	AccessReviewPending AccessReviewDecision = "pending"
+	// AccessReviewKeep certifies the assignment
+	AccessReviewKeep AccessReviewDecision = "keep"
+	// AccessReviewRevoke removes the assignment when the campaign closes
+	AccessReviewRevoke AccessReviewDecision = "revoke"
+)
+
+// IsValid reports whether the decision is known
+func (d AccessReviewDecision) IsValid() bool {
+	switch d {
+	case AccessReviewPending, AccessReviewKeep, AccessReviewRevoke:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrAccessReviewClosed is returned when deciding on an item of a closed campaign, or closing it twice
+	ErrAccessReviewClosed = errors.New("access review campaign is closed")
+	// ErrNotAccessReviewer is returned when a user decides on an item assigned to another reviewer
+	ErrNotAccessReviewer = errors.New("user is not the reviewer of this item")
+	// ErrNotAccessReviewManager is returned when a user without the manager role starts or closes a campaign
+	ErrNotAccessReviewManager = errors.New("user may not run access review campaigns")
+)
+
+// AccessReviewScope selects the assignments a campaign snapshots; empty fields match everything
+type AccessReviewScope struct {
+	RoleKinds []RoleKind `json:"role_kinds,omitempty"`
+	RoleIDs   []string   `json:"role_ids,omitempty"`
+	UserIDs   []string   `json:"user_ids,omitempty"`
+}
+
+// AccessReviewer reviews the assignments of a team of users
+type AccessReviewer struct {
+	ReviewerID string   `json:"reviewer_id"`
+	UserIDs    []string `json:"user_ids"`
+}
+
+// AccessReviewCampaign is a certification round over a snapshot of role assignments
+type AccessReviewCampaign struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Scope       AccessReviewScope `json:"scope"`
+	// DefaultReviewerID reviews the assignments of users outside every reviewer's team
+	DefaultReviewerID string             `json:"default_reviewer_id,omitempty"`
+	Status            AccessReviewStatus `json:"status"`
+	DueAt             *time.Time         `json:"due_at,omitempty"`
+	CreatedBy         string             `json:"created_by"`
+	ClosedBy          string             `json:"closed_by,omitempty"`
+	ClosedAt          *time.Time         `json:"closed_at,omitempty"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// AccessReviewItem is one snapshotted assignment and its review
+type AccessReviewItem struct {
+	ID         string   `json:"id"`
+	CampaignID string   `json:"campaign_id"`
+	UserID     string   `json:"user_id"`
+	RoleKind   RoleKind `json:"role_kind"`
+	RoleID     string   `json:"role_id"`
+	// RoleName is the name when the snapshot was taken
+	RoleName string `json:"role_name"`
+	// Scope limits a CMS role assignment to a resource scope; it is zero for a global assignment
+	Scope      ResourceScope        `json:"scope"`
+	StartsAt   *time.Time           `json:"starts_at,omitempty"`
+	ExpiresAt  *time.Time           `json:"expires_at,omitempty"`
+	ReviewerID string               `json:"reviewer_id"`
+	Decision   AccessReviewDecision `json:"decision"`
+	Comment    string               `json:"comment,omitempty"`
+	DecidedAt  *time.Time           `json:"decided_at,omitempty"`
+	// RevokedAt is when a revoke decision was applied; RevokeError tells why it could not be
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	RevokeError string     `json:"revoke_error,omitempty"`
+}
+
+// AccessReviewItemFilter narrows an item listing; empty fields match everything
+type AccessReviewItemFilter struct {
+	ReviewerID string
+	UserID     string
+	Decision   AccessReviewDecision
+}
+
+// AccessReviewSummary counts a campaign's items by outcome
+type AccessReviewSummary struct {
+	Total   int `json:"total"`
+	Pending int `json:"pending"`
+	Kept    int `json:"kept"`
+	Revoked int `json:"revoked"`
+	// Failed counts revoke decisions that could not be applied at close
+	Failed int `json:"failed"`
+}
+
+// AccessReviewReport is the evidence of a campaign: what was reviewed, by whom, and the outcome
+type AccessReviewReport struct {
+	Campaign    *AccessReviewCampaign `json:"campaign"`
+	Summary     *AccessReviewSummary  `json:"summary"`
+	Items       []*AccessReviewItem   `json:"items"`
+	GeneratedAt time.Time             `json:"generated_at"`
+}
+
+// AccessReviewReportFormat is a serialization format of an access review report
+type AccessReviewReportFormat string
+
+const (
+	// AccessReviewReportCSV has one row per reviewed assignment
+	AccessReviewReportCSV AccessReviewReportFormat = "csv"
+	// AccessReviewReportJSON is the whole report, campaign and summary included
+	AccessReviewReportJSON AccessReviewReportFormat = "json"
+)
+
+// EncodeAccessReviewReport serializes a report in the given format
+func EncodeAccessReviewReport(report *AccessReviewReport, format AccessReviewReportFormat) ([]byte, error) {
+	switch format {
+	case AccessReviewReportJSON:
+		return json.MarshalIndent(report, "", "  ")
+	case AccessReviewReportCSV:
+		return encodeAccessReviewReportCSV(report)
+	default:
+		return nil, fmt.Errorf("unsupported access review report format: %s", format)
+	}
+}
+
+func encodeAccessReviewReportCSV(report *AccessReviewReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	closedBy, closedAt := report.Campaign.ClosedBy, formatTime(report.Campaign.ClosedAt)
+
+	if err := w.Write([]string{
+		"campaign_id", "campaign_name", "campaign_status", "closed_by", "closed_at",
+		"user_id", "role_kind", "role_id", "role_name", "scope", "starts_at", "expires_at",
+		"reviewer_id", "decision", "comment", "decided_at", "revoked_at", "revoke_error",
+	}); err != nil {
+		return nil, err
+	}
+	for _, item := range report.Items {
+		if err := w.Write([]string{
+			report.Campaign.ID, report.Campaign.Name, string(report.Campaign.Status), closedBy, closedAt,
+			item.UserID, string(item.RoleKind), item.RoleID, item.RoleName, item.Scope.String(),
+			formatTime(item.StartsAt), formatTime(item.ExpiresAt),
+			item.ReviewerID, string(item.Decision), item.Comment,
+			formatTime(item.DecidedAt), formatTime(item.RevokedAt), item.RevokeError,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAccessReviewReport() *AccessReviewReport {
+	closedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return &AccessReviewReport{
+		Campaign: &AccessReviewCampaign{
+			ID:       "c-1",
+			Name:     "Q3, CMS access",
+			Status:   AccessReviewClosed,
+			ClosedBy: "u-admin",
+			ClosedAt: &closedAt,
+		},
+		Summary: &AccessReviewSummary{Total: 2, Kept: 1, Revoked: 1},
+		Items: []*AccessReviewItem{
+			{UserID: "u-1", RoleKind: RoleKindCMSRole, RoleID: "cr-1", RoleName: "editor", ReviewerID: "u-mgr", Decision: AccessReviewKeep},
+			{UserID: "u-2", RoleKind: RoleKindCMSRole, RoleID: "cr-1", RoleName: "editor", Scope: ResourceScope{Type: "warehouse", ID: "W1"},
+				ReviewerID: "u-mgr", Decision: AccessReviewRevoke, Comment: "left the team", RevokedAt: &closedAt},
+		},
+		GeneratedAt: closedAt,
+	}
+}
+
+func TestEncodeAccessReviewReportCSV(t *testing.T) {
+	content, err := EncodeAccessReviewReport(testAccessReviewReport(), AccessReviewReportCSV)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3, "header and one row per item")
+
+	row := make(map[string]string)
+	for i, column := range records[0] {
+		row[column] = records[2][i]
+	}
+	assert.Equal(t, "Q3, CMS access", row["campaign_name"])
+	assert.Equal(t, "u-2", row["user_id"])
+	assert.Equal(t, "warehouse:W1", row["scope"])
+	assert.Equal(t, "revoke", row["decision"])
+	assert.Equal(t, "left the team", row["comment"])
+	assert.Equal(t, "2026-10-01T12:00:00Z", row["revoked_at"])
+	assert.Equal(t, "", row["decided_at"])
+}
+
+func TestEncodeAccessReviewReportJSON(t *testing.T) {
+	content, err := EncodeAccessReviewReport(testAccessReviewReport(), AccessReviewReportJSON)
+	require.NoError(t, err)
+
+	var decoded AccessReviewReport
+	require.NoError(t, json.Unmarshal(content, &decoded))
+	assert.Equal(t, 1, decoded.Summary.Revoked)
+	assert.Len(t, decoded.Items, 2)
+
+	_, err = EncodeAccessReviewReport(testAccessReviewReport(), "xml")
+	assert.Error(t, err)
+}
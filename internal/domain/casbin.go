@@ -3,6 +3,9 @@
 package domain
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -18,7 +21,17 @@ const (
 	DomainAPI CasbinDomain = "api"
 )
 
-// CMSTab represents different tabs/sections in CMS
+// IsValid reports whether the domain is one of the supported authorization domains
+func (d CasbinDomain) IsValid() bool {
+	switch d {
+	case DomainUser, DomainCMS, DomainAPI:
+		return true
+	}
+	return false
+}
+
+// CMSTab represents different tabs/sections in CMS.
+// Valid tabs are registered in the cms_tabs table; the constants below are the built-in ones.
 type CMSTab string
 
 const (
@@ -36,6 +49,399 @@ const (
 	CMSTabSetting CMSTab = "setting"
 )
 
+// CMSAction represents an operation a CMS role may perform within a tab
+type CMSAction string
+
+const (
+	// CMSActionView allows reading the tab's content
+	CMSActionView CMSAction = "view"
+	// CMSActionCreate allows creating new records in the tab
+	CMSActionCreate CMSAction = "create"
+	// CMSActionEdit allows modifying existing records in the tab
+	CMSActionEdit CMSAction = "edit"
+	// CMSActionDelete allows deleting records in the tab
+	CMSActionDelete CMSAction = "delete"
+	// CMSActionExport allows exporting the tab's data
+	CMSActionExport CMSAction = "export"
+)
+
+// AllCMSActions returns every supported CMS action in display order
+func AllCMSActions() []CMSAction {
+	return []CMSAction{CMSActionView, CMSActionCreate, CMSActionEdit, CMSActionDelete, CMSActionExport}
+}
+
+// IsValid reports whether the action is one of the supported CMS actions
+func (a CMSAction) IsValid() bool {
+	for _, action := range AllCMSActions() {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// CMSActionFromMethod maps an HTTP method to the equivalent CMS action.
+// It keeps callers that still send HTTP methods to CheckCMSAccess working.
+func CMSActionFromMethod(method string) (CMSAction, bool) {
+	switch strings.ToUpper(method) {
+	case "GET", "HEAD":
+		return CMSActionView, true
+	case "POST":
+		return CMSActionCreate, true
+	case "PUT", "PATCH":
+		return CMSActionEdit, true
+	case "DELETE":
+		return CMSActionDelete, true
+	}
+	return "", false
+}
+
+// CMSTabPermission grants a set of actions on a single CMS tab. An explicit empty list of actions
+// grants nothing, which narrows a grant inherited from a parent tab to no access.
+type CMSTabPermission struct {
+	Tab     CMSTab      `json:"tab"`
+	Actions []CMSAction `json:"actions"`
+}
+
+// ActionPattern returns the Casbin action pattern matching all granted actions, e.g.
+// ^(view|edit)$. The pattern is anchored so view does not also match preview.
+func (p CMSTabPermission) ActionPattern() string {
+	if len(p.Actions) == 1 {
+		return "^" + string(p.Actions[0]) + "$"
+	}
+	actions := make([]string, len(p.Actions))
+	for i, action := range p.Actions {
+		actions[i] = string(action)
+	}
+	return "^(" + strings.Join(actions, "|") + ")$"
+}
+
+// CMSTabResource returns the Casbin object path guarding a CMS tab
+func CMSTabResource(tab CMSTab) string {
+	return fmt.Sprintf("/cms/%s/*", string(tab))
+}
+
+// ParseCMSTabResource returns the CMS tab guarded by a Casbin object path, the inverse of CMSTabResource
+func ParseCMSTabResource(object string) (CMSTab, bool) {
+	if len(object) <= len("/cms//*") || !strings.HasPrefix(object, "/cms/") || !strings.HasSuffix(object, "/*") {
+		return "", false
+	}
+	tab := object[len("/cms/") : len(object)-len("/*")]
+	if strings.Contains(tab, "/") {
+		return "", false
+	}
+	return CMSTab(tab), true
+}
+
+// ParseCMSActionPattern returns the CMS actions matched by a Casbin action pattern, the inverse of
+// ActionPattern. Patterns naming anything but CMS actions are not parsed.
+func ParseCMSActionPattern(pattern string) ([]CMSAction, bool) {
+	if strings.HasPrefix(pattern, "^") && strings.HasSuffix(pattern, "$") {
+		pattern = pattern[1 : len(pattern)-1]
+	}
+	if strings.HasPrefix(pattern, "(") && strings.HasSuffix(pattern, ")") {
+		pattern = pattern[1 : len(pattern)-1]
+	}
+
+	var actions []CMSAction
+	for _, part := range strings.Split(pattern, "|") {
+		action := CMSAction(part)
+		if !action.IsValid() {
+			return nil, false
+		}
+		actions = append(actions, action)
+	}
+	return actions, true
+}
+
+// PermissionResourcePrefix prefixes the Casbin objects projected from relational permissions
+const PermissionResourcePrefix = "/permissions/"
+
+// PermissionResource returns the Casbin object, in the user domain, of a relational permission's
+// resource, e.g. /permissions/user for the user resource
+func PermissionResource(resource string) string {
+	return PermissionResourcePrefix + resource
+}
+
+// PermissionActionPattern returns the Casbin action pattern of a relational permission's action.
+// The pattern is anchored so read does not also match unread.
+func PermissionActionPattern(action string) string {
+	return "^" + action + "$"
+}
+
+// CMSTabDefinition represents a CMS tab registered in the tab registry
+type CMSTabDefinition struct {
+	Key         CMSTab    `json:"key" db:"key"`
+	DisplayName string    `json:"display_name" db:"display_name"`
+	Icon        string    `json:"icon" db:"icon"`
+	ParentKey   CMSTab    `json:"parent_key" db:"parent_key"` // empty for top-level tabs
+	SortOrder   int       `json:"sort_order" db:"sort_order"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CMSTabNode is a node in a user's accessible CMS menu tree
+type CMSTabNode struct {
+	Key         CMSTab        `json:"key"`
+	DisplayName string        `json:"display_name"`
+	Icon        string        `json:"icon"`
+	SortOrder   int           `json:"sort_order"`
+	Actions     []CMSAction   `json:"actions"` // empty when the node is only shown to reach an accessible child
+	Children    []*CMSTabNode `json:"children,omitempty"`
+}
+
+// CMSTabAPI is an API endpoint used by a CMS tab (from cms_tab_apis)
+type CMSTabAPI struct {
+	Tab         CMSTab `json:"tab" yaml:"tab"`
+	Path        string `json:"path" yaml:"path"`
+	Method      string `json:"method" yaml:"method"`
+	Description string `json:"description" yaml:"description,omitempty"`
+}
+
+// CMSCapability lists what a user may do on one CMS tab
+type CMSCapability struct {
+	Tab         CMSTab      `json:"tab"`
+	DisplayName string      `json:"display_name"`
+	ParentKey   CMSTab      `json:"parent_key"`
+	Actions     []CMSAction `json:"actions"`
+	APIs        []CMSTabAPI `json:"apis"` // endpoints whose method maps to an allowed action
+}
+
+// CMSCapabilityMap is everything a user can access in the CMS.
+// Version is a hash of the capabilities that changes whenever they do.
+type CMSCapabilityMap struct {
+	UserID  string          `json:"user_id"`
+	Version string          `json:"version"`
+	Tabs    []CMSCapability `json:"tabs"`
+}
+
+// AllFields is the field wildcard granting every field of a resource type
+const AllFields = "*"
+
+// CMSFieldPermission lists the fields a CMS role may read and write on a resource type
+type CMSFieldPermission struct {
+	ID             string    `json:"id" db:"id"`
+	CMSRoleID      string    `json:"cms_role_id" db:"cms_role_id"`
+	ResourceType   string    `json:"resource_type" db:"resource_type"` // e.g., order, product
+	ReadableFields []string  `json:"readable_fields" db:"readable_fields"`
+	WritableFields []string  `json:"writable_fields" db:"writable_fields"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// FieldPermissionSet is the set of fields a user may read and write on a resource type
+type FieldPermissionSet struct {
+	ResourceType string   `json:"resource_type"`
+	Readable     []string `json:"readable"`
+	Writable     []string `json:"writable"`
+	// Scoped lists the fields the user may use within the resource scopes it holds CMS roles in,
+	// combining those roles with the global ones
+	Scoped []*ScopedFieldPermissionSet `json:"scoped,omitempty"`
+}
+
+// ScopedFieldPermissionSet is the fields a user may use within one resource scope
+type ScopedFieldPermissionSet struct {
+	Scope    ResourceScope `json:"scope"`
+	Readable []string      `json:"readable"`
+	Writable []string      `json:"writable"`
+}
+
+// CanRead reports whether the field may be returned to the user
+func (f *FieldPermissionSet) CanRead(field string) bool {
+	return containsField(f.Readable, field)
+}
+
+// CanWrite reports whether the user may change the field
+func (f *FieldPermissionSet) CanWrite(field string) bool {
+	return containsField(f.Writable, field)
+}
+
+// MergeFieldPermissions combines the rules of all a user's roles for one resource type.
+// Roles are additive, writable fields are also readable, and AllFields absorbs every other field.
+func MergeFieldPermissions(resourceType string, rules []*CMSFieldPermission) *FieldPermissionSet {
+	readable := make(map[string]bool)
+	writable := make(map[string]bool)
+	for _, rule := range rules {
+		for _, field := range rule.ReadableFields {
+			readable[field] = true
+		}
+		for _, field := range rule.WritableFields {
+			readable[field] = true
+			writable[field] = true
+		}
+	}
+
+	return &FieldPermissionSet{
+		ResourceType: resourceType,
+		Readable:     fieldList(readable),
+		Writable:     fieldList(writable),
+	}
+}
+
+// ResolveFieldPermissions returns the fields a user holding the roles may use on a resource type.
+// The resource type names the CMS tab the resource lives in. Roles with a rule for the resource
+// type get its fields; a role without one gets every field only if it grants the tab, so roles
+// unrelated to the resource cannot unmask fields another role hides.
+func ResolveFieldPermissions(resourceType string, roles []*CMSRole, rules []*CMSFieldPermission, registry []*CMSTabDefinition) *FieldPermissionSet {
+	restricted := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		restricted[rule.CMSRoleID] = true
+	}
+
+	merged := append([]*CMSFieldPermission(nil), rules...)
+	for _, role := range roles {
+		if restricted[role.ID] || !CMSRoleGrantsTab(role, CMSTab(resourceType), registry) {
+			continue
+		}
+		merged = append(merged, &CMSFieldPermission{
+			CMSRoleID:      role.ID,
+			ResourceType:   resourceType,
+			ReadableFields: []string{AllFields},
+			WritableFields: []string{AllFields},
+		})
+	}
+	return MergeFieldPermissions(resourceType, merged)
+}
+
+// CMSRoleGrantsTab reports whether the role grants any action on the tab, directly or through a
+// parent tab
+func CMSRoleGrantsTab(role *CMSRole, tab CMSTab, registry []*CMSTabDefinition) bool {
+	for _, perm := range ExpandCMSPermissions(role.Permissions, registry) {
+		if perm.Tab == tab && len(perm.Actions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldList(fields map[string]bool) []string {
+	if fields[AllFields] {
+		return []string{AllFields}
+	}
+	list := make([]string, 0, len(fields))
+	for field := range fields {
+		list = append(list, field)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field || f == AllFields {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandCMSPermissions applies tab inheritance to a role's explicit permissions.
+// A granted tab passes its actions down to every descendant, and an explicit
+// permission on a descendant replaces the inherited actions for that subtree.
+func ExpandCMSPermissions(permissions []CMSTabPermission, registry []*CMSTabDefinition) []CMSTabPermission {
+	explicit := make(map[CMSTab][]CMSAction, len(permissions))
+	for _, perm := range permissions {
+		explicit[perm.Tab] = perm.Actions
+	}
+
+	parents := make(map[CMSTab]CMSTab, len(registry))
+	for _, tab := range registry {
+		parents[tab.Key] = tab.ParentKey
+	}
+
+	result := make([]CMSTabPermission, 0, len(registry))
+	seen := make(map[CMSTab]bool, len(registry))
+	for _, tab := range registry {
+		// Walk up to the nearest tab with an explicit grant
+		for current, depth := tab.Key, 0; current != "" && depth <= len(registry); current, depth = parents[current], depth+1 {
+			if actions, ok := explicit[current]; ok {
+				result = append(result, CMSTabPermission{Tab: tab.Key, Actions: actions})
+				seen[tab.Key] = true
+				break
+			}
+		}
+	}
+
+	// Keep grants on tabs missing from the registry as they are
+	for _, perm := range permissions {
+		if _, ok := parents[perm.Tab]; !ok && !seen[perm.Tab] {
+			result = append(result, perm)
+		}
+	}
+
+	return result
+}
+
+// BuildCMSTabTree returns the registry as a menu tree limited to tabs with granted
+// actions and the ancestors needed to reach them. Siblings are ordered by sort order.
+func BuildCMSTabTree(registry []*CMSTabDefinition, actions map[CMSTab][]CMSAction) []*CMSTabNode {
+	nodes := make(map[CMSTab]*CMSTabNode, len(registry))
+	parents := make(map[CMSTab]CMSTab, len(registry))
+	for _, tab := range registry {
+		parents[tab.Key] = tab.ParentKey
+		nodes[tab.Key] = &CMSTabNode{
+			Key:         tab.Key,
+			DisplayName: tab.DisplayName,
+			Icon:        tab.Icon,
+			SortOrder:   tab.SortOrder,
+			Actions:     actions[tab.Key],
+		}
+	}
+
+	// Mark accessible tabs and their ancestors as visible
+	visible := make(map[CMSTab]bool, len(registry))
+	for _, tab := range registry {
+		if len(actions[tab.Key]) == 0 {
+			continue
+		}
+		for current, depth := tab.Key, 0; current != "" && !visible[current] && depth <= len(registry); current, depth = parents[current], depth+1 {
+			if _, ok := nodes[current]; !ok {
+				break
+			}
+			visible[current] = true
+		}
+	}
+
+	var roots []*CMSTabNode
+	for _, tab := range registry {
+		if !visible[tab.Key] {
+			continue
+		}
+		if parent, ok := nodes[tab.ParentKey]; ok && visible[tab.ParentKey] {
+			parent.Children = append(parent.Children, nodes[tab.Key])
+		} else {
+			roots = append(roots, nodes[tab.Key])
+		}
+	}
+
+	sortCMSTabNodes(roots)
+	return roots
+}
+
+// FlattenCMSTabTree returns the keys of all nodes in the tree that grant at least one action
+func FlattenCMSTabTree(nodes []*CMSTabNode) []CMSTab {
+	var tabs []CMSTab
+	for _, node := range nodes {
+		if len(node.Actions) > 0 {
+			tabs = append(tabs, node.Key)
+		}
+		tabs = append(tabs, FlattenCMSTabTree(node.Children)...)
+	}
+	return tabs
+}
+
+func sortCMSTabNodes(nodes []*CMSTabNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].SortOrder != nodes[j].SortOrder {
+			return nodes[i].SortOrder < nodes[j].SortOrder
+		}
+		return nodes[i].Key < nodes[j].Key
+	})
+	for _, node := range nodes {
+		sortCMSTabNodes(node.Children)
+	}
+}
+
 // APIResource represents API resource paths and methods
 type APIResource struct {
 	ID          string    `json:"id" db:"id"`
@@ -49,19 +455,23 @@ type APIResource struct {
 
 // CMSRole represents roles for CMS access control
 type CMSRole struct {
-	ID          string    `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name"`
-	Description string    `json:"description" db:"description"`
-	Tabs        []CMSTab  `json:"tabs"` // CMS tabs this role can access
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID          string   `json:"id" db:"id"`
+	Name        string   `json:"name" db:"name"`
+	Description string   `json:"description" db:"description"`
+	Tabs        []CMSTab `json:"tabs"` // CMS tabs this role can access
+	// Permissions lists the actions granted per tab; Tabs mirrors its tab names
+	Permissions []CMSTabPermission `json:"permissions"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
 }
 
 // UserCMSRole represents the relationship between users and CMS roles
 type UserCMSRole struct {
-	UserID    string    `json:"user_id" db:"user_id"`
-	CMSRoleID string    `json:"cms_role_id" db:"cms_role_id"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	CMSRoleID string     `json:"cms_role_id" db:"cms_role_id"`
+	StartsAt  *time.Time `json:"starts_at,omitempty" db:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
 }
 
 // PolicyRule represents a Casbin policy rule
@@ -75,16 +485,205 @@ type PolicyRule struct {
 	V5    string `json:"v5"`     // extra
 }
 
+// PolicyEffect is the effect of a policy rule.
+// Deny policies require a model with p.eft (configs/rbac_deny_model.conf) and override allow policies.
+type PolicyEffect string
+
+const (
+	// PolicyEffectAllow grants the request
+	PolicyEffectAllow PolicyEffect = "allow"
+	// PolicyEffectDeny refuses the request even if another policy allows it
+	PolicyEffectDeny PolicyEffect = "deny"
+)
+
+// Policy is a Casbin policy rule: subjects holding Subject may perform Action (a regex) on
+// resources matching Resource (a keyMatch2 pattern) in Domain
+type Policy struct {
+	Subject  string       `json:"subject" yaml:"subject"`
+	Domain   CasbinDomain `json:"domain" yaml:"domain"`
+	Resource string       `json:"resource" yaml:"resource"`
+	Action   string       `json:"action" yaml:"action"`
+	Effect   PolicyEffect `json:"effect" yaml:"effect"`
+}
+
+// PolicyFilter selects policies; empty fields match any value
+type PolicyFilter struct {
+	Subject  string
+	Domain   CasbinDomain
+	Resource string
+	Action   string
+	Effect   PolicyEffect
+}
+
+// PolicyChangeSet is a proposed set of policy and role link changes. Role links cover both role
+// inheritance and role assignments, where Member is a user ID.
+type PolicyChangeSet struct {
+	AddPolicies     []Policy   `json:"add_policies"`
+	RemovePolicies  []Policy   `json:"remove_policies"`
+	AddRoleLinks    []RoleLink `json:"add_role_links"`
+	RemoveRoleLinks []RoleLink `json:"remove_role_links"`
+}
+
+// AccessChange is a user's access to a registered API resource (api domain, Resource is the
+// path and Action the method) or to a CMS tab (cms domain, Resource is the tab key and Action
+// a CMS action)
+type AccessChange struct {
+	UserID   string       `json:"user_id"`
+	Domain   CasbinDomain `json:"domain"`
+	Resource string       `json:"resource"`
+	Action   string       `json:"action"`
+}
+
+// PolicySimulation reports the access users would gain and lose if a change set were applied
+type PolicySimulation struct {
+	Gained []AccessChange `json:"gained"`
+	Lost   []AccessChange `json:"lost"`
+}
+
+// PermissionSource is a policy through which a user holds an effective permission
+type PermissionSource struct {
+	Role      string   `json:"role"`                // subject of the policy: a role, or the user for direct policies
+	RoleChain []string `json:"role_chain"`          // user -> role -> ... -> Role
+	Condition string   `json:"condition,omitempty"` // expression the policy is restricted by
+	// Scope is set when the user holds the chain's first role only within that resource scope
+	Scope *ResourceScope `json:"scope,omitempty"`
+}
+
+// EffectivePermission is an action a user is allowed, or denied, once role inheritance, domain
+// policies and CMS roles are combined. In the cms domain Resource is the tab key and Action a
+// single CMS action; elsewhere they are the policy's object and action patterns.
+type EffectivePermission struct {
+	Domain   CasbinDomain       `json:"domain"`
+	Resource string             `json:"resource"`
+	Action   string             `json:"action"`
+	Effect   PolicyEffect       `json:"effect"`
+	Sources  []PermissionSource `json:"sources"`
+}
+
+// AccessHolderKind tells users and roles apart in reverse access lookups
+type AccessHolderKind string
+
+const (
+	// AccessHolderUser is a user holding access directly or through roles
+	AccessHolderUser AccessHolderKind = "user"
+	// AccessHolderRole is a role holding access through its own or inherited policies
+	AccessHolderRole AccessHolderKind = "role"
+)
+
+// AccessHolder is a user or role that the policies allow a resource and action
+type AccessHolder struct {
+	Subject   string           `json:"subject"`
+	Kind      AccessHolderKind `json:"kind"`
+	Policy    Policy           `json:"policy"`              // allow policy granting the access
+	RoleChain []string         `json:"role_chain"`          // subject -> role -> ... -> policy subject
+	Condition string           `json:"condition,omitempty"` // expression the policy is restricted by
+	// Scope is set when the user holds the access only within that resource scope, through a
+	// scoped CMS role
+	Scope *ResourceScope `json:"scope,omitempty"`
+}
+
+// RoleImpact lists the Casbin rules that reference a role: the policies granted to it, its
+// members (users and roles holding it) and the parent roles it inherits from
+type RoleImpact struct {
+	Role     string     `json:"role"`
+	Policies []Policy   `json:"policies"`
+	Members  []RoleLink `json:"members"`
+	Parents  []RoleLink `json:"parents"`
+}
+
+// RoleInUseError is returned when deleting a role that still has members without cascading
+type RoleInUseError struct {
+	Impact *RoleImpact
+}
+
+func (e *RoleInUseError) Error() string {
+	members := make([]string, 0, len(e.Impact.Members))
+	for _, link := range e.Impact.Members {
+		members = append(members, fmt.Sprintf("%s (%s)", link.Member, link.Domain))
+	}
+	return fmt.Sprintf("role %s is still held by %s; delete with cascade to remove its policies and assignments",
+		e.Impact.Role, strings.Join(members, ", "))
+}
+
+// RoleHierarchy describes where a role sits in a domain's role inheritance graph.
+// A role inherits every policy of its parents, and transitively of their parents.
+type RoleHierarchy struct {
+	Role        string       `json:"role"`
+	Domain      CasbinDomain `json:"domain"`
+	Parents     []string     `json:"parents"`     // roles inherited directly
+	Ancestors   []string     `json:"ancestors"`   // roles inherited directly or transitively
+	Descendants []string     `json:"descendants"` // roles inheriting this role
+	Policies    []*Policy    `json:"policies"`    // own and inherited policies
+}
+
 // AuthorizationRequest represents an authorization check request
 type AuthorizationRequest struct {
 	UserID   string       `json:"user_id"`
 	Domain   CasbinDomain `json:"domain"`
 	Resource string       `json:"resource"` // API path or CMS tab
 	Action   string       `json:"action"`   // HTTP method or operation
+	Explain  bool         `json:"explain"`  // include how the decision was reached
+	// Attributes are evaluated by policy conditions, e.g. client_ip, time, resource_owner_id
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Relation, when set, is checked against the relation tuples and combined with the policy
+	// decision according to RelationMode, all by default
+	Relation     *RelationCheck `json:"relation,omitempty"`
+	RelationMode RelationMode   `json:"relation_mode,omitempty"`
+	// Scope, when set, adds the CMS roles the user holds within the scope or the scopes above it
+	Scope *ResourceScope `json:"scope,omitempty"`
 }
 
 // AuthorizationResponse represents an authorization check response
 type AuthorizationResponse struct {
+	Allowed     bool                      `json:"allowed"`
+	Reason      string                    `json:"reason"`
+	Explanation *AuthorizationExplanation `json:"explanation,omitempty"`
+	// Relation is set when a relation check was combined with the policy decision
+	Relation *RelationDecision `json:"relation,omitempty"`
+}
+
+// AuthorizationExplanation describes how an authorization decision was reached
+type AuthorizationExplanation struct {
+	Allowed       bool               `json:"allowed"`
+	MatchedPolicy []string           `json:"matched_policy,omitempty"` // policy rule that granted access
+	RoleChain     []string           `json:"role_chain,omitempty"`     // user -> role -> ... -> policy subject
+	Candidates    []*PolicyCandidate `json:"candidates,omitempty"`     // closest policies on deny
+}
+
+// PolicyCandidate is a policy that came close to granting a denied request
+type PolicyCandidate struct {
+	Policy       []string `json:"policy"`
+	RoleChain    []string `json:"role_chain,omitempty"` // set when the user reaches the policy subject
+	FailedFields []string `json:"failed_fields"`        // subject, object, action and/or condition
+}
+
+// PolicyCondition restricts a policy to requests whose attributes satisfy an expression,
+// e.g. `hour >= 8 && hour < 17`, `ipInRange(client_ip, "10.0.0.0/8")` or `resource_owner_id == user_id`.
+// It belongs to the policy with the same effect; a deny condition that cannot be evaluated holds.
+type PolicyCondition struct {
+	Subject    string       `json:"subject"`
+	Domain     CasbinDomain `json:"domain"`
+	Resource   string       `json:"resource"`
+	Action     string       `json:"action"`
+	Effect     PolicyEffect `json:"effect"`
+	Expression string       `json:"expression"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+// MaxBatchAuthorizationSize limits the number of checks in one batch request
+const MaxBatchAuthorizationSize = 100
+
+// APIAccessCheck is one (path, method) pair of a batch API access check
+type APIAccessCheck struct {
+	APIPath string `json:"api_path"`
+	Method  string `json:"method"`
+}
+
+// BatchAuthorizationResult is the outcome of one check in a batch request.
+// Error is set when the check could not be evaluated; Allowed is then false.
+type BatchAuthorizationResult struct {
 	Allowed bool   `json:"allowed"`
 	Reason  string `json:"reason"`
+	Error   string `json:"error,omitempty"`
 }
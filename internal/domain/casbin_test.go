@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCMSTabRegistry() []*CMSTabDefinition {
+	return []*CMSTabDefinition{
+		{Key: "order", DisplayName: "Orders", SortOrder: 30},
+		{Key: "returns", DisplayName: "Returns", ParentKey: "order", SortOrder: 2},
+		{Key: "refunds", DisplayName: "Refunds", ParentKey: "order", SortOrder: 1},
+		{Key: "product", DisplayName: "Products", SortOrder: 10},
+	}
+}
+
+func TestExpandCMSPermissions(t *testing.T) {
+	t.Run("Parent grant is inherited by children", func(t *testing.T) {
+		perms := []CMSTabPermission{{Tab: "order", Actions: []CMSAction{CMSActionView, CMSActionEdit}}}
+
+		expanded := ExpandCMSPermissions(perms, testCMSTabRegistry())
+
+		assert.ElementsMatch(t, []CMSTabPermission{
+			{Tab: "order", Actions: []CMSAction{CMSActionView, CMSActionEdit}},
+			{Tab: "returns", Actions: []CMSAction{CMSActionView, CMSActionEdit}},
+			{Tab: "refunds", Actions: []CMSAction{CMSActionView, CMSActionEdit}},
+		}, expanded)
+	})
+
+	t.Run("Explicit child grant restricts inheritance", func(t *testing.T) {
+		perms := []CMSTabPermission{
+			{Tab: "order", Actions: []CMSAction{CMSActionView, CMSActionEdit}},
+			{Tab: "refunds", Actions: []CMSAction{CMSActionView}},
+		}
+
+		expanded := ExpandCMSPermissions(perms, testCMSTabRegistry())
+
+		assert.Contains(t, expanded, CMSTabPermission{Tab: "refunds", Actions: []CMSAction{CMSActionView}})
+		assert.Contains(t, expanded, CMSTabPermission{Tab: "returns", Actions: []CMSAction{CMSActionView, CMSActionEdit}})
+	})
+
+	t.Run("Empty child grant narrows inheritance to nothing", func(t *testing.T) {
+		perms := []CMSTabPermission{
+			{Tab: "order", Actions: []CMSAction{CMSActionView, CMSActionEdit}},
+			{Tab: "refunds", Actions: []CMSAction{}},
+		}
+
+		expanded := ExpandCMSPermissions(perms, testCMSTabRegistry())
+
+		assert.Contains(t, expanded, CMSTabPermission{Tab: "refunds", Actions: []CMSAction{}})
+		assert.Contains(t, expanded, CMSTabPermission{Tab: "returns", Actions: []CMSAction{CMSActionView, CMSActionEdit}})
+	})
+
+	t.Run("Child grant does not reach parent", func(t *testing.T) {
+		perms := []CMSTabPermission{{Tab: "returns", Actions: []CMSAction{CMSActionView}}}
+
+		expanded := ExpandCMSPermissions(perms, testCMSTabRegistry())
+
+		assert.Equal(t, perms, expanded)
+	})
+}
+
+func TestBuildCMSTabTree(t *testing.T) {
+	actions := map[CMSTab][]CMSAction{
+		"refunds": {CMSActionView},
+		"returns": {CMSActionView, CMSActionCreate},
+	}
+
+	tree := BuildCMSTabTree(testCMSTabRegistry(), actions)
+
+	// The parent is included to reach its children but grants nothing itself
+	require.Len(t, tree, 1)
+	assert.Equal(t, CMSTab("order"), tree[0].Key)
+	assert.Empty(t, tree[0].Actions)
+
+	require.Len(t, tree[0].Children, 2)
+	assert.Equal(t, CMSTab("refunds"), tree[0].Children[0].Key)
+	assert.Equal(t, CMSTab("returns"), tree[0].Children[1].Key)
+	assert.Equal(t, []CMSAction{CMSActionView, CMSActionCreate}, tree[0].Children[1].Actions)
+
+	assert.Equal(t, []CMSTab{"refunds", "returns"}, FlattenCMSTabTree(tree))
+}
+
+func TestMergeFieldPermissions(t *testing.T) {
+	t.Run("Roles are additive and writable implies readable", func(t *testing.T) {
+		fields := MergeFieldPermissions("order", []*CMSFieldPermission{
+			{ReadableFields: []string{"status", "total"}},
+			{ReadableFields: []string{"id"}, WritableFields: []string{"status"}},
+		})
+
+		assert.Equal(t, []string{"id", "status", "total"}, fields.Readable)
+		assert.Equal(t, []string{"status"}, fields.Writable)
+		assert.False(t, fields.CanRead("customer_phone"))
+		assert.True(t, fields.CanWrite("status"))
+	})
+
+	t.Run("Wildcard grants every field", func(t *testing.T) {
+		fields := MergeFieldPermissions("product", []*CMSFieldPermission{
+			{ReadableFields: []string{AllFields}, WritableFields: []string{"name"}},
+		})
+
+		assert.Equal(t, []string{AllFields}, fields.Readable)
+		assert.True(t, fields.CanRead("cost_price"))
+		assert.False(t, fields.CanWrite("cost_price"))
+	})
+}
+
+func TestResolveFieldPermissions(t *testing.T) {
+	registry := testCMSTabRegistry()
+	orderViewer := &CMSRole{ID: "order-viewer", Permissions: []CMSTabPermission{{Tab: "order", Actions: []CMSAction{CMSActionView}}}}
+	productEditor := &CMSRole{ID: "product-editor", Permissions: []CMSTabPermission{{Tab: "product", Actions: []CMSAction{CMSActionEdit}}}}
+	orderManager := &CMSRole{ID: "order-manager", Permissions: []CMSTabPermission{{Tab: "order", Actions: []CMSAction{CMSActionEdit}}}}
+	rules := []*CMSFieldPermission{{CMSRoleID: "order-viewer", ReadableFields: []string{"id", "status"}}}
+
+	t.Run("Unrelated role does not unmask restricted fields", func(t *testing.T) {
+		fields := ResolveFieldPermissions("order", []*CMSRole{orderViewer, productEditor}, rules, registry)
+
+		assert.Equal(t, []string{"id", "status"}, fields.Readable)
+		assert.Empty(t, fields.Writable)
+	})
+
+	t.Run("Role granting the tab without a rule is unrestricted", func(t *testing.T) {
+		fields := ResolveFieldPermissions("order", []*CMSRole{orderViewer, orderManager}, rules, registry)
+
+		assert.Equal(t, []string{AllFields}, fields.Readable)
+		assert.Equal(t, []string{AllFields}, fields.Writable)
+	})
+
+	t.Run("Inherited tab grant counts", func(t *testing.T) {
+		fields := ResolveFieldPermissions("refunds", []*CMSRole{orderManager}, nil, registry)
+
+		assert.Equal(t, []string{AllFields}, fields.Readable)
+	})
+}
+
+func TestParseCMSTabResource(t *testing.T) {
+	tab, ok := ParseCMSTabResource(CMSTabResource("order"))
+	require.True(t, ok)
+	assert.Equal(t, CMSTab("order"), tab)
+
+	for _, object := range []string{"/cms/*", "/cms/order/items/*", "/api/v1/orders/*", "/cms/order"} {
+		_, ok := ParseCMSTabResource(object)
+		assert.False(t, ok, object)
+	}
+}
+
+func TestParseCMSActionPattern(t *testing.T) {
+	perm := CMSTabPermission{Tab: "order", Actions: []CMSAction{CMSActionView, CMSActionEdit}}
+	actions, ok := ParseCMSActionPattern(perm.ActionPattern())
+	require.True(t, ok)
+	assert.Equal(t, perm.Actions, actions)
+
+	actions, ok = ParseCMSActionPattern("view")
+	require.True(t, ok)
+	assert.Equal(t, []CMSAction{CMSActionView}, actions)
+
+	actions, ok = ParseCMSActionPattern("^view$")
+	require.True(t, ok)
+	assert.Equal(t, []CMSAction{CMSActionView}, actions)
+
+	// Patterns are anchored so an action only matches itself
+	pattern := CMSTabPermission{Tab: "order", Actions: []CMSAction{CMSActionView}}.ActionPattern()
+	assert.Regexp(t, pattern, "view")
+	assert.NotRegexp(t, pattern, "preview")
+
+	_, ok = ParseCMSActionPattern("(view|approve)")
+	assert.False(t, ok)
+	_, ok = ParseCMSActionPattern("*")
+	assert.False(t, ok)
+}
@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PolicyBundleFormat is a serialization format of a policy bundle
+type PolicyBundleFormat string
+
+const (
+	// PolicyBundleFormatCSV is the Casbin-native format: one p, g or cms_tab_api rule per line
+	PolicyBundleFormatCSV PolicyBundleFormat = "csv"
+	// PolicyBundleFormatYAML lists policies, role links and CMS tab APIs by section
+	PolicyBundleFormatYAML PolicyBundleFormat = "yaml"
+)
+
+// CSV rule types of a policy bundle
+const (
+	bundleRulePolicy    = "p"
+	bundleRuleRoleLink  = "g"
+	bundleRuleCMSTabAPI = "cms_tab_api"
+)
+
+// RoleLink makes Member (a user or a role) inherit Role in a domain
+type RoleLink struct {
+	Member string       `json:"member" yaml:"member"`
+	Role   string       `json:"role" yaml:"role"`
+	Domain CasbinDomain `json:"domain" yaml:"domain"`
+}
+
+// PolicyBundle is the declarative authorization state: policies, role links and CMS tab APIs.
+// A nil section is not managed by the bundle, so importing it leaves the live section unchanged;
+// an empty section removes everything in it.
+type PolicyBundle struct {
+	Policies   []Policy    `json:"policies" yaml:"policies"`
+	RoleLinks  []RoleLink  `json:"role_links" yaml:"role_links"`
+	CMSTabAPIs []CMSTabAPI `json:"cms_tab_apis" yaml:"cms_tab_apis"`
+}
+
+// PolicyBundleDiff lists the changes that bring the live state to a bundle
+type PolicyBundleDiff struct {
+	AddedPolicies     []Policy    `json:"added_policies"`
+	RemovedPolicies   []Policy    `json:"removed_policies"`
+	AddedRoleLinks    []RoleLink  `json:"added_role_links"`
+	RemovedRoleLinks  []RoleLink  `json:"removed_role_links"`
+	AddedCMSTabAPIs   []CMSTabAPI `json:"added_cms_tab_apis"`
+	RemovedCMSTabAPIs []CMSTabAPI `json:"removed_cms_tab_apis"`
+}
+
+// IsEmpty reports whether the diff has no changes
+func (d *PolicyBundleDiff) IsEmpty() bool {
+	return len(d.AddedPolicies) == 0 && len(d.RemovedPolicies) == 0 &&
+		len(d.AddedRoleLinks) == 0 && len(d.RemovedRoleLinks) == 0 &&
+		len(d.AddedCMSTabAPIs) == 0 && len(d.RemovedCMSTabAPIs) == 0
+}
+
+// IsValid reports whether the format is supported
+func (f PolicyBundleFormat) IsValid() bool {
+	return f == PolicyBundleFormatCSV || f == PolicyBundleFormatYAML
+}
+
+// Sort orders every section so exports are stable and diff well in git
+func (b *PolicyBundle) Sort() {
+	sort.Slice(b.Policies, func(i, j int) bool { return policyKey(b.Policies[i]) < policyKey(b.Policies[j]) })
+	sort.Slice(b.RoleLinks, func(i, j int) bool { return roleLinkKey(b.RoleLinks[i]) < roleLinkKey(b.RoleLinks[j]) })
+	sort.Slice(b.CMSTabAPIs, func(i, j int) bool { return cmsTabAPIKey(b.CMSTabAPIs[i]) < cmsTabAPIKey(b.CMSTabAPIs[j]) })
+}
+
+// EncodePolicyBundle serializes a bundle in the given format
+func EncodePolicyBundle(bundle *PolicyBundle, format PolicyBundleFormat) ([]byte, error) {
+	switch format {
+	case PolicyBundleFormatYAML:
+		return yaml.Marshal(bundle)
+	case PolicyBundleFormatCSV:
+		return encodePolicyBundleCSV(bundle), nil
+	default:
+		return nil, fmt.Errorf("unsupported policy bundle format: %s", format)
+	}
+}
+
+// ParsePolicyBundle reads a bundle in the given format
+func ParsePolicyBundle(data []byte, format PolicyBundleFormat) (*PolicyBundle, error) {
+	switch format {
+	case PolicyBundleFormatYAML:
+		bundle := &PolicyBundle{}
+		if err := yaml.Unmarshal(data, bundle); err != nil {
+			return nil, fmt.Errorf("invalid YAML policy bundle: %w", err)
+		}
+		for i := range bundle.Policies {
+			if bundle.Policies[i].Effect == "" {
+				bundle.Policies[i].Effect = PolicyEffectAllow
+			}
+		}
+		return bundle, nil
+	case PolicyBundleFormatCSV:
+		return parsePolicyBundleCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported policy bundle format: %s", format)
+	}
+}
+
+// encodePolicyBundleCSV writes rules the way Casbin policy files do, e.g. "p, admin, api, /api/v1/*, GET, allow"
+func encodePolicyBundleCSV(bundle *PolicyBundle) []byte {
+	var buf bytes.Buffer
+	writeLine := func(fields ...string) {
+		for i, field := range fields {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(quoteCSVField(field))
+		}
+		buf.WriteByte('\n')
+	}
+
+	for _, p := range bundle.Policies {
+		writeLine(bundleRulePolicy, p.Subject, string(p.Domain), p.Resource, p.Action, string(p.Effect))
+	}
+	for _, g := range bundle.RoleLinks {
+		writeLine(bundleRuleRoleLink, g.Member, g.Role, string(g.Domain))
+	}
+	for _, api := range bundle.CMSTabAPIs {
+		if api.Description == "" {
+			writeLine(bundleRuleCMSTabAPI, string(api.Tab), api.Path, api.Method)
+			continue
+		}
+		writeLine(bundleRuleCMSTabAPI, string(api.Tab), api.Path, api.Method, api.Description)
+	}
+	return buf.Bytes()
+}
+
+// quoteCSVField quotes a field that would otherwise be split or trimmed when read back
+func quoteCSVField(field string) string {
+	if !strings.ContainsAny(field, ",\"\n") && strings.TrimSpace(field) == field {
+		return field
+	}
+	return `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+}
+
+func parsePolicyBundleCSV(data []byte) (*PolicyBundle, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	bundle := &PolicyBundle{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV policy bundle: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+
+		switch record[0] {
+		case bundleRulePolicy:
+			if len(record) != 5 && len(record) != 6 {
+				return nil, fmt.Errorf("line %d: p rules need subject, domain, resource, action and an optional effect", line)
+			}
+			policy := Policy{
+				Subject:  record[1],
+				Domain:   CasbinDomain(record[2]),
+				Resource: record[3],
+				Action:   record[4],
+				Effect:   PolicyEffectAllow,
+			}
+			if len(record) == 6 && record[5] != "" {
+				policy.Effect = PolicyEffect(record[5])
+			}
+			bundle.Policies = append(bundle.Policies, policy)
+		case bundleRuleRoleLink:
+			if len(record) != 4 {
+				return nil, fmt.Errorf("line %d: g rules need member, role and domain", line)
+			}
+			bundle.RoleLinks = append(bundle.RoleLinks, RoleLink{
+				Member: record[1],
+				Role:   record[2],
+				Domain: CasbinDomain(record[3]),
+			})
+		case bundleRuleCMSTabAPI:
+			if len(record) != 4 && len(record) != 5 {
+				return nil, fmt.Errorf("line %d: cms_tab_api rules need tab, path, method and an optional description", line)
+			}
+			api := CMSTabAPI{Tab: CMSTab(record[1]), Path: record[2], Method: record[3]}
+			if len(record) == 5 {
+				api.Description = record[4]
+			}
+			bundle.CMSTabAPIs = append(bundle.CMSTabAPIs, api)
+		default:
+			return nil, fmt.Errorf("line %d: unknown rule type %q", line, record[0])
+		}
+	}
+	return bundle, nil
+}
+
+// DiffPolicyBundles computes the changes that turn current into desired.
+// Sections desired does not manage (nil) are left out of the diff.
+func DiffPolicyBundles(current, desired *PolicyBundle) *PolicyBundleDiff {
+	diff := &PolicyBundleDiff{}
+	if desired.Policies != nil {
+		diff.AddedPolicies, diff.RemovedPolicies = diffSection(current.Policies, desired.Policies, policyKey)
+	}
+	if desired.RoleLinks != nil {
+		diff.AddedRoleLinks, diff.RemovedRoleLinks = diffSection(current.RoleLinks, desired.RoleLinks, roleLinkKey)
+	}
+	if desired.CMSTabAPIs != nil {
+		diff.AddedCMSTabAPIs, diff.RemovedCMSTabAPIs = diffSection(current.CMSTabAPIs, desired.CMSTabAPIs, cmsTabAPIKey)
+	}
+	return diff
+}
+
+// diffSection returns the items only in desired and the items only in current, by key
+func diffSection[T any](current, desired []T, key func(T) string) (added, removed []T) {
+	currentKeys := make(map[string]bool, len(current))
+	for _, item := range current {
+		currentKeys[key(item)] = true
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, item := range desired {
+		k := key(item)
+		if !currentKeys[k] && !desiredKeys[k] {
+			added = append(added, item)
+		}
+		desiredKeys[k] = true
+	}
+	for _, item := range current {
+		if !desiredKeys[key(item)] {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed
+}
+
+func policyKey(p Policy) string {
+	return strings.Join([]string{string(p.Domain), p.Subject, p.Resource, p.Action, string(p.Effect)}, "\x00")
+}
+
+func roleLinkKey(g RoleLink) string {
+	return strings.Join([]string{string(g.Domain), g.Role, g.Member}, "\x00")
+}
+
+// cmsTabAPIKey identifies a tab API mapping; the description is not part of it
+func cmsTabAPIKey(api CMSTabAPI) string {
+	return strings.Join([]string{string(api.Tab), api.Path, api.Method}, "\x00")
+}
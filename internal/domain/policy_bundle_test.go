@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPolicyBundle() *PolicyBundle {
+	return &PolicyBundle{
+		Policies: []Policy{
+			{Subject: "api_admin", Domain: DomainAPI, Resource: "/api/v1/*", Action: "(GET|POST)", Effect: PolicyEffectAllow},
+			{Subject: "cms_support", Domain: DomainCMS, Resource: "/cms/refunds/*", Action: "edit", Effect: PolicyEffectDeny},
+			{Subject: "shopper", Domain: DomainUser, Resource: "/api/v1/orders/:id", Action: "GET{1,3}", Effect: PolicyEffectAllow},
+		},
+		RoleLinks: []RoleLink{
+			{Member: "alice", Role: "api_admin", Domain: DomainAPI},
+		},
+		CMSTabAPIs: []CMSTabAPI{
+			{Tab: "order", Path: "/api/v1/orders/*", Method: "GET", Description: "List orders, paginated"},
+			{Tab: "product", Path: "/api/v1/products/*", Method: "PUT"},
+		},
+	}
+}
+
+func TestPolicyBundleRoundTrip(t *testing.T) {
+	for _, format := range []PolicyBundleFormat{PolicyBundleFormatCSV, PolicyBundleFormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := EncodePolicyBundle(testPolicyBundle(), format)
+			require.NoError(t, err)
+
+			parsed, err := ParsePolicyBundle(data, format)
+			require.NoError(t, err)
+			assert.Equal(t, testPolicyBundle(), parsed)
+		})
+	}
+}
+
+func TestParsePolicyBundleCSV(t *testing.T) {
+	t.Run("Casbin policy file", func(t *testing.T) {
+		bundle, err := ParsePolicyBundle([]byte(`
+# API policies
+p, api_admin, api, /api/v1/*, (GET|POST)
+g, alice, api_admin, api
+`), PolicyBundleFormatCSV)
+		require.NoError(t, err)
+
+		assert.Equal(t, []Policy{{Subject: "api_admin", Domain: DomainAPI, Resource: "/api/v1/*", Action: "(GET|POST)", Effect: PolicyEffectAllow}}, bundle.Policies)
+		assert.Equal(t, []RoleLink{{Member: "alice", Role: "api_admin", Domain: DomainAPI}}, bundle.RoleLinks)
+		assert.Nil(t, bundle.CMSTabAPIs)
+	})
+
+	t.Run("Rejects malformed rules", func(t *testing.T) {
+		_, err := ParsePolicyBundle([]byte("p, api_admin, api\n"), PolicyBundleFormatCSV)
+		assert.Error(t, err)
+		_, err = ParsePolicyBundle([]byte("x, api_admin, api, /api/v1/*\n"), PolicyBundleFormatCSV)
+		assert.Error(t, err)
+	})
+}
+
+func TestDiffPolicyBundles(t *testing.T) {
+	current := testPolicyBundle()
+	desired := &PolicyBundle{
+		Policies: []Policy{
+			current.Policies[0],
+			{Subject: "api_viewer", Domain: DomainAPI, Resource: "/api/v1/*", Action: "GET", Effect: PolicyEffectAllow},
+		},
+		RoleLinks: []RoleLink{},
+	}
+
+	diff := DiffPolicyBundles(current, desired)
+
+	assert.Equal(t, []Policy{desired.Policies[1]}, diff.AddedPolicies)
+	assert.Equal(t, current.Policies[1:], diff.RemovedPolicies)
+	assert.Empty(t, diff.AddedRoleLinks)
+	assert.Equal(t, current.RoleLinks, diff.RemovedRoleLinks)
+	// CMS tab APIs are not managed by the desired bundle
+	assert.Empty(t, diff.AddedCMSTabAPIs)
+	assert.Empty(t, diff.RemovedCMSTabAPIs)
+
+	assert.True(t, DiffPolicyBundles(current, testPolicyBundle()).IsEmpty())
+}
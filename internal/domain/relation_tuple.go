@@ -0,0 +1,283 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxRelationDepth bounds how many usersets and rewrites a relation check follows
+const MaxRelationDepth = 25
+
+// ErrRelationDepthExceeded is returned when a relation check follows more than MaxRelationDepth
+// usersets
+var ErrRelationDepthExceeded = fmt.Errorf("relation check exceeded the maximum depth of %d", MaxRelationDepth)
+
+var relationNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// RelationSubject is who a relation tuple grants a relation to:
+//   - a user, written as the user ID;
+//   - a userset, everyone holding a relation on an object, written namespace:object#relation;
+//   - an object, written namespace:object, which tuple-to-userset rewrites follow, e.g. the store
+//     an order belongs to.
+type RelationSubject struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+}
+
+// ParseRelationSubject parses a user ID, namespace:object or namespace:object#relation
+func ParseRelationSubject(s string) (RelationSubject, error) {
+	namespace, rest, found := strings.Cut(s, ":")
+	if !found {
+		subject := RelationSubject{ObjectID: s}
+		return subject, subject.Validate()
+	}
+	objectID, relation, _ := strings.Cut(rest, "#")
+	subject := RelationSubject{Namespace: namespace, ObjectID: objectID, Relation: relation}
+	if strings.HasSuffix(s, "#") && relation == "" {
+		return subject, fmt.Errorf("invalid subject %q: relation is empty", s)
+	}
+	return subject, subject.Validate()
+}
+
+// IsUser reports whether the subject is a single user
+func (s RelationSubject) IsUser() bool {
+	return s.Namespace == ""
+}
+
+// IsUserset reports whether the subject is everyone holding a relation on an object
+func (s RelationSubject) IsUserset() bool {
+	return s.Namespace != "" && s.Relation != ""
+}
+
+// Validate checks the subject is well formed
+func (s RelationSubject) Validate() error {
+	if s.Namespace == "" {
+		if s.Relation != "" {
+			return fmt.Errorf("invalid subject %q: a user has no relation", s)
+		}
+		return validateRelationObjectID("user ID", s.ObjectID)
+	}
+	if !relationNamePattern.MatchString(s.Namespace) {
+		return fmt.Errorf("invalid subject namespace %q", s.Namespace)
+	}
+	if s.Relation != "" && !relationNamePattern.MatchString(s.Relation) {
+		return fmt.Errorf("invalid subject relation %q", s.Relation)
+	}
+	return validateRelationObjectID("subject object ID", s.ObjectID)
+}
+
+func (s RelationSubject) String() string {
+	switch {
+	case s.IsUser():
+		return s.ObjectID
+	case s.Relation == "":
+		return s.Namespace + ":" + s.ObjectID
+	default:
+		return s.Namespace + ":" + s.ObjectID + "#" + s.Relation
+	}
+}
+
+// MarshalText writes the subject in its string form, so that it is a plain string in JSON
+func (s RelationSubject) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses the string form of a subject
+func (s *RelationSubject) UnmarshalText(text []byte) error {
+	subject, err := ParseRelationSubject(string(text))
+	if err != nil {
+		return err
+	}
+	*s = subject
+	return nil
+}
+
+// RelationTuple states that a subject holds a relation on an object, object#relation@subject,
+// e.g. order:o-1#owner@u-1 or store:s-1#manager@team:t-1#member
+type RelationTuple struct {
+	Namespace string          `json:"namespace"`
+	ObjectID  string          `json:"object_id"`
+	Relation  string          `json:"relation"`
+	Subject   RelationSubject `json:"subject"`
+}
+
+// ParseRelationTuple parses namespace:object#relation@subject
+func ParseRelationTuple(s string) (*RelationTuple, error) {
+	object, subject, found := strings.Cut(s, "@")
+	if !found {
+		return nil, fmt.Errorf("invalid relation tuple %q: expected namespace:object#relation@subject", s)
+	}
+	namespace, rest, found := strings.Cut(object, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid relation tuple %q: expected namespace:object#relation@subject", s)
+	}
+	objectID, relation, found := strings.Cut(rest, "#")
+	if !found {
+		return nil, fmt.Errorf("invalid relation tuple %q: expected namespace:object#relation@subject", s)
+	}
+	parsedSubject, err := ParseRelationSubject(subject)
+	if err != nil {
+		return nil, err
+	}
+	tuple := &RelationTuple{Namespace: namespace, ObjectID: objectID, Relation: relation, Subject: parsedSubject}
+	return tuple, tuple.Validate()
+}
+
+// Validate checks the tuple is well formed; whether its namespace defines the relation is checked
+// against the namespace config
+func (t *RelationTuple) Validate() error {
+	if !relationNamePattern.MatchString(t.Namespace) {
+		return fmt.Errorf("invalid namespace %q", t.Namespace)
+	}
+	if !relationNamePattern.MatchString(t.Relation) {
+		return fmt.Errorf("invalid relation %q", t.Relation)
+	}
+	if err := validateRelationObjectID("object ID", t.ObjectID); err != nil {
+		return err
+	}
+	return t.Subject.Validate()
+}
+
+func (t *RelationTuple) String() string {
+	return Userset(t.Namespace, t.ObjectID, t.Relation) + "@" + t.Subject.String()
+}
+
+// Userset writes the set of subjects holding relation on an object: namespace:object#relation
+func Userset(namespace, objectID, relation string) string {
+	return namespace + ":" + objectID + "#" + relation
+}
+
+// RelationTupleFilter narrows a tuple listing; empty fields match everything
+type RelationTupleFilter struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subject   *RelationSubject
+}
+
+// TupleToUserset grants a relation to whoever holds ComputedUserset on the objects the Tupleset
+// relation points to. With tupleset parent and computed userset manager, the managers of an
+// order's parent store are granted the relation on the order.
+type TupleToUserset struct {
+	Tupleset        string `json:"tupleset"`
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// RelationDefinition is a relation of a namespace and its userset rewrites. A subject holds the
+// relation through a tuple naming it, or through any of the rewrites.
+type RelationDefinition struct {
+	Name string `json:"name"`
+	// ComputedUsersets are relations on the same object implying this one, e.g. owner for viewer
+	ComputedUsersets []string         `json:"computed_usersets,omitempty"`
+	TupleToUsersets  []TupleToUserset `json:"tuple_to_usersets,omitempty"`
+}
+
+// RelationNamespace is the config of a kind of object, such as order or store, and the relations
+// subjects may hold on them
+type RelationNamespace struct {
+	Name      string               `json:"name"`
+	Relations []RelationDefinition `json:"relations"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// Relation returns the definition of a relation, or nil when the namespace does not define it
+func (n *RelationNamespace) Relation(name string) *RelationDefinition {
+	for i := range n.Relations {
+		if n.Relations[i].Name == name {
+			return &n.Relations[i]
+		}
+	}
+	return nil
+}
+
+// Validate checks the relations are uniquely named and that rewrites refer to relations of the
+// namespace. The relation a tuple-to-userset computes on the related objects belongs to another
+// namespace and is resolved when checking.
+func (n *RelationNamespace) Validate() error {
+	if !relationNamePattern.MatchString(n.Name) {
+		return fmt.Errorf("invalid namespace name %q: expected lowercase letters, digits and underscores", n.Name)
+	}
+	if len(n.Relations) == 0 {
+		return fmt.Errorf("namespace %s defines no relation", n.Name)
+	}
+
+	defined := make(map[string]bool, len(n.Relations))
+	for _, relation := range n.Relations {
+		if !relationNamePattern.MatchString(relation.Name) {
+			return fmt.Errorf("invalid relation name %q: expected lowercase letters, digits and underscores", relation.Name)
+		}
+		if defined[relation.Name] {
+			return fmt.Errorf("relation %s is defined twice", relation.Name)
+		}
+		defined[relation.Name] = true
+	}
+	for _, relation := range n.Relations {
+		for _, computed := range relation.ComputedUsersets {
+			if !defined[computed] {
+				return fmt.Errorf("relation %s: computed userset %s is not a relation of %s", relation.Name, computed, n.Name)
+			}
+		}
+		for _, ttu := range relation.TupleToUsersets {
+			if !defined[ttu.Tupleset] {
+				return fmt.Errorf("relation %s: tupleset %s is not a relation of %s", relation.Name, ttu.Tupleset, n.Name)
+			}
+			if !relationNamePattern.MatchString(ttu.ComputedUserset) {
+				return fmt.Errorf("relation %s: invalid computed userset %q", relation.Name, ttu.ComputedUserset)
+			}
+		}
+	}
+	return nil
+}
+
+// RelationTree is the expansion of a userset: the subjects named by its tuples, and the usersets
+// its rewrites add. The userset is the union of its subjects and children.
+type RelationTree struct {
+	Userset string `json:"userset"`
+	// Subjects are users and usersets granted the relation by tuples; usersets are not expanded
+	Subjects []RelationSubject `json:"subjects,omitempty"`
+	Children []*RelationTree   `json:"children,omitempty"`
+}
+
+// RelationCheck asks whether a user holds a relation on an object
+type RelationCheck struct {
+	Namespace string `json:"namespace"`
+	ObjectID  string `json:"object_id"`
+	Relation  string `json:"relation"`
+}
+
+// RelationMode is how a relation check combines with the policy decision in EnforcePolicy
+type RelationMode string
+
+const (
+	// RelationModeAll allows when both the policy and the relation allow
+	RelationModeAll RelationMode = "all"
+	// RelationModeAny allows when either the policy or the relation allows
+	RelationModeAny RelationMode = "any"
+)
+
+// IsValid reports whether the mode is known
+func (m RelationMode) IsValid() bool {
+	return m == RelationModeAll || m == RelationModeAny
+}
+
+// RelationDecision reports both halves of a decision combining a policy and a relation check
+type RelationDecision struct {
+	Check           RelationCheck `json:"check"`
+	Mode            RelationMode  `json:"mode"`
+	PolicyAllowed   bool          `json:"policy_allowed"`
+	RelationAllowed bool          `json:"relation_allowed"`
+}
+
+func validateRelationObjectID(field, id string) error {
+	if id == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if strings.ContainsAny(id, "#@ ") {
+		return fmt.Errorf("invalid %s %q: must not contain '#', '@' or spaces", field, id)
+	}
+	return nil
+}
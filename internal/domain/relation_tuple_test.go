@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelationTuple(t *testing.T) {
+	tuple, err := ParseRelationTuple("store:s-1#manager@team:t-1#member")
+	require.NoError(t, err)
+	assert.Equal(t, "store", tuple.Namespace)
+	assert.Equal(t, "s-1", tuple.ObjectID)
+	assert.Equal(t, "manager", tuple.Relation)
+	assert.True(t, tuple.Subject.IsUserset())
+	assert.Equal(t, "store:s-1#manager@team:t-1#member", tuple.String())
+
+	tuple, err = ParseRelationTuple("order:o-1#parent@store:s-1")
+	require.NoError(t, err)
+	assert.False(t, tuple.Subject.IsUser())
+	assert.False(t, tuple.Subject.IsUserset())
+
+	tuple, err = ParseRelationTuple("order:o-1#owner@u-1")
+	require.NoError(t, err)
+	assert.True(t, tuple.Subject.IsUser())
+
+	for _, invalid := range []string{"order:o-1#owner", "order#owner@u-1", "Order:o-1#owner@u-1", "order:o-1#owner@team:t-1#"} {
+		_, err := ParseRelationTuple(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestRelationSubjectJSON(t *testing.T) {
+	var tuple RelationTuple
+	require.NoError(t, json.Unmarshal([]byte(`{"namespace":"order","object_id":"o-1","relation":"viewer","subject":"store:s-1#manager"}`), &tuple))
+	assert.Equal(t, RelationSubject{Namespace: "store", ObjectID: "s-1", Relation: "manager"}, tuple.Subject)
+
+	content, err := json.Marshal(tuple)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"subject":"store:s-1#manager"`)
+}
+
+func TestRelationNamespaceValidate(t *testing.T) {
+	namespace := &RelationNamespace{
+		Name: "order",
+		Relations: []RelationDefinition{
+			{Name: "owner"},
+			{Name: "parent"},
+			{Name: "viewer", ComputedUsersets: []string{"owner"}, TupleToUsersets: []TupleToUserset{{Tupleset: "parent", ComputedUserset: "manager"}}},
+		},
+	}
+	assert.NoError(t, namespace.Validate())
+
+	namespace.Relations[2].ComputedUsersets = []string{"editor"}
+	assert.Error(t, namespace.Validate(), "computed userset must be a relation of the namespace")
+
+	namespace.Relations[2].ComputedUsersets = nil
+	namespace.Relations[2].TupleToUsersets[0].Tupleset = "store"
+	assert.Error(t, namespace.Validate(), "tupleset must be a relation of the namespace")
+
+	namespace.Relations = append(namespace.Relations, RelationDefinition{Name: "owner"})
+	assert.Error(t, namespace.Validate(), "duplicate relation")
+}
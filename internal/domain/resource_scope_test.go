@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceScopeValidate(t *testing.T) {
+	assert.NoError(t, ResourceScope{}.Validate())
+	assert.NoError(t, ResourceScope{Type: "warehouse", ID: "W1"}.Validate())
+	assert.Equal(t, "global", ResourceScope{}.String())
+	assert.Equal(t, "warehouse:W1", ResourceScope{Type: "warehouse", ID: "W1"}.String())
+
+	for _, invalid := range []ResourceScope{
+		{Type: "warehouse"},
+		{ID: "W1"},
+		{Type: "Warehouse", ID: "W1"},
+		{Type: "warehouse", ID: "  "},
+		{Type: "warehouse", ID: strings.Repeat("w", 256)},
+	} {
+		assert.Error(t, invalid.Validate(), invalid.String())
+	}
+}
+
+func TestResourceScopeDefinitionValidate(t *testing.T) {
+	region := ResourceScope{Type: "region", ID: "EU"}
+	warehouse := ResourceScope{Type: "warehouse", ID: "W1"}
+
+	assert.NoError(t, (&ResourceScopeDefinition{ResourceScope: region}).Validate())
+	assert.NoError(t, (&ResourceScopeDefinition{ResourceScope: warehouse, Parent: &region}).Validate())
+
+	assert.Error(t, (&ResourceScopeDefinition{}).Validate())
+	assert.Error(t, (&ResourceScopeDefinition{ResourceScope: warehouse, Parent: &ResourceScope{}}).Validate())
+	assert.Error(t, (&ResourceScopeDefinition{ResourceScope: warehouse, Parent: &ResourceScope{Type: "Region", ID: "EU"}}).Validate())
+	assert.Error(t, (&ResourceScopeDefinition{ResourceScope: warehouse, Parent: &warehouse}).Validate())
+}
+
+func TestScopedRoleNames(t *testing.T) {
+	region := ResourceScope{Type: "region", ID: "EU"}
+	warehouse := ResourceScope{Type: "warehouse", ID: "W1"}
+	parents := ResourceScopeParents([]*ResourceScopeDefinition{
+		{ResourceScope: region},
+		{ResourceScope: warehouse, Parent: &region},
+	})
+	assert.Equal(t, []ResourceScope{warehouse, region}, ScopeLineage(warehouse, parents))
+
+	held := ScopedRoleNames([]*ScopedCMSRoleAssignment{
+		{UserID: "u-1", CMSRoleName: "picker", Scope: warehouse},
+		{UserID: "u-1", CMSRoleName: "auditor", Scope: region},
+		{UserID: "u-2", CMSRoleName: "picker", Scope: region},
+	}, parents)
+
+	assert.Equal(t, map[ResourceScope][]string{
+		warehouse: {"auditor", "picker"}, // the region's roles hold in its warehouses
+		region:    {"auditor"},
+	}, held["u-1"])
+	assert.Equal(t, map[ResourceScope][]string{region: {"picker"}}, held["u-2"])
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SoDMode is how a separation-of-duties constraint is enforced
+type SoDMode string
+
+const (
+	// SoDStatic forbids holding two roles of the constraint at all
+	SoDStatic SoDMode = "static"
+	// SoDDynamic lets a user hold the roles but activate only one of them per session
+	SoDDynamic SoDMode = "dynamic"
+)
+
+// IsValid reports whether the mode is known
+func (m SoDMode) IsValid() bool {
+	return m == SoDStatic || m == SoDDynamic
+}
+
+// ErrSessionRoleNotAssigned is returned when activating a role the user does not hold
+var ErrSessionRoleNotAssigned = errors.New("role is not assigned to the user")
+
+// SoDRole is a role taking part in a separation-of-duties constraint
+type SoDRole struct {
+	Kind   RoleKind `json:"role_kind"`
+	RoleID string   `json:"role_id"`
+	// RoleName is empty once the role was deleted
+	RoleName string `json:"role_name"`
+}
+
+func (r SoDRole) String() string {
+	if r.Kind == RoleKindCMSRole {
+		return "CMS role " + r.RoleName
+	}
+	return "role " + r.RoleName
+}
+
+// SoDConstraint is a set of mutually exclusive roles: nobody may hold, or in dynamic mode
+// activate, two of them. Roles are matched together with the roles they inherit.
+type SoDConstraint struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Mode        SoDMode   `json:"mode"`
+	Roles       []SoDRole `json:"roles"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks the constraint names at least two distinct roles. Sessions only carry user
+// roles, so dynamic constraints cannot name CMS roles.
+func (c *SoDConstraint) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if !c.Mode.IsValid() {
+		return fmt.Errorf("invalid mode %q: expected static or dynamic", c.Mode)
+	}
+
+	seen := make(map[SoDRole]bool, len(c.Roles))
+	for _, role := range c.Roles {
+		if !role.Kind.IsValid() {
+			return fmt.Errorf("invalid role kind %q: expected role or cms_role", role.Kind)
+		}
+		if role.RoleID == "" {
+			return fmt.Errorf("role ID is required")
+		}
+		if c.Mode == SoDDynamic && role.Kind == RoleKindCMSRole {
+			return fmt.Errorf("dynamic constraints apply to session roles and cannot include CMS roles")
+		}
+		key := SoDRole{Kind: role.Kind, RoleID: role.RoleID}
+		if seen[key] {
+			return fmt.Errorf("role %s is listed twice", role.RoleID)
+		}
+		seen[key] = true
+	}
+	if len(seen) < 2 {
+		return fmt.Errorf("a constraint needs at least two roles")
+	}
+	return nil
+}
+
+// HeldRoles returns the roles of the constraint found in held, the role names a subject holds
+// per domain
+func (c *SoDConstraint) HeldRoles(held map[CasbinDomain]map[string]bool) []SoDRole {
+	var roles []SoDRole
+	for _, role := range c.Roles {
+		if role.RoleName != "" && held[role.Kind.Domain()][role.RoleName] {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// SoDViolation is a subject holding, or about to hold, two or more roles of a constraint.
+// The subject is a user, or a role that inherits the roles. Scope is set when the user only holds
+// the roles together within that scope, through scoped CMS role assignments.
+type SoDViolation struct {
+	ConstraintID   string         `json:"constraint_id"`
+	ConstraintName string         `json:"constraint_name"`
+	Mode           SoDMode        `json:"mode"`
+	Subject        string         `json:"subject"`
+	Roles          []SoDRole      `json:"roles"`
+	Scope          *ResourceScope `json:"scope,omitempty"`
+}
+
+// SoDViolationError is returned when a change would break separation-of-duties constraints
+type SoDViolationError struct {
+	Violations []*SoDViolation
+}
+
+func (e *SoDViolationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		roles := make([]string, 0, len(v.Roles))
+		for _, role := range v.Roles {
+			roles = append(roles, role.String())
+		}
+		verb := "hold"
+		if v.Mode == SoDDynamic {
+			verb = "activate"
+		}
+		where := ""
+		if v.Scope != nil {
+			where = " in " + v.Scope.String()
+		}
+		parts = append(parts, fmt.Sprintf("%s would %s %s%s, which constraint %s keeps apart",
+			v.Subject, verb, strings.Join(roles, " and "), where, v.ConstraintName))
+	}
+	return "separation of duties violated: " + strings.Join(parts, "; ")
+}
+
+// RoleGraph indexes role links, (member, role, domain), to find what a subject holds through
+// inheritance
+type RoleGraph struct {
+	parents map[CasbinDomain]map[string][]string
+	members map[CasbinDomain]map[string][]string
+}
+
+// NewRoleGraph builds a graph from role links
+func NewRoleGraph(links [][]string) *RoleGraph {
+	g := &RoleGraph{
+		parents: make(map[CasbinDomain]map[string][]string),
+		members: make(map[CasbinDomain]map[string][]string),
+	}
+	for _, link := range links {
+		if len(link) >= 3 {
+			g.AddLink(link[0], link[1], CasbinDomain(link[2]))
+		}
+	}
+	return g
+}
+
+// AddLink makes member hold role in dom
+func (g *RoleGraph) AddLink(member, role string, dom CasbinDomain) {
+	if g.parents[dom] == nil {
+		g.parents[dom] = make(map[string][]string)
+		g.members[dom] = make(map[string][]string)
+	}
+	g.parents[dom][member] = append(g.parents[dom][member], role)
+	g.members[dom][role] = append(g.members[dom][role], member)
+}
+
+// Held returns the subject and every role it holds in dom, directly or by inheritance
+func (g *RoleGraph) Held(subject string, dom CasbinDomain) map[string]bool {
+	return walkRoles(g.parents[dom], subject)
+}
+
+// Members returns the subject and every user or role holding it in dom, directly or by inheritance
+func (g *RoleGraph) Members(subject string, dom CasbinDomain) map[string]bool {
+	return walkRoles(g.members[dom], subject)
+}
+
+// Subjects returns every user and role with a link in one of the domains, sorted
+func (g *RoleGraph) Subjects(domains ...CasbinDomain) []string {
+	seen := make(map[string]bool)
+	for _, dom := range domains {
+		for member := range g.parents[dom] {
+			seen[member] = true
+		}
+	}
+	subjects := make([]string, 0, len(seen))
+	for subject := range seen {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+func walkRoles(edges map[string][]string, start string) map[string]bool {
+	seen := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[current] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return seen
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRefundConstraint() *SoDConstraint {
+	return &SoDConstraint{
+		Name: "refunds",
+		Mode: SoDStatic,
+		Roles: []SoDRole{
+			{Kind: RoleKindRole, RoleID: "r-1", RoleName: "order_refund_approver"},
+			{Kind: RoleKindCMSRole, RoleID: "c-1", RoleName: "order_refund_requester"},
+		},
+	}
+}
+
+func TestSoDConstraintValidate(t *testing.T) {
+	assert.NoError(t, testRefundConstraint().Validate())
+
+	single := testRefundConstraint()
+	single.Roles = single.Roles[:1]
+	assert.Error(t, single.Validate())
+
+	duplicate := testRefundConstraint()
+	duplicate.Roles[1] = duplicate.Roles[0]
+	assert.Error(t, duplicate.Validate())
+
+	dynamic := testRefundConstraint()
+	dynamic.Mode = SoDDynamic
+	assert.Error(t, dynamic.Validate(), "dynamic constraints cannot name CMS roles")
+}
+
+func TestSoDConstraintHeldRoles(t *testing.T) {
+	graph := NewRoleGraph([][]string{
+		{"alice", "finance_lead", "user"},
+		{"finance_lead", "order_refund_approver", "user"},
+		{"alice", "order_refund_requester", "cms"},
+		{"bob", "order_refund_approver", "user"},
+		// Same name in another domain does not count
+		{"bob", "order_refund_requester", "user"},
+	})
+	constraint := testRefundConstraint()
+
+	held := func(subject string) map[CasbinDomain]map[string]bool {
+		return map[CasbinDomain]map[string]bool{
+			DomainUser: graph.Held(subject, DomainUser),
+			DomainCMS:  graph.Held(subject, DomainCMS),
+		}
+	}
+
+	assert.Len(t, constraint.HeldRoles(held("alice")), 2, "inherited and cross-domain roles count")
+	assert.Len(t, constraint.HeldRoles(held("bob")), 1)
+	assert.Len(t, constraint.HeldRoles(held("finance_lead")), 1)
+
+	assert.Equal(t, map[string]bool{"order_refund_approver": true, "finance_lead": true, "alice": true, "bob": true},
+		graph.Members("order_refund_approver", DomainUser))
+	assert.Equal(t, []string{"alice", "bob", "finance_lead"}, graph.Subjects(DomainUser, DomainCMS))
+}
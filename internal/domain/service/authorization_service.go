@@ -14,17 +14,27 @@ const (
 	DomainAPI CasbinDomain = "api"
 )
 
+// PolicyEffect is the effect of a policy rule
+type PolicyEffect string
+
+const (
+	// EffectAllow grants the request
+	EffectAllow PolicyEffect = "allow"
+	// EffectDeny refuses the request even if another policy allows it
+	EffectDeny PolicyEffect = "deny"
+)
+
 // AuthorizationService defines the contract for Casbin authorization
 // This is a domain service interface
 type AuthorizationService interface {
 	// Enforce checks if subject can perform action on object in domain
 	Enforce(ctx context.Context, subject string, domain CasbinDomain, object, action string) (bool, error)
 
-	// AddPolicy adds a policy rule
-	AddPolicy(ctx context.Context, subject string, domain CasbinDomain, object, action string) error
+	// AddPolicy adds a policy rule with an allow or deny effect
+	AddPolicy(ctx context.Context, subject string, domain CasbinDomain, object, action string, effect PolicyEffect) error
 
-	// RemovePolicy removes a policy rule
-	RemovePolicy(ctx context.Context, subject string, domain CasbinDomain, object, action string) error
+	// RemovePolicy removes a policy rule with an allow or deny effect
+	RemovePolicy(ctx context.Context, subject string, domain CasbinDomain, object, action string, effect PolicyEffect) error
 
 	// AddRoleForUser assigns a role to user in a domain
 	AddRoleForUser(ctx context.Context, userID, role string, domain CasbinDomain) error
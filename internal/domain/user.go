@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -37,11 +38,86 @@ type Permission struct {
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// RoleKind tells a relational role from a CMS role when a role is referenced by ID
+type RoleKind string
+
+const (
+	// RoleKindRole is a relational role, assigned with AssignRole
+	RoleKindRole RoleKind = "role"
+	// RoleKindCMSRole is a CMS role, assigned with AssignCMSRole
+	RoleKindCMSRole RoleKind = "cms_role"
+)
+
+// IsValid reports whether the kind is known
+func (k RoleKind) IsValid() bool {
+	return k == RoleKindRole || k == RoleKindCMSRole
+}
+
+// Domain returns the Casbin domain the kind's roles are checked in
+func (k RoleKind) Domain() CasbinDomain {
+	if k == RoleKindCMSRole {
+		return DomainCMS
+	}
+	return DomainUser
+}
+
 // UserRole represents the many-to-many relationship between users and roles
 type UserRole struct {
-	UserID    string    `json:"user_id" db:"user_id"`
-	RoleID    string    `json:"role_id" db:"role_id"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	RoleID    string     `json:"role_id" db:"role_id"`
+	StartsAt  *time.Time `json:"starts_at,omitempty" db:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// AssignmentWindow limits a role assignment to a period. A nil bound leaves that side open;
+// an assignment without bounds is permanent.
+type AssignmentWindow struct {
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// IsZero reports whether the window is unbounded
+func (w AssignmentWindow) IsZero() bool {
+	return w.StartsAt == nil && w.ExpiresAt == nil
+}
+
+// Validate checks that the window can still be active at now
+func (w AssignmentWindow) Validate(now time.Time) error {
+	if w.StartsAt != nil && w.ExpiresAt != nil && !w.ExpiresAt.After(*w.StartsAt) {
+		return fmt.Errorf("expires_at must be after starts_at")
+	}
+	if w.ExpiresAt != nil && !w.ExpiresAt.After(now) {
+		return fmt.Errorf("expires_at must be in the future")
+	}
+	return nil
+}
+
+// UTC returns the window with its bounds in UTC, the way assignment windows are stored
+func (w AssignmentWindow) UTC() AssignmentWindow {
+	var utc AssignmentWindow
+	if w.StartsAt != nil {
+		startsAt := w.StartsAt.UTC()
+		utc.StartsAt = &startsAt
+	}
+	if w.ExpiresAt != nil {
+		expiresAt := w.ExpiresAt.UTC()
+		utc.ExpiresAt = &expiresAt
+	}
+	return utc
+}
+
+// ExpiredAssignment is a role assignment whose window has closed
+type ExpiredAssignment struct {
+	UserID   string `json:"user_id"`
+	RoleID   string `json:"role_id"`
+	RoleName string `json:"role_name"`
+	// Domain is DomainCMS for CMS roles and DomainUser for roles, which also hold in DomainAPI
+	Domain CasbinDomain `json:"domain"`
+	// Scope is set for a CMS role assigned within a resource scope
+	Scope *ResourceScope `json:"scope,omitempty"`
+	// ExpiresAt is when the assignment expired
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 // RolePermission represents the many-to-many relationship between roles and permissions
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	pb "github.com/tvttt/iam-services/pkg/proto"
+)
+
+// CreateAccessRequest handles a user's request to be granted a role
+func (h *GRPCHandler) CreateAccessRequest(ctx context.Context, req *pb.CreateAccessRequestRequest) (*pb.AccessRequestResponse, error) {
+	h.logger.Info("CreateAccessRequest request received",
+		zap.String("requester_id", req.RequesterId),
+		zap.String("role_kind", req.RoleKind),
+		zap.String("role_id", req.RoleId))
+
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+
+	request, err := h.accessService.CreateAccessRequest(ctx, req.RequesterId, domain.RoleKind(req.RoleKind), req.RoleId, req.Justification, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		h.logger.Error("Failed to create access request", zap.Error(err))
+		return nil, accessRequestStatusError("failed to create access request", err)
+	}
+
+	return &pb.AccessRequestResponse{
+		Request: domainAccessRequestToPB(request),
+		Message: "Access request created successfully",
+	}, nil
+}
+
+// GetAccessRequest handles retrieving an access request with its decision history
+func (h *GRPCHandler) GetAccessRequest(ctx context.Context, req *pb.GetAccessRequestRequest) (*pb.GetAccessRequestResponse, error) {
+	h.logger.Info("GetAccessRequest request received", zap.String("id", req.Id))
+
+	request, decisions, err := h.accessService.GetAccessRequest(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to get access request", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "access request not found: %v", err)
+	}
+
+	return &pb.GetAccessRequestResponse{
+		Request:   domainAccessRequestToPB(request),
+		Decisions: domainAccessRequestDecisionsToPB(decisions),
+	}, nil
+}
+
+// ListAccessRequests handles listing access requests
+func (h *GRPCHandler) ListAccessRequests(ctx context.Context, req *pb.ListAccessRequestsRequest) (*pb.ListAccessRequestsResponse, error) {
+	h.logger.Info("ListAccessRequests request received",
+		zap.String("status", req.Status),
+		zap.String("requester_id", req.RequesterId))
+
+	filter := domain.AccessRequestFilter{
+		Status:      domain.AccessRequestStatus(req.Status),
+		RequesterID: req.RequesterId,
+		RoleKind:    domain.RoleKind(req.RoleKind),
+		RoleID:      req.RoleId,
+	}
+	requests, total, err := h.accessService.ListAccessRequests(ctx, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list access requests", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list access requests: %v", err)
+	}
+
+	pbRequests := make([]*pb.AccessRequest, len(requests))
+	for i, request := range requests {
+		pbRequests[i] = domainAccessRequestToPB(request)
+	}
+
+	return &pb.ListAccessRequestsResponse{
+		Requests: pbRequests,
+		Total:    int32(total),
+	}, nil
+}
+
+// ApproveAccessRequest handles approving an access request, which assigns the role
+func (h *GRPCHandler) ApproveAccessRequest(ctx context.Context, req *pb.DecideAccessRequestRequest) (*pb.AccessRequestResponse, error) {
+	h.logger.Info("ApproveAccessRequest request received", zap.String("id", req.Id))
+
+	approverID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := h.accessService.ApproveAccessRequest(ctx, req.Id, approverID, req.Comment)
+	if err != nil {
+		h.logger.Error("Failed to approve access request", zap.Error(err))
+		return nil, accessRequestStatusError("failed to approve access request", err)
+	}
+
+	return &pb.AccessRequestResponse{
+		Request: domainAccessRequestToPB(request),
+		Message: "Access request approved successfully",
+	}, nil
+}
+
+// DenyAccessRequest handles denying an access request
+func (h *GRPCHandler) DenyAccessRequest(ctx context.Context, req *pb.DecideAccessRequestRequest) (*pb.AccessRequestResponse, error) {
+	h.logger.Info("DenyAccessRequest request received", zap.String("id", req.Id))
+
+	approverID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := h.accessService.DenyAccessRequest(ctx, req.Id, approverID, req.Comment)
+	if err != nil {
+		h.logger.Error("Failed to deny access request", zap.Error(err))
+		return nil, accessRequestStatusError("failed to deny access request", err)
+	}
+
+	return &pb.AccessRequestResponse{
+		Request: domainAccessRequestToPB(request),
+		Message: "Access request denied successfully",
+	}, nil
+}
+
+// SetAccessRequestApprovers handles replacing the approvers of a role
+func (h *GRPCHandler) SetAccessRequestApprovers(ctx context.Context, req *pb.SetAccessRequestApproversRequest) (*pb.SetAccessRequestApproversResponse, error) {
+	h.logger.Info("SetAccessRequestApprovers request received",
+		zap.String("role_kind", req.RoleKind),
+		zap.String("role_id", req.RoleId),
+		zap.Int("approvers", len(req.ApproverIds)))
+
+	actorID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.accessService.SetAccessRequestApprovers(ctx, actorID, domain.RoleKind(req.RoleKind), req.RoleId, req.ApproverIds); err != nil {
+		h.logger.Error("Failed to set access request approvers", zap.Error(err))
+		return nil, accessRequestStatusError("failed to set access request approvers", err)
+	}
+
+	return &pb.SetAccessRequestApproversResponse{
+		Message: "Access request approvers set successfully",
+	}, nil
+}
+
+// ListAccessRequestApprovers handles listing the approvers of a role
+func (h *GRPCHandler) ListAccessRequestApprovers(ctx context.Context, req *pb.ListAccessRequestApproversRequest) (*pb.ListAccessRequestApproversResponse, error) {
+	h.logger.Info("ListAccessRequestApprovers request received",
+		zap.String("role_kind", req.RoleKind),
+		zap.String("role_id", req.RoleId))
+
+	approverIDs, err := h.accessService.ListAccessRequestApprovers(ctx, domain.RoleKind(req.RoleKind), req.RoleId)
+	if err != nil {
+		h.logger.Error("Failed to list access request approvers", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list access request approvers: %v", err)
+	}
+
+	return &pb.ListAccessRequestApproversResponse{
+		ApproverIds: approverIDs,
+	}, nil
+}
+
+// accessRequestStatusError maps access request errors to gRPC status codes
+func accessRequestStatusError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAccessRequestNotPending):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, domain.ErrNotAccessRequestApprover), errors.Is(err, domain.ErrNotAccessRequestAdmin):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	}
+	return roleLinkStatusError(msg, err)
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	pb "github.com/tvttt/iam-services/pkg/proto"
+)
+
+// CreateAccessReviewCampaign handles starting an access review over a snapshot of assignments
+func (h *GRPCHandler) CreateAccessReviewCampaign(ctx context.Context, req *pb.CreateAccessReviewCampaignRequest) (*pb.AccessReviewCampaignResponse, error) {
+	h.logger.Info("CreateAccessReviewCampaign request received",
+		zap.String("name", req.Name),
+		zap.Int("reviewers", len(req.Reviewers)))
+
+	creatorID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dueAt *time.Time
+	if req.DueAt != "" {
+		t, err := time.Parse(time.RFC3339, req.DueAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid due_at: %v", err)
+		}
+		dueAt = &t
+	}
+
+	campaign, summary, err := h.reviewService.CreateAccessReviewCampaign(ctx, req.Name, req.Description,
+		pbAccessReviewScopeToDomain(req.Scope), pbAccessReviewersToDomain(req.Reviewers), req.DefaultReviewerId, dueAt, creatorID)
+	if err != nil {
+		h.logger.Error("Failed to create access review campaign", zap.Error(err))
+		return nil, accessReviewStatusError("failed to create access review campaign", err)
+	}
+
+	return &pb.AccessReviewCampaignResponse{
+		Campaign: domainAccessReviewCampaignToPB(campaign, summary),
+		Message:  "Access review campaign created successfully",
+	}, nil
+}
+
+// GetAccessReviewCampaign handles retrieving a campaign with its progress
+func (h *GRPCHandler) GetAccessReviewCampaign(ctx context.Context, req *pb.GetAccessReviewCampaignRequest) (*pb.AccessReviewCampaignResponse, error) {
+	h.logger.Info("GetAccessReviewCampaign request received", zap.String("id", req.Id))
+
+	campaign, summary, err := h.reviewService.GetAccessReviewCampaign(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to get access review campaign", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "access review campaign not found: %v", err)
+	}
+
+	return &pb.AccessReviewCampaignResponse{
+		Campaign: domainAccessReviewCampaignToPB(campaign, summary),
+	}, nil
+}
+
+// ListAccessReviewCampaigns handles listing access review campaigns
+func (h *GRPCHandler) ListAccessReviewCampaigns(ctx context.Context, req *pb.ListAccessReviewCampaignsRequest) (*pb.ListAccessReviewCampaignsResponse, error) {
+	h.logger.Info("ListAccessReviewCampaigns request received", zap.String("status", req.Status))
+
+	campaigns, total, err := h.reviewService.ListAccessReviewCampaigns(ctx, domain.AccessReviewStatus(req.Status), int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list access review campaigns", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list access review campaigns: %v", err)
+	}
+
+	pbCampaigns := make([]*pb.AccessReviewCampaign, len(campaigns))
+	for i, campaign := range campaigns {
+		pbCampaigns[i] = domainAccessReviewCampaignToPB(campaign, nil)
+	}
+
+	return &pb.ListAccessReviewCampaignsResponse{
+		Campaigns: pbCampaigns,
+		Total:     int32(total),
+	}, nil
+}
+
+// ListAccessReviewItems handles listing the assignments of a campaign, such as a reviewer's queue
+func (h *GRPCHandler) ListAccessReviewItems(ctx context.Context, req *pb.ListAccessReviewItemsRequest) (*pb.ListAccessReviewItemsResponse, error) {
+	h.logger.Info("ListAccessReviewItems request received",
+		zap.String("campaign_id", req.CampaignId),
+		zap.String("reviewer_id", req.ReviewerId))
+
+	filter := domain.AccessReviewItemFilter{
+		ReviewerID: req.ReviewerId,
+		UserID:     req.UserId,
+		Decision:   domain.AccessReviewDecision(req.Decision),
+	}
+	items, total, err := h.reviewService.ListAccessReviewItems(ctx, req.CampaignId, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list access review items", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list access review items: %v", err)
+	}
+
+	pbItems := make([]*pb.AccessReviewItem, len(items))
+	for i, item := range items {
+		pbItems[i] = domainAccessReviewItemToPB(item)
+	}
+
+	return &pb.ListAccessReviewItemsResponse{
+		Items: pbItems,
+		Total: int32(total),
+	}, nil
+}
+
+// DecideAccessReviewItem handles a reviewer keeping or revoking an assignment
+func (h *GRPCHandler) DecideAccessReviewItem(ctx context.Context, req *pb.DecideAccessReviewItemRequest) (*pb.AccessReviewItemResponse, error) {
+	h.logger.Info("DecideAccessReviewItem request received",
+		zap.String("campaign_id", req.CampaignId),
+		zap.String("item_id", req.ItemId),
+		zap.String("decision", req.Decision))
+
+	reviewerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item, err := h.reviewService.DecideAccessReviewItem(ctx, req.CampaignId, req.ItemId, reviewerID, domain.AccessReviewDecision(req.Decision), req.Comment)
+	if err != nil {
+		h.logger.Error("Failed to decide access review item", zap.Error(err))
+		return nil, accessReviewStatusError("failed to decide access review item", err)
+	}
+
+	return &pb.AccessReviewItemResponse{
+		Item:    domainAccessReviewItemToPB(item),
+		Message: "Access review decision recorded successfully",
+	}, nil
+}
+
+// CloseAccessReviewCampaign handles closing a campaign, which applies its revocations
+func (h *GRPCHandler) CloseAccessReviewCampaign(ctx context.Context, req *pb.CloseAccessReviewCampaignRequest) (*pb.AccessReviewCampaignResponse, error) {
+	h.logger.Info("CloseAccessReviewCampaign request received", zap.String("id", req.Id))
+
+	closerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	campaign, summary, err := h.reviewService.CloseAccessReviewCampaign(ctx, req.Id, closerID)
+	if err != nil {
+		h.logger.Error("Failed to close access review campaign", zap.Error(err))
+		return nil, accessReviewStatusError("failed to close access review campaign", err)
+	}
+
+	return &pb.AccessReviewCampaignResponse{
+		Campaign: domainAccessReviewCampaignToPB(campaign, summary),
+		Message:  "Access review campaign closed successfully",
+	}, nil
+}
+
+// ExportAccessReviewReport handles exporting the evidence report of a campaign
+func (h *GRPCHandler) ExportAccessReviewReport(ctx context.Context, req *pb.ExportAccessReviewReportRequest) (*pb.ExportAccessReviewReportResponse, error) {
+	h.logger.Info("ExportAccessReviewReport request received",
+		zap.String("id", req.Id),
+		zap.String("format", req.Format))
+
+	format := domain.AccessReviewReportFormat(req.Format)
+	if format == "" {
+		format = domain.AccessReviewReportCSV
+	}
+
+	content, err := h.reviewService.ExportAccessReviewReport(ctx, req.Id, format)
+	if err != nil {
+		h.logger.Error("Failed to export access review report", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to export access review report: %v", err)
+	}
+
+	return &pb.ExportAccessReviewReportResponse{
+		Format:  string(format),
+		Content: string(content),
+	}, nil
+}
+
+// accessReviewStatusError maps access review errors to gRPC status codes
+func accessReviewStatusError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAccessReviewClosed):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, domain.ErrNotAccessReviewer), errors.Is(err, domain.ErrNotAccessReviewManager):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
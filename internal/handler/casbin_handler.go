@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -16,9 +17,31 @@ func (h *GRPCHandler) CheckAPIAccess(ctx context.Context, req *pb.CheckAPIAccess
 	h.logger.Info("CheckAPIAccess request received",
 		zap.String("user_id", req.UserId),
 		zap.String("api_path", req.ApiPath),
-		zap.String("method", req.Method))
+		zap.String("method", req.Method),
+		zap.Bool("explain", req.Explain))
+
+	if req.Explain && req.AccessToken != "" {
+		return nil, status.Error(codes.InvalidArgument, "explain cannot be combined with access_token")
+	}
 
-	allowed, err := h.casbinService.CheckAPIAccess(ctx, req.UserId, req.ApiPath, req.Method)
+	var allowed bool
+	var explanation *domain.AuthorizationExplanation
+	var err error
+	switch {
+	case req.Explain:
+		explanation, err = h.casbinService.ExplainAPIAccess(ctx, req.UserId, req.ApiPath, req.Method)
+		if err == nil {
+			allowed = explanation.Allowed
+		}
+	case req.AccessToken != "":
+		inactive, sessionErr := h.sessionInactiveRoles(ctx, req.UserId, req.AccessToken)
+		if sessionErr != nil {
+			return nil, sessionErr
+		}
+		allowed, err = h.casbinService.CheckAPIAccessInSession(ctx, req.UserId, inactive, req.ApiPath, req.Method)
+	default:
+		allowed, err = h.casbinService.CheckAPIAccess(ctx, req.UserId, req.ApiPath, req.Method)
+	}
 	if err != nil {
 		h.logger.Error("Failed to check API access", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to check API access: %v", err)
@@ -30,8 +53,9 @@ func (h *GRPCHandler) CheckAPIAccess(ctx context.Context, req *pb.CheckAPIAccess
 	}
 
 	return &pb.CheckAPIAccessResponse{
-		Allowed: allowed,
-		Message: message,
+		Allowed:     allowed,
+		Message:     message,
+		Explanation: domainExplanationToPB(explanation),
 	}, nil
 }
 
@@ -40,7 +64,9 @@ func (h *GRPCHandler) CheckCMSAccess(ctx context.Context, req *pb.CheckCMSAccess
 	h.logger.Info("CheckCMSAccess request received",
 		zap.String("user_id", req.UserId),
 		zap.String("cms_tab", req.CmsTab),
-		zap.String("action", req.Action))
+		zap.String("action", req.Action),
+		zap.Stringer("scope", pbResourceScopeToDomain(req.Scope)),
+		zap.Bool("explain", req.Explain))
 
 	// Get user's CMS tabs first
 	tabs, err := h.casbinService.GetUserCMSTabs(ctx, req.UserId)
@@ -56,7 +82,20 @@ func (h *GRPCHandler) CheckCMSAccess(ctx context.Context, req *pb.CheckCMSAccess
 
 	// Check access to specific tab
 	cmsTab := domain.CMSTab(req.CmsTab)
-	allowed, err := h.casbinService.CheckCMSAccess(ctx, req.UserId, cmsTab, req.Action)
+	var allowed bool
+	var explanation *domain.AuthorizationExplanation
+	scope := pbResourceScopeToDomain(req.Scope)
+	if req.Explain && !scope.IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "explain is not supported for checks with a resource scope")
+	}
+	if req.Explain {
+		explanation, err = h.casbinService.ExplainCMSAccess(ctx, req.UserId, cmsTab, req.Action)
+		if err == nil {
+			allowed = explanation.Allowed
+		}
+	} else {
+		allowed, err = h.casbinService.CheckCMSAccess(ctx, req.UserId, cmsTab, req.Action, scope)
+	}
 	if err != nil {
 		h.logger.Error("Failed to check CMS access", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to check CMS access: %v", err)
@@ -71,6 +110,7 @@ func (h *GRPCHandler) CheckCMSAccess(ctx context.Context, req *pb.CheckCMSAccess
 		Allowed:        allowed,
 		Message:        message,
 		AccessibleTabs: accessibleTabs,
+		Explanation:    domainExplanationToPB(explanation),
 	}, nil
 }
 
@@ -80,13 +120,19 @@ func (h *GRPCHandler) EnforcePolicy(ctx context.Context, req *pb.EnforcePolicyRe
 		zap.String("user_id", req.UserId),
 		zap.String("domain", req.Domain),
 		zap.String("resource", req.Resource),
-		zap.String("action", req.Action))
+		zap.String("action", req.Action),
+		zap.Bool("explain", req.Explain))
 
 	authReq := &domain.AuthorizationRequest{
-		UserID:   req.UserId,
-		Domain:   domain.CasbinDomain(req.Domain),
-		Resource: req.Resource,
-		Action:   req.Action,
+		UserID:       req.UserId,
+		Domain:       domain.CasbinDomain(req.Domain),
+		Resource:     req.Resource,
+		Action:       req.Action,
+		Explain:      req.Explain,
+		Attributes:   req.Attributes,
+		Relation:     pbRelationCheckToDomain(req.Relation),
+		RelationMode: domain.RelationMode(req.RelationMode),
+		Scope:        pbRequestScopeToDomain(req.Scope),
 	}
 
 	response, err := h.casbinService.Enforce(ctx, authReq)
@@ -96,8 +142,384 @@ func (h *GRPCHandler) EnforcePolicy(ctx context.Context, req *pb.EnforcePolicyRe
 	}
 
 	return &pb.EnforcePolicyResponse{
-		Allowed: response.Allowed,
-		Reason:  response.Reason,
+		Allowed:     response.Allowed,
+		Reason:      response.Reason,
+		Explanation: domainExplanationToPB(response.Explanation),
+		Relation:    domainRelationDecisionToPB(response.Relation),
+	}, nil
+}
+
+// BatchCheckAPIAccess handles API access checks for several (path, method) pairs
+func (h *GRPCHandler) BatchCheckAPIAccess(ctx context.Context, req *pb.BatchCheckAPIAccessRequest) (*pb.BatchCheckAPIAccessResponse, error) {
+	h.logger.Info("BatchCheckAPIAccess request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("checks", len(req.Checks)))
+
+	if len(req.Checks) == 0 || len(req.Checks) > domain.MaxBatchAuthorizationSize {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d checks are required", domain.MaxBatchAuthorizationSize)
+	}
+
+	checks := make([]domain.APIAccessCheck, len(req.Checks))
+	for i, check := range req.Checks {
+		checks[i] = domain.APIAccessCheck{APIPath: check.ApiPath, Method: check.Method}
+	}
+
+	results, err := h.casbinService.BatchCheckAPIAccess(ctx, req.UserId, checks)
+	if err != nil {
+		h.logger.Error("Failed to check API access", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to check API access: %v", err)
+	}
+
+	return &pb.BatchCheckAPIAccessResponse{
+		Results: domainBatchResultsToPB(results),
+	}, nil
+}
+
+// ListAPIAccessHolders handles listing the users and roles allowed to call an API
+func (h *GRPCHandler) ListAPIAccessHolders(ctx context.Context, req *pb.ListAPIAccessHoldersRequest) (*pb.ListAccessHoldersResponse, error) {
+	h.logger.Info("ListAPIAccessHolders request received",
+		zap.String("api_resource_id", req.ApiResourceId),
+		zap.String("api_path", req.ApiPath),
+		zap.String("method", req.Method))
+
+	holders, total, err := h.casbinService.ListAPIAccessHolders(ctx, req.ApiResourceId, req.ApiPath, req.Method, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list API access holders", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list API access holders: %v", err)
+	}
+
+	return &pb.ListAccessHoldersResponse{
+		Holders: domainAccessHoldersToPB(holders),
+		Total:   int32(total),
+	}, nil
+}
+
+// ListCMSAccessHolders handles listing the users and roles allowed an action on a CMS tab
+func (h *GRPCHandler) ListCMSAccessHolders(ctx context.Context, req *pb.ListCMSAccessHoldersRequest) (*pb.ListAccessHoldersResponse, error) {
+	h.logger.Info("ListCMSAccessHolders request received",
+		zap.String("cms_tab", req.CmsTab),
+		zap.String("action", req.Action))
+
+	holders, total, err := h.casbinService.ListCMSAccessHolders(ctx, domain.CMSTab(req.CmsTab), req.Action, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list CMS access holders", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list CMS access holders: %v", err)
+	}
+
+	return &pb.ListAccessHoldersResponse{
+		Holders: domainAccessHoldersToPB(holders),
+		Total:   int32(total),
+	}, nil
+}
+
+// BatchEnforcePolicy handles policy enforcement for several requests
+func (h *GRPCHandler) BatchEnforcePolicy(ctx context.Context, req *pb.BatchEnforcePolicyRequest) (*pb.BatchEnforcePolicyResponse, error) {
+	h.logger.Info("BatchEnforcePolicy request received", zap.Int("requests", len(req.Requests)))
+
+	if len(req.Requests) == 0 || len(req.Requests) > domain.MaxBatchAuthorizationSize {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d requests are required", domain.MaxBatchAuthorizationSize)
+	}
+
+	authReqs := make([]*domain.AuthorizationRequest, len(req.Requests))
+	for i, r := range req.Requests {
+		authReqs[i] = &domain.AuthorizationRequest{
+			UserID:     r.UserId,
+			Domain:     domain.CasbinDomain(r.Domain),
+			Resource:   r.Resource,
+			Action:     r.Action,
+			Attributes: r.Attributes,
+			Scope:      pbRequestScopeToDomain(r.Scope),
+		}
+	}
+
+	results, err := h.casbinService.BatchEnforce(ctx, authReqs)
+	if err != nil {
+		h.logger.Error("Failed to enforce policies", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to enforce policies: %v", err)
+	}
+
+	return &pb.BatchEnforcePolicyResponse{
+		Results: domainBatchResultsToPB(results),
+	}, nil
+}
+
+// CreatePolicy handles adding a policy rule
+func (h *GRPCHandler) CreatePolicy(ctx context.Context, req *pb.CreatePolicyRequest) (*pb.CreatePolicyResponse, error) {
+	h.logger.Info("CreatePolicy request received",
+		zap.String("subject", req.Subject),
+		zap.String("domain", req.Domain),
+		zap.String("resource", req.Resource),
+		zap.String("action", req.Action),
+		zap.String("effect", req.Effect))
+
+	policy := &domain.Policy{
+		Subject:  req.Subject,
+		Domain:   domain.CasbinDomain(req.Domain),
+		Resource: req.Resource,
+		Action:   req.Action,
+		Effect:   domain.PolicyEffect(req.Effect),
+	}
+	if policy.Effect == "" {
+		policy.Effect = domain.PolicyEffectAllow
+	}
+
+	err := h.casbinService.AddPolicy(ctx, policy.Subject, policy.Domain, policy.Resource, policy.Action, policy.Effect)
+	if err != nil {
+		h.logger.Error("Failed to create policy", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create policy: %v", err)
+	}
+
+	return &pb.CreatePolicyResponse{
+		Policy:  domainPolicyToPB(policy),
+		Message: "Policy created successfully",
+	}, nil
+}
+
+// DeletePolicy handles removing a policy rule
+func (h *GRPCHandler) DeletePolicy(ctx context.Context, req *pb.DeletePolicyRequest) (*pb.DeletePolicyResponse, error) {
+	h.logger.Info("DeletePolicy request received",
+		zap.String("subject", req.Subject),
+		zap.String("domain", req.Domain),
+		zap.String("resource", req.Resource),
+		zap.String("action", req.Action),
+		zap.String("effect", req.Effect))
+
+	err := h.casbinService.RemovePolicy(ctx, req.Subject, domain.CasbinDomain(req.Domain), req.Resource, req.Action, domain.PolicyEffect(req.Effect))
+	if err != nil {
+		h.logger.Error("Failed to delete policy", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete policy: %v", err)
+	}
+
+	return &pb.DeletePolicyResponse{
+		Message: "Policy deleted successfully",
+	}, nil
+}
+
+// ListPolicies handles listing policy rules matching a filter
+func (h *GRPCHandler) ListPolicies(ctx context.Context, req *pb.ListPoliciesRequest) (*pb.ListPoliciesResponse, error) {
+	h.logger.Info("ListPolicies request received",
+		zap.String("subject", req.Subject),
+		zap.String("domain", req.Domain))
+
+	filter := domain.PolicyFilter{
+		Subject:  req.Subject,
+		Domain:   domain.CasbinDomain(req.Domain),
+		Resource: req.Resource,
+		Action:   req.Action,
+		Effect:   domain.PolicyEffect(req.Effect),
+	}
+
+	policies, total, err := h.casbinService.ListPolicies(ctx, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to list policies", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list policies: %v", err)
+	}
+
+	return &pb.ListPoliciesResponse{
+		Policies: domainPoliciesToPB(policies),
+		Total:    safeIntToInt32(total),
+	}, nil
+}
+
+// ExportPolicies handles exporting all policies, role links and CMS tab APIs
+func (h *GRPCHandler) ExportPolicies(ctx context.Context, req *pb.ExportPoliciesRequest) (*pb.ExportPoliciesResponse, error) {
+	h.logger.Info("ExportPolicies request received", zap.String("format", req.Format))
+
+	format := domain.PolicyBundleFormat(req.Format)
+	if format == "" {
+		format = domain.PolicyBundleFormatCSV
+	}
+
+	content, err := h.casbinService.ExportPolicies(ctx, format)
+	if err != nil {
+		h.logger.Error("Failed to export policies", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to export policies: %v", err)
+	}
+
+	return &pb.ExportPoliciesResponse{
+		Format:  string(format),
+		Content: string(content),
+	}, nil
+}
+
+// ImportPolicies handles replacing the live policies with an exported bundle
+func (h *GRPCHandler) ImportPolicies(ctx context.Context, req *pb.ImportPoliciesRequest) (*pb.ImportPoliciesResponse, error) {
+	h.logger.Info("ImportPolicies request received",
+		zap.String("format", req.Format),
+		zap.Bool("dry_run", req.DryRun))
+
+	format := domain.PolicyBundleFormat(req.Format)
+	if format == "" {
+		format = domain.PolicyBundleFormatCSV
+	}
+
+	diff, err := h.casbinService.ImportPolicies(ctx, []byte(req.Content), format, req.DryRun)
+	if err != nil {
+		h.logger.Error("Failed to import policies", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to import policies: %v", err)
+	}
+
+	return domainPolicyBundleDiffToPB(diff, req.DryRun), nil
+}
+
+// SimulatePolicyChanges handles reporting who would gain or lose access from a change set
+func (h *GRPCHandler) SimulatePolicyChanges(ctx context.Context, req *pb.SimulatePolicyChangesRequest) (*pb.SimulatePolicyChangesResponse, error) {
+	h.logger.Info("SimulatePolicyChanges request received",
+		zap.Int("add_policies", len(req.AddPolicies)),
+		zap.Int("remove_policies", len(req.RemovePolicies)),
+		zap.Int("add_role_links", len(req.AddRoleLinks)),
+		zap.Int("remove_role_links", len(req.RemoveRoleLinks)))
+
+	simulation, err := h.casbinService.SimulatePolicyChanges(ctx, pbPolicyChangeSetToDomain(req))
+	if err != nil {
+		h.logger.Error("Failed to simulate policy changes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to simulate policy changes: %v", err)
+	}
+
+	return &pb.SimulatePolicyChangesResponse{
+		Gained: domainAccessChangesToPB(simulation.Gained),
+		Lost:   domainAccessChangesToPB(simulation.Lost),
+	}, nil
+}
+
+// AddRoleParent handles making a role inherit another role
+func (h *GRPCHandler) AddRoleParent(ctx context.Context, req *pb.AddRoleParentRequest) (*pb.AddRoleParentResponse, error) {
+	h.logger.Info("AddRoleParent request received",
+		zap.String("role", req.Role),
+		zap.String("parent", req.Parent),
+		zap.String("domain", req.Domain))
+
+	if err := h.casbinService.AddRoleParent(ctx, req.Role, req.Parent, domain.CasbinDomain(req.Domain)); err != nil {
+		h.logger.Error("Failed to add role parent", zap.Error(err))
+		return nil, roleLinkStatusError("failed to add role parent", err)
+	}
+
+	return &pb.AddRoleParentResponse{
+		Message: "Role parent added successfully",
+	}, nil
+}
+
+// RemoveRoleParent handles removing a role's parent
+func (h *GRPCHandler) RemoveRoleParent(ctx context.Context, req *pb.RemoveRoleParentRequest) (*pb.RemoveRoleParentResponse, error) {
+	h.logger.Info("RemoveRoleParent request received",
+		zap.String("role", req.Role),
+		zap.String("parent", req.Parent),
+		zap.String("domain", req.Domain))
+
+	if err := h.casbinService.RemoveRoleParent(ctx, req.Role, req.Parent, domain.CasbinDomain(req.Domain)); err != nil {
+		h.logger.Error("Failed to remove role parent", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to remove role parent: %v", err)
+	}
+
+	return &pb.RemoveRoleParentResponse{
+		Message: "Role parent removed successfully",
+	}, nil
+}
+
+// GetRoleHierarchy handles getting a role's ancestors, descendants and effective policies
+func (h *GRPCHandler) GetRoleHierarchy(ctx context.Context, req *pb.GetRoleHierarchyRequest) (*pb.GetRoleHierarchyResponse, error) {
+	h.logger.Info("GetRoleHierarchy request received",
+		zap.String("role", req.Role),
+		zap.String("domain", req.Domain))
+
+	hierarchy, err := h.casbinService.GetRoleHierarchy(ctx, req.Role, domain.CasbinDomain(req.Domain))
+	if err != nil {
+		h.logger.Error("Failed to get role hierarchy", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get role hierarchy: %v", err)
+	}
+
+	return &pb.GetRoleHierarchyResponse{
+		Role:        hierarchy.Role,
+		Domain:      string(hierarchy.Domain),
+		Parents:     hierarchy.Parents,
+		Ancestors:   hierarchy.Ancestors,
+		Descendants: hierarchy.Descendants,
+		Policies:    domainPoliciesToPB(hierarchy.Policies),
+	}, nil
+}
+
+// GetEffectivePermissions handles listing what a user holds across roles, domains and CMS roles
+func (h *GRPCHandler) GetEffectivePermissions(ctx context.Context, req *pb.GetEffectivePermissionsRequest) (*pb.GetEffectivePermissionsResponse, error) {
+	h.logger.Info("GetEffectivePermissions request received",
+		zap.String("user_id", req.UserId),
+		zap.String("domain", req.Domain))
+
+	permissions, err := h.casbinService.GetEffectivePermissions(ctx, req.UserId, domain.CasbinDomain(req.Domain))
+	if err != nil {
+		h.logger.Error("Failed to get effective permissions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get effective permissions: %v", err)
+	}
+
+	return &pb.GetEffectivePermissionsResponse{
+		Permissions: domainEffectivePermissionsToPB(permissions),
+	}, nil
+}
+
+// SetPolicyCondition handles attaching a condition to a policy
+func (h *GRPCHandler) SetPolicyCondition(ctx context.Context, req *pb.SetPolicyConditionRequest) (*pb.SetPolicyConditionResponse, error) {
+	h.logger.Info("SetPolicyCondition request received",
+		zap.String("subject", req.Subject),
+		zap.String("domain", req.Domain),
+		zap.String("resource", req.Resource),
+		zap.String("action", req.Action),
+		zap.String("effect", req.Effect))
+
+	condition, err := h.casbinService.SetPolicyCondition(ctx, &domain.PolicyCondition{
+		Subject:    req.Subject,
+		Domain:     domain.CasbinDomain(req.Domain),
+		Resource:   req.Resource,
+		Action:     req.Action,
+		Effect:     domain.PolicyEffect(req.Effect),
+		Expression: req.Expression,
+	})
+	if err != nil {
+		h.logger.Error("Failed to set policy condition", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set policy condition: %v", err)
+	}
+
+	return &pb.SetPolicyConditionResponse{
+		Condition: domainPolicyConditionToPB(condition),
+	}, nil
+}
+
+// DeletePolicyCondition handles detaching the condition from a policy
+func (h *GRPCHandler) DeletePolicyCondition(ctx context.Context, req *pb.DeletePolicyConditionRequest) (*pb.DeletePolicyConditionResponse, error) {
+	h.logger.Info("DeletePolicyCondition request received",
+		zap.String("subject", req.Subject),
+		zap.String("domain", req.Domain),
+		zap.String("resource", req.Resource),
+		zap.String("action", req.Action),
+		zap.String("effect", req.Effect))
+
+	err := h.casbinService.RemovePolicyCondition(ctx, req.Subject, domain.CasbinDomain(req.Domain),
+		req.Resource, req.Action, domain.PolicyEffect(req.Effect))
+	if err != nil {
+		h.logger.Error("Failed to delete policy condition", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete policy condition: %v", err)
+	}
+
+	return &pb.DeletePolicyConditionResponse{
+		Message: "Policy condition deleted successfully",
+	}, nil
+}
+
+// ListPolicyConditions handles listing policy conditions
+func (h *GRPCHandler) ListPolicyConditions(ctx context.Context, req *pb.ListPolicyConditionsRequest) (*pb.ListPolicyConditionsResponse, error) {
+	h.logger.Info("ListPolicyConditions request received", zap.String("domain", req.Domain))
+
+	conditions, err := h.casbinService.ListPolicyConditions(ctx, domain.CasbinDomain(req.Domain))
+	if err != nil {
+		h.logger.Error("Failed to list policy conditions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list policy conditions: %v", err)
+	}
+
+	pbConditions := make([]*pb.PolicyCondition, len(conditions))
+	for i, condition := range conditions {
+		pbConditions[i] = domainPolicyConditionToPB(condition)
+	}
+
+	return &pb.ListPolicyConditionsResponse{
+		Conditions: pbConditions,
 	}, nil
 }
 
@@ -105,12 +527,13 @@ func (h *GRPCHandler) EnforcePolicy(ctx context.Context, req *pb.EnforcePolicyRe
 func (h *GRPCHandler) CreateCMSRole(ctx context.Context, req *pb.CreateCMSRoleRequest) (*pb.CreateCMSRoleResponse, error) {
 	h.logger.Info("CreateCMSRole request received", zap.String("name", req.Name))
 
-	tabs := make([]domain.CMSTab, len(req.Tabs))
-	for i, tab := range req.Tabs {
-		tabs[i] = domain.CMSTab(tab)
+	// Plain tabs are granted view-only access
+	permissions := pbCMSTabPermissionsToDomain(req.Permissions)
+	for _, tab := range req.Tabs {
+		permissions = append(permissions, domain.CMSTabPermission{Tab: domain.CMSTab(tab)})
 	}
 
-	cmsRole, err := h.casbinService.CreateCMSRole(ctx, req.Name, req.Description, tabs)
+	cmsRole, err := h.casbinService.CreateCMSRole(ctx, req.Name, req.Description, permissions)
 	if err != nil {
 		h.logger.Error("Failed to create CMS role", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to create CMS role: %v", err)
@@ -126,11 +549,21 @@ func (h *GRPCHandler) CreateCMSRole(ctx context.Context, req *pb.CreateCMSRoleRe
 func (h *GRPCHandler) AssignCMSRole(ctx context.Context, req *pb.AssignCMSRoleRequest) (*pb.AssignCMSRoleResponse, error) {
 	h.logger.Info("AssignCMSRole request received",
 		zap.String("user_id", req.UserId),
-		zap.String("cms_role_id", req.CmsRoleId))
+		zap.String("cms_role_id", req.CmsRoleId),
+		zap.Stringer("scope", pbResourceScopeToDomain(req.Scope)))
+
+	window, err := pbAssignmentWindowToDomain(req.StartsAt, req.ExpiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	scope := pbResourceScopeToDomain(req.Scope)
+	if err := scope.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %v", err)
+	}
 
-	if err := h.casbinService.AssignCMSRole(ctx, req.UserId, req.CmsRoleId); err != nil {
+	if err := h.casbinService.AssignCMSRole(ctx, req.UserId, req.CmsRoleId, scope, window); err != nil {
 		h.logger.Error("Failed to assign CMS role", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to assign CMS role: %v", err)
+		return nil, roleLinkStatusError("failed to assign CMS role", err)
 	}
 
 	return &pb.AssignCMSRoleResponse{
@@ -142,9 +575,10 @@ func (h *GRPCHandler) AssignCMSRole(ctx context.Context, req *pb.AssignCMSRoleRe
 func (h *GRPCHandler) RemoveCMSRole(ctx context.Context, req *pb.RemoveCMSRoleRequest) (*pb.RemoveCMSRoleResponse, error) {
 	h.logger.Info("RemoveCMSRole request received",
 		zap.String("user_id", req.UserId),
-		zap.String("cms_role_id", req.CmsRoleId))
+		zap.String("cms_role_id", req.CmsRoleId),
+		zap.Stringer("scope", pbResourceScopeToDomain(req.Scope)))
 
-	if err := h.casbinService.RemoveCMSRole(ctx, req.UserId, req.CmsRoleId); err != nil {
+	if err := h.casbinService.RemoveCMSRole(ctx, req.UserId, req.CmsRoleId, pbResourceScopeToDomain(req.Scope)); err != nil {
 		h.logger.Error("Failed to remove CMS role", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to remove CMS role: %v", err)
 	}
@@ -158,12 +592,13 @@ func (h *GRPCHandler) RemoveCMSRole(ctx context.Context, req *pb.RemoveCMSRoleRe
 func (h *GRPCHandler) GetUserCMSTabs(ctx context.Context, req *pb.GetUserCMSTabsRequest) (*pb.GetUserCMSTabsResponse, error) {
 	h.logger.Info("GetUserCMSTabs request received", zap.String("user_id", req.UserId))
 
-	tabs, err := h.casbinService.GetUserCMSTabs(ctx, req.UserId)
+	tree, err := h.casbinService.GetUserCMSTabTree(ctx, req.UserId)
 	if err != nil {
 		h.logger.Error("Failed to get user CMS tabs", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to get user CMS tabs: %v", err)
 	}
 
+	tabs := domain.FlattenCMSTabTree(tree)
 	tabStrings := make([]string, len(tabs))
 	for i, tab := range tabs {
 		tabStrings[i] = string(tab)
@@ -171,6 +606,30 @@ func (h *GRPCHandler) GetUserCMSTabs(ctx context.Context, req *pb.GetUserCMSTabs
 
 	return &pb.GetUserCMSTabsResponse{
 		Tabs: tabStrings,
+		Tree: domainCMSTabNodesToPB(tree),
+	}, nil
+}
+
+// GetCMSCapabilities handles getting the user's CMS capability map
+func (h *GRPCHandler) GetCMSCapabilities(ctx context.Context, req *pb.GetCMSCapabilitiesRequest) (*pb.GetCMSCapabilitiesResponse, error) {
+	h.logger.Info("GetCMSCapabilities request received", zap.String("user_id", req.UserId))
+
+	capabilities, err := h.casbinService.GetCMSCapabilities(ctx, req.UserId, req.Attributes, pbResourceScopeToDomain(req.Scope))
+	if err != nil {
+		h.logger.Error("Failed to get CMS capabilities", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get CMS capabilities: %v", err)
+	}
+
+	if req.KnownVersion != "" && req.KnownVersion == capabilities.Version {
+		return &pb.GetCMSCapabilitiesResponse{
+			Version:     capabilities.Version,
+			NotModified: true,
+		}, nil
+	}
+
+	return &pb.GetCMSCapabilitiesResponse{
+		Version: capabilities.Version,
+		Tabs:    domainCMSCapabilitiesToPB(capabilities.Tabs),
 	}, nil
 }
 
@@ -187,6 +646,306 @@ func (h *GRPCHandler) ListCMSRoles(ctx context.Context, req *pb.ListCMSRolesRequ
 	}, nil
 }
 
+// GetCMSRole handles getting a single CMS role
+func (h *GRPCHandler) GetCMSRole(ctx context.Context, req *pb.GetCMSRoleRequest) (*pb.GetCMSRoleResponse, error) {
+	h.logger.Info("GetCMSRole request received", zap.String("cms_role_id", req.CmsRoleId))
+
+	cmsRole, err := h.casbinService.GetCMSRole(ctx, req.CmsRoleId)
+	if err != nil {
+		h.logger.Error("Failed to get CMS role", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "CMS role not found")
+	}
+
+	return &pb.GetCMSRoleResponse{
+		Role: domainCMSRoleToPB(cmsRole),
+	}, nil
+}
+
+// UpdateCMSRole handles CMS role update
+func (h *GRPCHandler) UpdateCMSRole(ctx context.Context, req *pb.UpdateCMSRoleRequest) (*pb.UpdateCMSRoleResponse, error) {
+	h.logger.Info("UpdateCMSRole request received", zap.String("cms_role_id", req.CmsRoleId))
+
+	permissions := pbCMSTabPermissionsToDomain(req.Permissions)
+	if _, err := h.casbinService.UpdateCMSRole(ctx, req.CmsRoleId, req.Name, req.Description, permissions); err != nil {
+		h.logger.Error("Failed to update CMS role", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update CMS role: %v", err)
+	}
+
+	return &pb.UpdateCMSRoleResponse{
+		Message: "CMS role updated successfully",
+	}, nil
+}
+
+// DeleteCMSRole handles CMS role deletion
+func (h *GRPCHandler) DeleteCMSRole(ctx context.Context, req *pb.DeleteCMSRoleRequest) (*pb.DeleteCMSRoleResponse, error) {
+	h.logger.Info("DeleteCMSRole request received", zap.String("cms_role_id", req.CmsRoleId))
+
+	impact, err := h.casbinService.DeleteCMSRole(ctx, req.CmsRoleId, req.Cascade)
+	if err != nil {
+		h.logger.Error("Failed to delete CMS role", zap.Error(err))
+		var inUse *domain.RoleInUseError
+		if errors.As(err, &inUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to delete CMS role: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete CMS role: %v", err)
+	}
+
+	return &pb.DeleteCMSRoleResponse{
+		Message: "CMS role deleted successfully",
+		Impact:  domainRoleImpactToPB(impact),
+	}, nil
+}
+
+// SetCMSRoleFieldPermission handles setting a CMS role's field rule for a resource type
+func (h *GRPCHandler) SetCMSRoleFieldPermission(ctx context.Context, req *pb.SetCMSRoleFieldPermissionRequest) (*pb.SetCMSRoleFieldPermissionResponse, error) {
+	h.logger.Info("SetCMSRoleFieldPermission request received",
+		zap.String("cms_role_id", req.CmsRoleId),
+		zap.String("resource_type", req.ResourceType))
+
+	perm, err := h.casbinService.SetCMSRoleFieldPermission(ctx, req.CmsRoleId, req.ResourceType, req.ReadableFields, req.WritableFields)
+	if err != nil {
+		h.logger.Error("Failed to set CMS field permission", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set CMS field permission: %v", err)
+	}
+
+	return &pb.SetCMSRoleFieldPermissionResponse{
+		Permission: domainCMSFieldPermissionToPB(perm),
+		Message:    "CMS field permission saved successfully",
+	}, nil
+}
+
+// ListCMSRoleFieldPermissions handles listing a CMS role's field rules
+func (h *GRPCHandler) ListCMSRoleFieldPermissions(ctx context.Context, req *pb.ListCMSRoleFieldPermissionsRequest) (*pb.ListCMSRoleFieldPermissionsResponse, error) {
+	h.logger.Info("ListCMSRoleFieldPermissions request received", zap.String("cms_role_id", req.CmsRoleId))
+
+	perms, err := h.casbinService.ListCMSRoleFieldPermissions(ctx, req.CmsRoleId)
+	if err != nil {
+		h.logger.Error("Failed to list CMS field permissions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list CMS field permissions: %v", err)
+	}
+
+	pbPerms := make([]*pb.CMSFieldPermission, len(perms))
+	for i, perm := range perms {
+		pbPerms[i] = domainCMSFieldPermissionToPB(perm)
+	}
+
+	return &pb.ListCMSRoleFieldPermissionsResponse{
+		Permissions: pbPerms,
+	}, nil
+}
+
+// DeleteCMSRoleFieldPermission handles removing a CMS role's field rule
+func (h *GRPCHandler) DeleteCMSRoleFieldPermission(ctx context.Context, req *pb.DeleteCMSRoleFieldPermissionRequest) (*pb.DeleteCMSRoleFieldPermissionResponse, error) {
+	h.logger.Info("DeleteCMSRoleFieldPermission request received",
+		zap.String("cms_role_id", req.CmsRoleId),
+		zap.String("resource_type", req.ResourceType))
+
+	if err := h.casbinService.DeleteCMSRoleFieldPermission(ctx, req.CmsRoleId, req.ResourceType); err != nil {
+		h.logger.Error("Failed to delete CMS field permission", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete CMS field permission: %v", err)
+	}
+
+	return &pb.DeleteCMSRoleFieldPermissionResponse{
+		Message: "CMS field permission deleted successfully",
+	}, nil
+}
+
+// GetUserFieldPermissions handles getting the fields a user may read and write on a resource type
+func (h *GRPCHandler) GetUserFieldPermissions(ctx context.Context, req *pb.GetUserFieldPermissionsRequest) (*pb.GetUserFieldPermissionsResponse, error) {
+	h.logger.Info("GetUserFieldPermissions request received",
+		zap.String("user_id", req.UserId),
+		zap.String("resource_type", req.ResourceType))
+
+	fields, err := h.casbinService.GetUserFieldPermissions(ctx, req.UserId, req.ResourceType)
+	if err != nil {
+		h.logger.Error("Failed to get user field permissions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get user field permissions: %v", err)
+	}
+
+	return &pb.GetUserFieldPermissionsResponse{
+		ResourceType:   fields.ResourceType,
+		ReadableFields: fields.Readable,
+		WritableFields: fields.Writable,
+		Scoped:         domainScopedFieldPermissionsToPB(fields.Scoped),
+	}, nil
+}
+
+// CreateCMSTab handles registering a CMS tab
+func (h *GRPCHandler) CreateCMSTab(ctx context.Context, req *pb.CreateCMSTabRequest) (*pb.CreateCMSTabResponse, error) {
+	h.logger.Info("CreateCMSTab request received", zap.String("key", req.Key))
+
+	tab, err := h.casbinService.CreateCMSTab(ctx, &domain.CMSTabDefinition{
+		Key:         domain.CMSTab(req.Key),
+		DisplayName: req.DisplayName,
+		Icon:        req.Icon,
+		ParentKey:   domain.CMSTab(req.ParentKey),
+		SortOrder:   int(req.SortOrder),
+	})
+	if err != nil {
+		h.logger.Error("Failed to create CMS tab", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create CMS tab: %v", err)
+	}
+
+	return &pb.CreateCMSTabResponse{
+		Key:     string(tab.Key),
+		Message: "CMS tab created successfully",
+	}, nil
+}
+
+// GetCMSTab handles getting a single CMS tab
+func (h *GRPCHandler) GetCMSTab(ctx context.Context, req *pb.GetCMSTabRequest) (*pb.GetCMSTabResponse, error) {
+	h.logger.Info("GetCMSTab request received", zap.String("key", req.Key))
+
+	tab, err := h.casbinService.GetCMSTab(ctx, domain.CMSTab(req.Key))
+	if err != nil {
+		h.logger.Error("Failed to get CMS tab", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "CMS tab not found")
+	}
+
+	return &pb.GetCMSTabResponse{
+		Tab: domainCMSTabToPB(tab),
+	}, nil
+}
+
+// ListCMSTabs handles listing registered CMS tabs
+func (h *GRPCHandler) ListCMSTabs(ctx context.Context, req *pb.ListCMSTabsRequest) (*pb.ListCMSTabsResponse, error) {
+	h.logger.Info("ListCMSTabs request received")
+
+	tabs, err := h.casbinService.ListCMSTabs(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list CMS tabs", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list CMS tabs: %v", err)
+	}
+
+	pbTabs := make([]*pb.CMSTabDefinition, len(tabs))
+	for i, tab := range tabs {
+		pbTabs[i] = domainCMSTabToPB(tab)
+	}
+
+	return &pb.ListCMSTabsResponse{
+		Tabs:  pbTabs,
+		Total: safeIntToInt32(len(pbTabs)),
+	}, nil
+}
+
+// UpdateCMSTab handles CMS tab update
+func (h *GRPCHandler) UpdateCMSTab(ctx context.Context, req *pb.UpdateCMSTabRequest) (*pb.UpdateCMSTabResponse, error) {
+	h.logger.Info("UpdateCMSTab request received", zap.String("key", req.Key))
+
+	_, err := h.casbinService.UpdateCMSTab(ctx, &domain.CMSTabDefinition{
+		Key:         domain.CMSTab(req.Key),
+		DisplayName: req.DisplayName,
+		Icon:        req.Icon,
+		ParentKey:   domain.CMSTab(req.ParentKey),
+		SortOrder:   int(req.SortOrder),
+	})
+	if err != nil {
+		h.logger.Error("Failed to update CMS tab", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update CMS tab: %v", err)
+	}
+
+	return &pb.UpdateCMSTabResponse{
+		Message: "CMS tab updated successfully",
+	}, nil
+}
+
+// DeleteCMSTab handles CMS tab deletion
+func (h *GRPCHandler) DeleteCMSTab(ctx context.Context, req *pb.DeleteCMSTabRequest) (*pb.DeleteCMSTabResponse, error) {
+	h.logger.Info("DeleteCMSTab request received", zap.String("key", req.Key))
+
+	if err := h.casbinService.DeleteCMSTab(ctx, domain.CMSTab(req.Key)); err != nil {
+		h.logger.Error("Failed to delete CMS tab", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete CMS tab: %v", err)
+	}
+
+	return &pb.DeleteCMSTabResponse{
+		Message: "CMS tab deleted successfully",
+	}, nil
+}
+
+// CreateResourceScope handles registering a resource scope below its parent
+func (h *GRPCHandler) CreateResourceScope(ctx context.Context, req *pb.CreateResourceScopeRequest) (*pb.ResourceScopeResponse, error) {
+	h.logger.Info("CreateResourceScope request received",
+		zap.String("type", req.Type),
+		zap.String("id", req.Id))
+
+	scope := &domain.ResourceScopeDefinition{
+		ResourceScope: domain.ResourceScope{Type: req.Type, ID: req.Id},
+		Name:          req.Name,
+	}
+	if req.Parent != nil {
+		parent := pbResourceScopeToDomain(req.Parent)
+		scope.Parent = &parent
+	}
+
+	created, err := h.casbinService.CreateResourceScope(ctx, scope)
+	if err != nil {
+		h.logger.Error("Failed to create resource scope", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create resource scope: %v", err)
+	}
+
+	return &pb.ResourceScopeResponse{
+		Scope:   domainResourceScopeDefinitionToPB(created),
+		Message: "Resource scope created successfully",
+	}, nil
+}
+
+// ListResourceScopes handles listing resource scopes
+func (h *GRPCHandler) ListResourceScopes(ctx context.Context, req *pb.ListResourceScopesRequest) (*pb.ListResourceScopesResponse, error) {
+	h.logger.Info("ListResourceScopes request received", zap.String("type", req.Type))
+
+	scopes, err := h.casbinService.ListResourceScopes(ctx, req.Type)
+	if err != nil {
+		h.logger.Error("Failed to list resource scopes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list resource scopes: %v", err)
+	}
+
+	pbScopes := make([]*pb.ResourceScopeDefinition, len(scopes))
+	for i, scope := range scopes {
+		pbScopes[i] = domainResourceScopeDefinitionToPB(scope)
+	}
+
+	return &pb.ListResourceScopesResponse{
+		Scopes: pbScopes,
+	}, nil
+}
+
+// DeleteResourceScope handles deleting a resource scope without child scopes
+func (h *GRPCHandler) DeleteResourceScope(ctx context.Context, req *pb.DeleteResourceScopeRequest) (*pb.DeleteResourceScopeResponse, error) {
+	h.logger.Info("DeleteResourceScope request received",
+		zap.String("type", req.Type),
+		zap.String("id", req.Id))
+
+	if err := h.casbinService.DeleteResourceScope(ctx, domain.ResourceScope{Type: req.Type, ID: req.Id}); err != nil {
+		h.logger.Error("Failed to delete resource scope", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete resource scope: %v", err)
+	}
+
+	return &pb.DeleteResourceScopeResponse{
+		Message: "Resource scope deleted successfully",
+	}, nil
+}
+
+// ListUserScopedCMSRoles handles listing the CMS roles a user holds within resource scopes
+func (h *GRPCHandler) ListUserScopedCMSRoles(ctx context.Context, req *pb.ListUserScopedCMSRolesRequest) (*pb.ListUserScopedCMSRolesResponse, error) {
+	h.logger.Info("ListUserScopedCMSRoles request received", zap.String("user_id", req.UserId))
+
+	assignments, err := h.casbinService.ListUserScopedCMSRoles(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to list scoped CMS roles", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list scoped CMS roles: %v", err)
+	}
+
+	pbAssignments := make([]*pb.ScopedCMSRoleAssignment, len(assignments))
+	for i, assignment := range assignments {
+		pbAssignments[i] = domainScopedCMSRoleAssignmentToPB(assignment)
+	}
+
+	return &pb.ListUserScopedCMSRolesResponse{
+		Assignments: pbAssignments,
+	}, nil
+}
+
 // CreateAPIResource handles API resource creation
 func (h *GRPCHandler) CreateAPIResource(ctx context.Context, req *pb.CreateAPIResourceRequest) (*pb.CreateAPIResourceResponse, error) {
 	h.logger.Info("CreateAPIResource request received",
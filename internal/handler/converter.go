@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/tvttt/iam-services/internal/domain"
 	pb "github.com/tvttt/iam-services/pkg/proto"
 )
@@ -45,3 +48,817 @@ func domainPermissionToPB(perm *domain.Permission) *pb.Permission {
 		UpdatedAt:   perm.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 }
+
+// domainCMSRoleToPB converts domain.CMSRole to pb.CMSRole
+func domainCMSRoleToPB(role *domain.CMSRole) *pb.CMSRole {
+	if role == nil {
+		return nil
+	}
+
+	pbRole := &pb.CMSRole{
+		Id:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Tabs:        make([]string, len(role.Tabs)),
+		Permissions: make([]*pb.CMSTabPermission, len(role.Permissions)),
+		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+
+	for i, tab := range role.Tabs {
+		pbRole.Tabs[i] = string(tab)
+	}
+	for i, perm := range role.Permissions {
+		actions := make([]string, len(perm.Actions))
+		for j, action := range perm.Actions {
+			actions[j] = string(action)
+		}
+		pbRole.Permissions[i] = &pb.CMSTabPermission{
+			Tab:       string(perm.Tab),
+			Actions:   actions,
+			NoActions: perm.Actions != nil && len(perm.Actions) == 0,
+		}
+	}
+
+	return pbRole
+}
+
+// pbCMSTabPermissionsToDomain converts pb.CMSTabPermission list to domain.CMSTabPermission list
+func pbCMSTabPermissionsToDomain(perms []*pb.CMSTabPermission) []domain.CMSTabPermission {
+	result := make([]domain.CMSTabPermission, 0, len(perms))
+	for _, perm := range perms {
+		if perm == nil {
+			continue
+		}
+		// Without actions a tab is view-only unless no_actions asks for an empty grant
+		var actions []domain.CMSAction
+		if len(perm.Actions) > 0 || perm.NoActions {
+			actions = make([]domain.CMSAction, len(perm.Actions))
+		}
+		for i, action := range perm.Actions {
+			actions[i] = domain.CMSAction(action)
+		}
+		result = append(result, domain.CMSTabPermission{
+			Tab:     domain.CMSTab(perm.Tab),
+			Actions: actions,
+		})
+	}
+	return result
+}
+
+// domainCMSTabToPB converts domain.CMSTabDefinition to pb.CMSTabDefinition
+func domainCMSTabToPB(tab *domain.CMSTabDefinition) *pb.CMSTabDefinition {
+	if tab == nil {
+		return nil
+	}
+
+	return &pb.CMSTabDefinition{
+		Key:         string(tab.Key),
+		DisplayName: tab.DisplayName,
+		Icon:        tab.Icon,
+		ParentKey:   string(tab.ParentKey),
+		SortOrder:   safeIntToInt32(tab.SortOrder),
+		CreatedAt:   tab.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   tab.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// domainCMSTabNodesToPB converts a domain.CMSTabNode tree to pb.CMSTabNode
+func domainCMSTabNodesToPB(nodes []*domain.CMSTabNode) []*pb.CMSTabNode {
+	result := make([]*pb.CMSTabNode, len(nodes))
+	for i, node := range nodes {
+		actions := make([]string, len(node.Actions))
+		for j, action := range node.Actions {
+			actions[j] = string(action)
+		}
+		result[i] = &pb.CMSTabNode{
+			Key:         string(node.Key),
+			DisplayName: node.DisplayName,
+			Icon:        node.Icon,
+			SortOrder:   safeIntToInt32(node.SortOrder),
+			Actions:     actions,
+			Children:    domainCMSTabNodesToPB(node.Children),
+		}
+	}
+	return result
+}
+
+// domainCMSCapabilitiesToPB converts domain.CMSCapability list to pb.CMSCapability list
+func domainCMSCapabilitiesToPB(capabilities []domain.CMSCapability) []*pb.CMSCapability {
+	result := make([]*pb.CMSCapability, len(capabilities))
+	for i, capability := range capabilities {
+		actions := make([]string, len(capability.Actions))
+		for j, action := range capability.Actions {
+			actions[j] = string(action)
+		}
+		apis := make([]*pb.CMSTabAPI, len(capability.APIs))
+		for j, api := range capability.APIs {
+			apis[j] = &pb.CMSTabAPI{
+				Path:        api.Path,
+				Method:      api.Method,
+				Description: api.Description,
+			}
+		}
+		result[i] = &pb.CMSCapability{
+			Tab:         string(capability.Tab),
+			DisplayName: capability.DisplayName,
+			ParentKey:   string(capability.ParentKey),
+			Actions:     actions,
+			Apis:        apis,
+		}
+	}
+	return result
+}
+
+// domainCMSFieldPermissionToPB converts domain.CMSFieldPermission to pb.CMSFieldPermission
+func domainCMSFieldPermissionToPB(perm *domain.CMSFieldPermission) *pb.CMSFieldPermission {
+	if perm == nil {
+		return nil
+	}
+
+	return &pb.CMSFieldPermission{
+		Id:             perm.ID,
+		CmsRoleId:      perm.CMSRoleID,
+		ResourceType:   perm.ResourceType,
+		ReadableFields: perm.ReadableFields,
+		WritableFields: perm.WritableFields,
+		CreatedAt:      perm.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      perm.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// domainPolicyToPB converts domain.Policy to pb.Policy
+func domainPolicyToPB(policy *domain.Policy) *pb.Policy {
+	if policy == nil {
+		return nil
+	}
+
+	return &pb.Policy{
+		Subject:  policy.Subject,
+		Domain:   string(policy.Domain),
+		Resource: policy.Resource,
+		Action:   policy.Action,
+		Effect:   string(policy.Effect),
+	}
+}
+
+// domainPoliciesToPB converts a list of domain.Policy to pb.Policy
+func domainPoliciesToPB(policies []*domain.Policy) []*pb.Policy {
+	pbPolicies := make([]*pb.Policy, len(policies))
+	for i, policy := range policies {
+		pbPolicies[i] = domainPolicyToPB(policy)
+	}
+	return pbPolicies
+}
+
+// domainRoleLinksToPB converts a list of domain.RoleLink to pb.RoleLink
+func domainRoleLinksToPB(links []domain.RoleLink) []*pb.RoleLink {
+	pbLinks := make([]*pb.RoleLink, len(links))
+	for i, link := range links {
+		pbLinks[i] = &pb.RoleLink{
+			Member: link.Member,
+			Role:   link.Role,
+			Domain: string(link.Domain),
+		}
+	}
+	return pbLinks
+}
+
+// domainCMSTabAPIsToPB converts a list of domain.CMSTabAPI to pb.CMSTabAPI
+func domainCMSTabAPIsToPB(apis []domain.CMSTabAPI) []*pb.CMSTabAPI {
+	pbAPIs := make([]*pb.CMSTabAPI, len(apis))
+	for i, api := range apis {
+		pbAPIs[i] = &pb.CMSTabAPI{
+			Tab:         string(api.Tab),
+			Path:        api.Path,
+			Method:      api.Method,
+			Description: api.Description,
+		}
+	}
+	return pbAPIs
+}
+
+// domainPolicyBundleDiffToPB converts domain.PolicyBundleDiff to pb.ImportPoliciesResponse
+func domainPolicyBundleDiffToPB(diff *domain.PolicyBundleDiff, dryRun bool) *pb.ImportPoliciesResponse {
+	policies := func(list []domain.Policy) []*pb.Policy {
+		pbPolicies := make([]*pb.Policy, len(list))
+		for i := range list {
+			pbPolicies[i] = domainPolicyToPB(&list[i])
+		}
+		return pbPolicies
+	}
+
+	return &pb.ImportPoliciesResponse{
+		DryRun:            dryRun,
+		AddedPolicies:     policies(diff.AddedPolicies),
+		RemovedPolicies:   policies(diff.RemovedPolicies),
+		AddedRoleLinks:    domainRoleLinksToPB(diff.AddedRoleLinks),
+		RemovedRoleLinks:  domainRoleLinksToPB(diff.RemovedRoleLinks),
+		AddedCmsTabApis:   domainCMSTabAPIsToPB(diff.AddedCMSTabAPIs),
+		RemovedCmsTabApis: domainCMSTabAPIsToPB(diff.RemovedCMSTabAPIs),
+	}
+}
+
+// domainRoleImpactToPB converts domain.RoleImpact to pb.RoleImpact
+func domainRoleImpactToPB(impact *domain.RoleImpact) *pb.RoleImpact {
+	if impact == nil {
+		return nil
+	}
+
+	policies := make([]*pb.Policy, len(impact.Policies))
+	for i := range impact.Policies {
+		policies[i] = domainPolicyToPB(&impact.Policies[i])
+	}
+	return &pb.RoleImpact{
+		Role:     impact.Role,
+		Policies: policies,
+		Members:  domainRoleLinksToPB(impact.Members),
+		Parents:  domainRoleLinksToPB(impact.Parents),
+	}
+}
+
+// domainEffectivePermissionsToPB converts a list of domain.EffectivePermission to pb.EffectivePermission
+func domainEffectivePermissionsToPB(permissions []*domain.EffectivePermission) []*pb.EffectivePermission {
+	pbPermissions := make([]*pb.EffectivePermission, len(permissions))
+	for i, permission := range permissions {
+		sources := make([]*pb.PermissionSource, len(permission.Sources))
+		for j, source := range permission.Sources {
+			sources[j] = &pb.PermissionSource{
+				Role:      source.Role,
+				RoleChain: source.RoleChain,
+				Condition: source.Condition,
+				Scope:     domainOptionalResourceScopeToPB(source.Scope),
+			}
+		}
+		pbPermissions[i] = &pb.EffectivePermission{
+			Domain:   string(permission.Domain),
+			Resource: permission.Resource,
+			Action:   permission.Action,
+			Effect:   string(permission.Effect),
+			Sources:  sources,
+		}
+	}
+	return pbPermissions
+}
+
+// domainAccessHoldersToPB converts a list of domain.AccessHolder to pb.AccessHolder
+func domainAccessHoldersToPB(holders []*domain.AccessHolder) []*pb.AccessHolder {
+	pbHolders := make([]*pb.AccessHolder, len(holders))
+	for i, holder := range holders {
+		pbHolders[i] = &pb.AccessHolder{
+			Subject:   holder.Subject,
+			Kind:      string(holder.Kind),
+			Policy:    domainPolicyToPB(&holder.Policy),
+			RoleChain: holder.RoleChain,
+			Condition: holder.Condition,
+			Scope:     domainOptionalResourceScopeToPB(holder.Scope),
+		}
+	}
+	return pbHolders
+}
+
+// pbAssignmentWindowToDomain parses and validates the RFC 3339 bounds of a role assignment; empty
+// bounds are open
+func pbAssignmentWindowToDomain(startsAt, expiresAt string) (domain.AssignmentWindow, error) {
+	var window domain.AssignmentWindow
+	if startsAt != "" {
+		t, err := time.Parse(time.RFC3339, startsAt)
+		if err != nil {
+			return window, fmt.Errorf("invalid starts_at: %w", err)
+		}
+		window.StartsAt = &t
+	}
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return window, fmt.Errorf("invalid expires_at: %w", err)
+		}
+		window.ExpiresAt = &t
+	}
+	return window, window.Validate(time.Now())
+}
+
+// pbPolicyChangeSetToDomain converts pb.SimulatePolicyChangesRequest to domain.PolicyChangeSet
+func pbPolicyChangeSetToDomain(req *pb.SimulatePolicyChangesRequest) *domain.PolicyChangeSet {
+	policies := func(list []*pb.Policy) []domain.Policy {
+		result := make([]domain.Policy, len(list))
+		for i, policy := range list {
+			result[i] = domain.Policy{
+				Subject:  policy.Subject,
+				Domain:   domain.CasbinDomain(policy.Domain),
+				Resource: policy.Resource,
+				Action:   policy.Action,
+				Effect:   domain.PolicyEffect(policy.Effect),
+			}
+		}
+		return result
+	}
+	links := func(list []*pb.RoleLink) []domain.RoleLink {
+		result := make([]domain.RoleLink, len(list))
+		for i, link := range list {
+			result[i] = domain.RoleLink{
+				Member: link.Member,
+				Role:   link.Role,
+				Domain: domain.CasbinDomain(link.Domain),
+			}
+		}
+		return result
+	}
+
+	return &domain.PolicyChangeSet{
+		AddPolicies:     policies(req.AddPolicies),
+		RemovePolicies:  policies(req.RemovePolicies),
+		AddRoleLinks:    links(req.AddRoleLinks),
+		RemoveRoleLinks: links(req.RemoveRoleLinks),
+	}
+}
+
+// domainAccessChangesToPB converts a list of domain.AccessChange to pb.AccessChange
+func domainAccessChangesToPB(changes []domain.AccessChange) []*pb.AccessChange {
+	pbChanges := make([]*pb.AccessChange, len(changes))
+	for i, change := range changes {
+		pbChanges[i] = &pb.AccessChange{
+			UserId:   change.UserID,
+			Domain:   string(change.Domain),
+			Resource: change.Resource,
+			Action:   change.Action,
+		}
+	}
+	return pbChanges
+}
+
+// domainPolicyConditionToPB converts domain.PolicyCondition to pb.PolicyCondition
+func domainPolicyConditionToPB(condition *domain.PolicyCondition) *pb.PolicyCondition {
+	if condition == nil {
+		return nil
+	}
+
+	return &pb.PolicyCondition{
+		Subject:    condition.Subject,
+		Domain:     string(condition.Domain),
+		Resource:   condition.Resource,
+		Action:     condition.Action,
+		Effect:     string(condition.Effect),
+		Expression: condition.Expression,
+		CreatedAt:  condition.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  condition.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// domainBatchResultsToPB converts batch authorization results to pb.BatchCheckResult, keeping their order
+func domainBatchResultsToPB(results []*domain.BatchAuthorizationResult) []*pb.BatchCheckResult {
+	pbResults := make([]*pb.BatchCheckResult, len(results))
+	for i, result := range results {
+		pbResults[i] = &pb.BatchCheckResult{
+			Allowed: result.Allowed,
+			Reason:  result.Reason,
+			Error:   result.Error,
+		}
+	}
+	return pbResults
+}
+
+// domainExplanationToPB converts domain.AuthorizationExplanation to pb.AuthorizationExplanation
+func domainExplanationToPB(explanation *domain.AuthorizationExplanation) *pb.AuthorizationExplanation {
+	if explanation == nil {
+		return nil
+	}
+
+	candidates := make([]*pb.PolicyCandidate, len(explanation.Candidates))
+	for i, candidate := range explanation.Candidates {
+		candidates[i] = &pb.PolicyCandidate{
+			Policy:       candidate.Policy,
+			RoleChain:    candidate.RoleChain,
+			FailedFields: candidate.FailedFields,
+		}
+	}
+
+	return &pb.AuthorizationExplanation{
+		Allowed:       explanation.Allowed,
+		MatchedPolicy: explanation.MatchedPolicy,
+		RoleChain:     explanation.RoleChain,
+		Candidates:    candidates,
+	}
+}
+
+// domainAccessRequestToPB converts domain.AccessRequest to pb.AccessRequest
+func domainAccessRequestToPB(request *domain.AccessRequest) *pb.AccessRequest {
+	if request == nil {
+		return nil
+	}
+
+	pbRequest := &pb.AccessRequest{
+		Id:              request.ID,
+		RequesterId:     request.RequesterID,
+		RoleKind:        string(request.RoleKind),
+		RoleId:          request.RoleID,
+		RoleName:        request.RoleName,
+		Justification:   request.Justification,
+		DurationSeconds: int64(request.Duration / time.Second),
+		Status:          string(request.Status),
+		DecidedBy:       request.DecidedBy,
+		DecisionComment: request.DecisionComment,
+		ExpiresAt:       request.ExpiresAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:       request.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       request.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if request.DecidedAt != nil {
+		pbRequest.DecidedAt = request.DecidedAt.Format("2006-01-02T15:04:05Z")
+	}
+	return pbRequest
+}
+
+// domainAccessRequestDecisionsToPB converts access request decisions to their protobuf form
+func domainAccessRequestDecisionsToPB(decisions []*domain.AccessRequestDecision) []*pb.AccessRequestDecision {
+	pbDecisions := make([]*pb.AccessRequestDecision, len(decisions))
+	for i, decision := range decisions {
+		pbDecisions[i] = &pb.AccessRequestDecision{
+			Id:        decision.ID,
+			ActorId:   decision.ActorID,
+			Status:    string(decision.Status),
+			Comment:   decision.Comment,
+			CreatedAt: decision.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+	return pbDecisions
+}
+
+// pbSoDRolesToDomain converts protobuf SoD roles to their domain form
+func pbSoDRolesToDomain(roles []*pb.SoDRole) []domain.SoDRole {
+	domainRoles := make([]domain.SoDRole, 0, len(roles))
+	for _, role := range roles {
+		domainRoles = append(domainRoles, domain.SoDRole{
+			Kind:   domain.RoleKind(role.RoleKind),
+			RoleID: role.RoleId,
+		})
+	}
+	return domainRoles
+}
+
+// domainSoDRolesToPB converts SoD roles to their protobuf form
+func domainSoDRolesToPB(roles []domain.SoDRole) []*pb.SoDRole {
+	pbRoles := make([]*pb.SoDRole, len(roles))
+	for i, role := range roles {
+		pbRoles[i] = &pb.SoDRole{
+			RoleKind: string(role.Kind),
+			RoleId:   role.RoleID,
+			RoleName: role.RoleName,
+		}
+	}
+	return pbRoles
+}
+
+// domainSoDConstraintToPB converts domain.SoDConstraint to pb.SoDConstraint
+func domainSoDConstraintToPB(constraint *domain.SoDConstraint) *pb.SoDConstraint {
+	if constraint == nil {
+		return nil
+	}
+
+	return &pb.SoDConstraint{
+		Id:          constraint.ID,
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		Mode:        string(constraint.Mode),
+		Roles:       domainSoDRolesToPB(constraint.Roles),
+		CreatedAt:   constraint.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   constraint.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// domainSoDViolationsToPB converts SoD violations to their protobuf form
+func domainSoDViolationsToPB(violations []*domain.SoDViolation) []*pb.SoDViolation {
+	pbViolations := make([]*pb.SoDViolation, len(violations))
+	for i, violation := range violations {
+		pbViolations[i] = &pb.SoDViolation{
+			ConstraintId:   violation.ConstraintID,
+			ConstraintName: violation.ConstraintName,
+			Mode:           string(violation.Mode),
+			Subject:        violation.Subject,
+			Roles:          domainSoDRolesToPB(violation.Roles),
+		}
+		if violation.Scope != nil {
+			pbViolations[i].Scope = domainResourceScopeToPB(*violation.Scope)
+		}
+	}
+	return pbViolations
+}
+
+// pbAccessReviewScopeToDomain converts pb.AccessReviewScope to domain.AccessReviewScope
+func pbAccessReviewScopeToDomain(scope *pb.AccessReviewScope) domain.AccessReviewScope {
+	if scope == nil {
+		return domain.AccessReviewScope{}
+	}
+
+	kinds := make([]domain.RoleKind, len(scope.RoleKinds))
+	for i, kind := range scope.RoleKinds {
+		kinds[i] = domain.RoleKind(kind)
+	}
+	return domain.AccessReviewScope{
+		RoleKinds: kinds,
+		RoleIDs:   scope.RoleIds,
+		UserIDs:   scope.UserIds,
+	}
+}
+
+// pbAccessReviewersToDomain converts access reviewers from their protobuf form
+func pbAccessReviewersToDomain(reviewers []*pb.AccessReviewer) []domain.AccessReviewer {
+	domainReviewers := make([]domain.AccessReviewer, len(reviewers))
+	for i, reviewer := range reviewers {
+		domainReviewers[i] = domain.AccessReviewer{
+			ReviewerID: reviewer.ReviewerId,
+			UserIDs:    reviewer.UserIds,
+		}
+	}
+	return domainReviewers
+}
+
+// domainAccessReviewCampaignToPB converts domain.AccessReviewCampaign to pb.AccessReviewCampaign
+func domainAccessReviewCampaignToPB(campaign *domain.AccessReviewCampaign, summary *domain.AccessReviewSummary) *pb.AccessReviewCampaign {
+	if campaign == nil {
+		return nil
+	}
+
+	kinds := make([]string, len(campaign.Scope.RoleKinds))
+	for i, kind := range campaign.Scope.RoleKinds {
+		kinds[i] = string(kind)
+	}
+	pbCampaign := &pb.AccessReviewCampaign{
+		Id:          campaign.ID,
+		Name:        campaign.Name,
+		Description: campaign.Description,
+		Scope: &pb.AccessReviewScope{
+			RoleKinds: kinds,
+			RoleIds:   campaign.Scope.RoleIDs,
+			UserIds:   campaign.Scope.UserIDs,
+		},
+		DefaultReviewerId: campaign.DefaultReviewerID,
+		Status:            string(campaign.Status),
+		CreatedBy:         campaign.CreatedBy,
+		ClosedBy:          campaign.ClosedBy,
+		CreatedAt:         campaign.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:         campaign.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if campaign.DueAt != nil {
+		pbCampaign.DueAt = campaign.DueAt.Format("2006-01-02T15:04:05Z")
+	}
+	if campaign.ClosedAt != nil {
+		pbCampaign.ClosedAt = campaign.ClosedAt.Format("2006-01-02T15:04:05Z")
+	}
+	if summary != nil {
+		pbCampaign.Summary = &pb.AccessReviewSummary{
+			Total:   int32(summary.Total),
+			Pending: int32(summary.Pending),
+			Kept:    int32(summary.Kept),
+			Revoked: int32(summary.Revoked),
+			Failed:  int32(summary.Failed),
+		}
+	}
+	return pbCampaign
+}
+
+// domainAccessReviewItemToPB converts domain.AccessReviewItem to pb.AccessReviewItem
+func domainAccessReviewItemToPB(item *domain.AccessReviewItem) *pb.AccessReviewItem {
+	if item == nil {
+		return nil
+	}
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("2006-01-02T15:04:05Z")
+	}
+	return &pb.AccessReviewItem{
+		Id:          item.ID,
+		CampaignId:  item.CampaignID,
+		UserId:      item.UserID,
+		RoleKind:    string(item.RoleKind),
+		RoleId:      item.RoleID,
+		RoleName:    item.RoleName,
+		StartsAt:    formatTime(item.StartsAt),
+		ExpiresAt:   formatTime(item.ExpiresAt),
+		ReviewerId:  item.ReviewerID,
+		Decision:    string(item.Decision),
+		Comment:     item.Comment,
+		DecidedAt:   formatTime(item.DecidedAt),
+		RevokedAt:   formatTime(item.RevokedAt),
+		RevokeError: item.RevokeError,
+		Scope:       domainResourceScopeToPB(item.Scope),
+	}
+}
+
+// pbRelationTuplesToDomain parses relation tuples from their protobuf form
+func pbRelationTuplesToDomain(tuples []*pb.RelationTuple) ([]*domain.RelationTuple, error) {
+	domainTuples := make([]*domain.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		subject, err := domain.ParseRelationSubject(tuple.Subject)
+		if err != nil {
+			return nil, err
+		}
+		domainTuples[i] = &domain.RelationTuple{
+			Namespace: tuple.Namespace,
+			ObjectID:  tuple.ObjectId,
+			Relation:  tuple.Relation,
+			Subject:   subject,
+		}
+	}
+	return domainTuples, nil
+}
+
+// domainRelationTuplesToPB converts relation tuples to their protobuf form
+func domainRelationTuplesToPB(tuples []*domain.RelationTuple) []*pb.RelationTuple {
+	pbTuples := make([]*pb.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		pbTuples[i] = &pb.RelationTuple{
+			Namespace: tuple.Namespace,
+			ObjectId:  tuple.ObjectID,
+			Relation:  tuple.Relation,
+			Subject:   tuple.Subject.String(),
+		}
+	}
+	return pbTuples
+}
+
+// pbRelationDefinitionsToDomain converts relation definitions from their protobuf form
+func pbRelationDefinitionsToDomain(relations []*pb.RelationDefinition) []domain.RelationDefinition {
+	definitions := make([]domain.RelationDefinition, len(relations))
+	for i, relation := range relations {
+		definitions[i] = domain.RelationDefinition{
+			Name:             relation.Name,
+			ComputedUsersets: relation.ComputedUsersets,
+		}
+		for _, ttu := range relation.TupleToUsersets {
+			definitions[i].TupleToUsersets = append(definitions[i].TupleToUsersets, domain.TupleToUserset{
+				Tupleset:        ttu.Tupleset,
+				ComputedUserset: ttu.ComputedUserset,
+			})
+		}
+	}
+	return definitions
+}
+
+// domainRelationNamespaceToPB converts domain.RelationNamespace to pb.RelationNamespace
+func domainRelationNamespaceToPB(namespace *domain.RelationNamespace) *pb.RelationNamespace {
+	if namespace == nil {
+		return nil
+	}
+
+	pbNamespace := &pb.RelationNamespace{
+		Name:      namespace.Name,
+		Relations: make([]*pb.RelationDefinition, len(namespace.Relations)),
+		CreatedAt: namespace.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: namespace.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	for i, relation := range namespace.Relations {
+		pbRelation := &pb.RelationDefinition{
+			Name:             relation.Name,
+			ComputedUsersets: relation.ComputedUsersets,
+		}
+		for _, ttu := range relation.TupleToUsersets {
+			pbRelation.TupleToUsersets = append(pbRelation.TupleToUsersets, &pb.TupleToUserset{
+				Tupleset:        ttu.Tupleset,
+				ComputedUserset: ttu.ComputedUserset,
+			})
+		}
+		pbNamespace.Relations[i] = pbRelation
+	}
+	return pbNamespace
+}
+
+// domainRelationTreeToPB converts domain.RelationTree to pb.RelationTree
+func domainRelationTreeToPB(tree *domain.RelationTree) *pb.RelationTree {
+	if tree == nil {
+		return nil
+	}
+
+	pbTree := &pb.RelationTree{
+		Userset:  tree.Userset,
+		Subjects: make([]string, len(tree.Subjects)),
+		Children: make([]*pb.RelationTree, len(tree.Children)),
+	}
+	for i, subject := range tree.Subjects {
+		pbTree.Subjects[i] = subject.String()
+	}
+	for i, child := range tree.Children {
+		pbTree.Children[i] = domainRelationTreeToPB(child)
+	}
+	return pbTree
+}
+
+// pbRelationCheckToDomain converts pb.RelationCheck to domain.RelationCheck
+func pbRelationCheckToDomain(check *pb.RelationCheck) *domain.RelationCheck {
+	if check == nil {
+		return nil
+	}
+
+	return &domain.RelationCheck{
+		Namespace: check.Namespace,
+		ObjectID:  check.ObjectId,
+		Relation:  check.Relation,
+	}
+}
+
+// domainRelationDecisionToPB converts domain.RelationDecision to pb.RelationDecision
+func domainRelationDecisionToPB(decision *domain.RelationDecision) *pb.RelationDecision {
+	if decision == nil {
+		return nil
+	}
+
+	return &pb.RelationDecision{
+		Check: &pb.RelationCheck{
+			Namespace: decision.Check.Namespace,
+			ObjectId:  decision.Check.ObjectID,
+			Relation:  decision.Check.Relation,
+		},
+		Mode:            string(decision.Mode),
+		PolicyAllowed:   decision.PolicyAllowed,
+		RelationAllowed: decision.RelationAllowed,
+	}
+}
+
+// pbResourceScopeToDomain converts pb.ResourceScope to domain.ResourceScope; nil is the global scope
+func pbResourceScopeToDomain(scope *pb.ResourceScope) domain.ResourceScope {
+	if scope == nil {
+		return domain.ResourceScope{}
+	}
+	return domain.ResourceScope{Type: scope.Type, ID: scope.Id}
+}
+
+// pbRequestScopeToDomain converts the scope of an authorization request; nil for a global request
+func pbRequestScopeToDomain(scope *pb.ResourceScope) *domain.ResourceScope {
+	if scope == nil {
+		return nil
+	}
+	domainScope := pbResourceScopeToDomain(scope)
+	return &domainScope
+}
+
+// domainResourceScopeToPB converts domain.ResourceScope to pb.ResourceScope; the global scope is nil
+func domainResourceScopeToPB(scope domain.ResourceScope) *pb.ResourceScope {
+	if scope.IsZero() {
+		return nil
+	}
+	return &pb.ResourceScope{Type: scope.Type, Id: scope.ID}
+}
+
+// domainOptionalResourceScopeToPB converts an optional domain.ResourceScope; nil stays nil
+func domainOptionalResourceScopeToPB(scope *domain.ResourceScope) *pb.ResourceScope {
+	if scope == nil {
+		return nil
+	}
+	return domainResourceScopeToPB(*scope)
+}
+
+// domainScopedFieldPermissionsToPB converts the per-scope fields of a domain.FieldPermissionSet
+func domainScopedFieldPermissionsToPB(scoped []*domain.ScopedFieldPermissionSet) []*pb.ScopedFieldPermissions {
+	pbScoped := make([]*pb.ScopedFieldPermissions, len(scoped))
+	for i, fields := range scoped {
+		pbScoped[i] = &pb.ScopedFieldPermissions{
+			Scope:          domainResourceScopeToPB(fields.Scope),
+			ReadableFields: fields.Readable,
+			WritableFields: fields.Writable,
+		}
+	}
+	return pbScoped
+}
+
+// domainResourceScopeDefinitionToPB converts domain.ResourceScopeDefinition to pb.ResourceScopeDefinition
+func domainResourceScopeDefinitionToPB(scope *domain.ResourceScopeDefinition) *pb.ResourceScopeDefinition {
+	if scope == nil {
+		return nil
+	}
+
+	pbScope := &pb.ResourceScopeDefinition{
+		Type:      scope.Type,
+		Id:        scope.ID,
+		Name:      scope.Name,
+		CreatedAt: scope.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if scope.Parent != nil {
+		pbScope.Parent = domainResourceScopeToPB(*scope.Parent)
+	}
+	return pbScope
+}
+
+// domainScopedCMSRoleAssignmentToPB converts domain.ScopedCMSRoleAssignment to pb.ScopedCMSRoleAssignment
+func domainScopedCMSRoleAssignmentToPB(assignment *domain.ScopedCMSRoleAssignment) *pb.ScopedCMSRoleAssignment {
+	if assignment == nil {
+		return nil
+	}
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("2006-01-02T15:04:05Z")
+	}
+	return &pb.ScopedCMSRoleAssignment{
+		UserId:      assignment.UserID,
+		CmsRoleId:   assignment.CMSRoleID,
+		CmsRoleName: assignment.CMSRoleName,
+		Scope:       domainResourceScopeToPB(assignment.Scope),
+		StartsAt:    formatTime(assignment.StartsAt),
+		ExpiresAt:   formatTime(assignment.ExpiresAt),
+		CreatedAt:   assignment.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -14,12 +18,16 @@ import (
 
 // GinHandler handles HTTP requests using Gin framework
 type GinHandler struct {
-	authService   service.AuthService
-	authzService  service.AuthorizationService
-	roleService   service.RoleService
-	permService   service.PermissionService
-	casbinService service.CasbinService
-	logger        *zap.Logger
+	authService     service.AuthService
+	authzService    service.AuthorizationService
+	roleService     service.RoleService
+	permService     service.PermissionService
+	casbinService   service.CasbinService
+	accessService   service.AccessRequestService
+	sodService      service.SoDService
+	reviewService   service.AccessReviewService
+	relationService service.RelationService
+	logger          *zap.Logger
 }
 
 // NewGinHandler creates a new Gin HTTP handler
@@ -29,23 +37,32 @@ func NewGinHandler(
 	roleService service.RoleService,
 	permService service.PermissionService,
 	casbinService service.CasbinService,
+	accessService service.AccessRequestService,
+	sodService service.SoDService,
+	reviewService service.AccessReviewService,
+	relationService service.RelationService,
 	logger *zap.Logger,
 ) *GinHandler {
 	return &GinHandler{
-		authService:   authService,
-		authzService:  authzService,
-		roleService:   roleService,
-		permService:   permService,
-		casbinService: casbinService,
-		logger:        logger,
+		authService:     authService,
+		authzService:    authzService,
+		roleService:     roleService,
+		permService:     permService,
+		casbinService:   casbinService,
+		accessService:   accessService,
+		sodService:      sodService,
+		reviewService:   reviewService,
+		relationService: relationService,
+		logger:          logger,
 	}
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
-	Code    int    `json:"code"`
+	Error   string      `json:"error"`
+	Message string      `json:"message,omitempty"`
+	Code    int         `json:"code"`
+	Details interface{} `json:"details,omitempty"`
 }
 
 // SuccessResponse represents a success response
@@ -64,6 +81,98 @@ func (h *GinHandler) sendError(c *gin.Context, code int, err error, message stri
 	})
 }
 
+// callerID returns the ID of the user authenticated by the request's bearer token. It sends an
+// unauthorized response and reports false when the token is missing or invalid.
+func (h *GinHandler) callerID(c *gin.Context) (string, bool) {
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		h.sendError(c, http.StatusUnauthorized, errors.New("missing bearer token"), "Unauthorized")
+		return "", false
+	}
+	userID, _, err := h.authService.VerifyToken(c.Request.Context(), token)
+	if err != nil {
+		h.sendError(c, http.StatusUnauthorized, err, "Invalid token")
+		return "", false
+	}
+	return userID, true
+}
+
+// sessionInactiveRoles verifies the access token of a check made on behalf of a user's session
+// and returns the user's roles the session leaves inactive. It sends an error response and
+// reports false when the token is invalid or belongs to another user.
+func (h *GinHandler) sessionInactiveRoles(c *gin.Context, userID, token string) ([]string, bool) {
+	sessionUserID, inactive, err := h.authService.VerifySession(c.Request.Context(), token)
+	if err != nil {
+		h.sendError(c, http.StatusUnauthorized, err, "Invalid access token")
+		return nil, false
+	}
+	if sessionUserID != userID {
+		h.sendError(c, http.StatusBadRequest, errors.New("access token belongs to another user"), "Invalid request")
+		return nil, false
+	}
+	return inactive, true
+}
+
+// sendDeleteRoleError sends a role deletion error. A role still in use is a conflict whose
+// details carry the impact report.
+func (h *GinHandler) sendDeleteRoleError(c *gin.Context, err error, message string) {
+	var inUse *domain.RoleInUseError
+	if !errors.As(err, &inUse) {
+		h.sendError(c, http.StatusInternalServerError, err, message)
+		return
+	}
+
+	h.logger.Warn(message, zap.Error(err))
+	c.JSON(http.StatusConflict, ErrorResponse{
+		Error:   err.Error(),
+		Message: message,
+		Code:    http.StatusConflict,
+		Details: inUse.Impact,
+	})
+}
+
+// sendAccessRequestError maps access request errors to HTTP status codes
+func (h *GinHandler) sendAccessRequestError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrAccessRequestNotPending):
+		h.sendError(c, http.StatusConflict, err, message)
+	case errors.Is(err, domain.ErrNotAccessRequestApprover), errors.Is(err, domain.ErrNotAccessRequestAdmin):
+		h.sendError(c, http.StatusForbidden, err, message)
+	default:
+		h.sendRoleLinkError(c, err, message)
+	}
+}
+
+// sendAccessReviewError maps access review errors to HTTP status codes
+func (h *GinHandler) sendAccessReviewError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrAccessReviewClosed):
+		h.sendError(c, http.StatusConflict, err, message)
+	case errors.Is(err, domain.ErrNotAccessReviewer), errors.Is(err, domain.ErrNotAccessReviewManager):
+		h.sendError(c, http.StatusForbidden, err, message)
+	default:
+		h.sendError(c, http.StatusInternalServerError, err, message)
+	}
+}
+
+// sendRoleLinkError sends a conflict listing the violations when a role assignment or inheritance
+// breaks separation-of-duties constraints, and an internal error otherwise
+func (h *GinHandler) sendRoleLinkError(c *gin.Context, err error, message string) {
+	var violation *domain.SoDViolationError
+	if !errors.As(err, &violation) {
+		h.sendError(c, http.StatusInternalServerError, err, message)
+		return
+	}
+
+	h.logger.Warn(message, zap.Error(err))
+	c.JSON(http.StatusConflict, ErrorResponse{
+		Error:   err.Error(),
+		Message: message,
+		Code:    http.StatusConflict,
+		Details: violation.Violations,
+	})
+}
+
 // sendSuccess sends a success response
 func (h *GinHandler) sendSuccess(c *gin.Context, code int, data interface{}, message string) {
 	response := SuccessResponse{
@@ -105,9 +214,17 @@ func (h *GinHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, tokenPair, err := h.authService.Login(c.Request.Context(), req.Username, req.Password)
+	user, tokenPair, err := h.authService.Login(c.Request.Context(), req.Username, req.Password, req.ActiveRoles)
 	if err != nil {
-		h.sendError(c, http.StatusUnauthorized, err, "Invalid credentials")
+		var violation *domain.SoDViolationError
+		switch {
+		case errors.As(err, &violation):
+			h.sendRoleLinkError(c, err, "Failed to activate roles")
+		case errors.Is(err, domain.ErrSessionRoleNotAssigned):
+			h.sendError(c, http.StatusForbidden, err, "Failed to activate roles")
+		default:
+			h.sendError(c, http.StatusUnauthorized, err, "Invalid credentials")
+		}
 		return
 	}
 
@@ -303,29 +420,38 @@ func (h *GinHandler) DeleteRole(c *gin.Context) {
 		return
 	}
 
-	err := h.roleService.DeleteRole(c.Request.Context(), roleID)
+	cascade, _ := strconv.ParseBool(c.Query("cascade"))
+	impact, err := h.roleService.DeleteRole(c.Request.Context(), roleID, cascade)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete role")
+		h.sendDeleteRoleError(c, err, "Failed to delete role")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusOK, nil, "Role deleted successfully")
+	h.sendSuccess(c, http.StatusOK, impact, "Role deleted successfully")
 }
 
 // AssignRole handles assigning role to user
 func (h *GinHandler) AssignRole(c *gin.Context) {
 	var req struct {
-		UserID string `json:"user_id" binding:"required"`
-		RoleID string `json:"role_id" binding:"required"`
+		UserID    string     `json:"user_id" binding:"required"`
+		RoleID    string     `json:"role_id" binding:"required"`
+		StartsAt  *time.Time `json:"starts_at"`
+		ExpiresAt *time.Time `json:"expires_at"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
 
-	err := h.authzService.AssignRole(c.Request.Context(), req.UserID, req.RoleID)
+	window := domain.AssignmentWindow{StartsAt: req.StartsAt, ExpiresAt: req.ExpiresAt}
+	if err := window.Validate(time.Now()); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid assignment window")
+		return
+	}
+
+	err := h.authzService.AssignRole(c.Request.Context(), req.UserID, req.RoleID, window)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to assign role")
+		h.sendRoleLinkError(c, err, "Failed to assign role")
 		return
 	}
 
@@ -369,6 +495,84 @@ func (h *GinHandler) GetUserRoles(c *gin.Context) {
 	h.sendSuccess(c, http.StatusOK, gin.H{"roles": roles}, "")
 }
 
+// GetEffectivePermissions handles listing what a user holds across roles, domains and CMS roles.
+// The optional domain query parameter limits the list to one domain.
+func (h *GinHandler) GetEffectivePermissions(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "User ID is required")
+		return
+	}
+
+	permissions, err := h.casbinService.GetEffectivePermissions(c.Request.Context(), userID, domain.CasbinDomain(c.Query("domain")))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to get effective permissions")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{"permissions": permissions}, "")
+}
+
+// AddRoleParent handles making a role inherit another role
+func (h *GinHandler) AddRoleParent(c *gin.Context) {
+	var req struct {
+		Role   string `json:"role" binding:"required"`
+		Parent string `json:"parent" binding:"required"`
+		Domain string `json:"domain" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	err := h.casbinService.AddRoleParent(c.Request.Context(), req.Role, req.Parent, domain.CasbinDomain(req.Domain))
+	if err != nil {
+		h.sendRoleLinkError(c, err, "Failed to add role parent")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Role parent added successfully")
+}
+
+// RemoveRoleParent handles removing a role's parent
+func (h *GinHandler) RemoveRoleParent(c *gin.Context) {
+	var req struct {
+		Role   string `json:"role" binding:"required"`
+		Parent string `json:"parent" binding:"required"`
+		Domain string `json:"domain" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	err := h.casbinService.RemoveRoleParent(c.Request.Context(), req.Role, req.Parent, domain.CasbinDomain(req.Domain))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to remove role parent")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Role parent removed successfully")
+}
+
+// GetRoleHierarchy handles getting a role's ancestors, descendants and effective policies
+func (h *GinHandler) GetRoleHierarchy(c *gin.Context) {
+	role := c.Query("role")
+	dom := c.Query("domain")
+	if role == "" || dom == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "Role and domain are required")
+		return
+	}
+
+	hierarchy, err := h.casbinService.GetRoleHierarchy(c.Request.Context(), role, domain.CasbinDomain(dom))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to get role hierarchy")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, hierarchy, "")
+}
+
 // Permission Management Handlers
 
 // CreatePermission handles permission creation
@@ -443,16 +647,27 @@ func (h *GinHandler) DeletePermission(c *gin.Context) {
 // CheckPermission handles permission check
 func (h *GinHandler) CheckPermission(c *gin.Context) {
 	var req struct {
-		UserID   string `json:"user_id" binding:"required"`
-		Resource string `json:"resource" binding:"required"`
-		Action   string `json:"action" binding:"required"`
+		UserID      string `json:"user_id" binding:"required"`
+		Resource    string `json:"resource" binding:"required"`
+		Action      string `json:"action" binding:"required"`
+		AccessToken string `json:"access_token"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
 
-	allowed, err := h.authzService.CheckPermission(c.Request.Context(), req.UserID, req.Resource, req.Action)
+	var allowed bool
+	var err error
+	if req.AccessToken != "" {
+		inactive, ok := h.sessionInactiveRoles(c, req.UserID, req.AccessToken)
+		if !ok {
+			return
+		}
+		allowed, err = h.authzService.CheckPermissionInSession(c.Request.Context(), req.UserID, inactive, req.Resource, req.Action)
+	} else {
+		allowed, err = h.authzService.CheckPermission(c.Request.Context(), req.UserID, req.Resource, req.Action)
+	}
 	if err != nil {
 		h.sendError(c, http.StatusInternalServerError, err, "Failed to check permission")
 		return
@@ -469,16 +684,47 @@ func (h *GinHandler) CheckPermission(c *gin.Context) {
 // CheckAPIAccess handles API access check
 func (h *GinHandler) CheckAPIAccess(c *gin.Context) {
 	var req struct {
-		UserID  string `json:"user_id" binding:"required"`
-		APIPath string `json:"api_path" binding:"required"`
-		Method  string `json:"method" binding:"required"`
+		UserID      string `json:"user_id" binding:"required"`
+		APIPath     string `json:"api_path" binding:"required"`
+		Method      string `json:"method" binding:"required"`
+		Explain     bool   `json:"explain"`
+		AccessToken string `json:"access_token"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
+	if req.Explain && req.AccessToken != "" {
+		h.sendError(c, http.StatusBadRequest, errors.New("explain cannot be combined with access_token"), "Invalid request body")
+		return
+	}
 
-	allowed, err := h.casbinService.CheckAPIAccess(c.Request.Context(), req.UserID, req.APIPath, req.Method)
+	if req.Explain {
+		explanation, err := h.casbinService.ExplainAPIAccess(c.Request.Context(), req.UserID, req.APIPath, req.Method)
+		if err != nil {
+			h.sendError(c, http.StatusInternalServerError, err, "Failed to check API access")
+			return
+		}
+
+		h.sendSuccess(c, http.StatusOK, gin.H{
+			"allowed":     explanation.Allowed,
+			"explanation": explanation,
+			"message":     "API access check completed",
+		}, "")
+		return
+	}
+
+	var allowed bool
+	var err error
+	if req.AccessToken != "" {
+		inactive, ok := h.sessionInactiveRoles(c, req.UserID, req.AccessToken)
+		if !ok {
+			return
+		}
+		allowed, err = h.casbinService.CheckAPIAccessInSession(c.Request.Context(), req.UserID, inactive, req.APIPath, req.Method)
+	} else {
+		allowed, err = h.casbinService.CheckAPIAccess(c.Request.Context(), req.UserID, req.APIPath, req.Method)
+	}
 	if err != nil {
 		h.sendError(c, http.StatusInternalServerError, err, "Failed to check API access")
 		return
@@ -493,14 +739,20 @@ func (h *GinHandler) CheckAPIAccess(c *gin.Context) {
 // CheckCMSAccess handles CMS access check
 func (h *GinHandler) CheckCMSAccess(c *gin.Context) {
 	var req struct {
-		UserID string `json:"user_id" binding:"required"`
-		CMSTab string `json:"cms_tab" binding:"required"`
-		Action string `json:"action" binding:"required"`
+		UserID  string               `json:"user_id" binding:"required"`
+		CMSTab  string               `json:"cms_tab" binding:"required"`
+		Action  string               `json:"action" binding:"required"`
+		Explain bool                 `json:"explain"`
+		Scope   domain.ResourceScope `json:"scope"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
+	if req.Explain && !req.Scope.IsZero() {
+		h.sendError(c, http.StatusBadRequest, nil, "Explain is not supported for checks with a resource scope")
+		return
+	}
 
 	// Get user's CMS tabs first
 	tabs, err := h.casbinService.GetUserCMSTabs(c.Request.Context(), req.UserID)
@@ -515,8 +767,24 @@ func (h *GinHandler) CheckCMSAccess(c *gin.Context) {
 		tabStrings[i] = string(tab)
 	}
 
+	if req.Explain {
+		explanation, err := h.casbinService.ExplainCMSAccess(c.Request.Context(), req.UserID, domain.CMSTab(req.CMSTab), req.Action)
+		if err != nil {
+			h.sendError(c, http.StatusInternalServerError, err, "Failed to check CMS access")
+			return
+		}
+
+		h.sendSuccess(c, http.StatusOK, gin.H{
+			"allowed":         explanation.Allowed,
+			"accessible_tabs": tabStrings,
+			"explanation":     explanation,
+			"message":         "CMS access check completed",
+		}, "")
+		return
+	}
+
 	// Check if user has access to requested tab
-	allowed, err := h.casbinService.CheckCMSAccess(c.Request.Context(), req.UserID, domain.CMSTab(req.CMSTab), req.Action)
+	allowed, err := h.casbinService.CheckCMSAccess(c.Request.Context(), req.UserID, domain.CMSTab(req.CMSTab), req.Action, req.Scope)
 	if err != nil {
 		h.sendError(c, http.StatusInternalServerError, err, "Failed to check CMS access")
 		return
@@ -532,10 +800,15 @@ func (h *GinHandler) CheckCMSAccess(c *gin.Context) {
 // EnforcePolicy handles policy enforcement
 func (h *GinHandler) EnforcePolicy(c *gin.Context) {
 	var req struct {
-		UserID   string `json:"user_id" binding:"required"`
-		Domain   string `json:"domain" binding:"required"`
-		Resource string `json:"resource" binding:"required"`
-		Action   string `json:"action" binding:"required"`
+		UserID       string                `json:"user_id" binding:"required"`
+		Domain       string                `json:"domain" binding:"required"`
+		Resource     string                `json:"resource" binding:"required"`
+		Action       string                `json:"action" binding:"required"`
+		Explain      bool                  `json:"explain"`
+		Attributes   map[string]string     `json:"attributes"`
+		Relation     *domain.RelationCheck `json:"relation"`
+		RelationMode string                `json:"relation_mode"`
+		Scope        *domain.ResourceScope `json:"scope"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
@@ -543,10 +816,15 @@ func (h *GinHandler) EnforcePolicy(c *gin.Context) {
 	}
 
 	authReq := &domain.AuthorizationRequest{
-		UserID:   req.UserID,
-		Domain:   domain.CasbinDomain(req.Domain),
-		Resource: req.Resource,
-		Action:   req.Action,
+		UserID:       req.UserID,
+		Domain:       domain.CasbinDomain(req.Domain),
+		Resource:     req.Resource,
+		Action:       req.Action,
+		Explain:      req.Explain,
+		Attributes:   req.Attributes,
+		Relation:     req.Relation,
+		RelationMode: domain.RelationMode(req.RelationMode),
+		Scope:        req.Scope,
 	}
 
 	response, err := h.casbinService.Enforce(c.Request.Context(), authReq)
@@ -555,158 +833,1411 @@ func (h *GinHandler) EnforcePolicy(c *gin.Context) {
 		return
 	}
 
-	h.sendSuccess(c, http.StatusOK, gin.H{
-		"allowed": response.Allowed,
-		"reason":  response.Reason,
-	}, "")
+	h.sendSuccess(c, http.StatusOK, response, "")
 }
 
-// CMS Handlers
-
-// CreateCMSRole handles CMS role creation
-func (h *GinHandler) CreateCMSRole(c *gin.Context) {
+// BatchCheckAPIAccess handles API access checks for several (path, method) pairs
+func (h *GinHandler) BatchCheckAPIAccess(c *gin.Context) {
 	var req struct {
-		Name        string   `json:"name" binding:"required"`
-		Description string   `json:"description"`
-		Tabs        []string `json:"tabs" binding:"required"`
+		UserID string `json:"user_id" binding:"required"`
+		Checks []struct {
+			APIPath string `json:"api_path"`
+			Method  string `json:"method"`
+		} `json:"checks" binding:"required,min=1,max=100"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
 
-	// Convert string tabs to domain.CMSTab
-	tabs := make([]domain.CMSTab, len(req.Tabs))
-	for i, tab := range req.Tabs {
-		tabs[i] = domain.CMSTab(tab)
+	checks := make([]domain.APIAccessCheck, len(req.Checks))
+	for i, check := range req.Checks {
+		checks[i] = domain.APIAccessCheck{APIPath: check.APIPath, Method: check.Method}
 	}
 
-	role, err := h.casbinService.CreateCMSRole(c.Request.Context(), req.Name, req.Description, tabs)
+	results, err := h.casbinService.BatchCheckAPIAccess(c.Request.Context(), req.UserID, checks)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to create CMS role")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to check API access")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusCreated, gin.H{
-		"cms_role_id": role.ID,
-		"name":        role.Name,
-	}, "CMS role created successfully")
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"results": results,
+	}, "")
 }
 
-// ListCMSRoles handles listing CMS roles
-func (h *GinHandler) ListCMSRoles(c *gin.Context) {
-	// Note: Full implementation pending - returning empty list for now
+// ListAPIAccessHolders handles listing the users and roles allowed to call an API.
+// The API is given as ?api_resource_id= or as ?api_path=&method=.
+func (h *GinHandler) ListAPIAccessHolders(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	holders, total, err := h.casbinService.ListAPIAccessHolders(c.Request.Context(),
+		c.Query("api_resource_id"), c.Query("api_path"), c.Query("method"), page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list API access holders")
+		return
+	}
+
 	h.sendSuccess(c, http.StatusOK, gin.H{
-		"roles": []interface{}{},
-		"total": 0,
+		"holders": holders,
+		"total":   total,
 	}, "")
 }
 
-// AssignCMSRole handles assigning CMS role to user
-func (h *GinHandler) AssignCMSRole(c *gin.Context) {
+// ListCMSAccessHolders handles listing the users and roles allowed an action on a CMS tab
+func (h *GinHandler) ListCMSAccessHolders(c *gin.Context) {
+	cmsTab := c.Query("cms_tab")
+	if cmsTab == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "CMS tab is required")
+		return
+	}
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	holders, total, err := h.casbinService.ListCMSAccessHolders(c.Request.Context(),
+		domain.CMSTab(cmsTab), c.Query("action"), page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list CMS access holders")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"holders": holders,
+		"total":   total,
+	}, "")
+}
+
+// BatchEnforcePolicy handles policy enforcement for several requests
+func (h *GinHandler) BatchEnforcePolicy(c *gin.Context) {
 	var req struct {
-		UserID    string `json:"user_id" binding:"required"`
-		CMSRoleID string `json:"cms_role_id" binding:"required"`
+		Requests []struct {
+			UserID     string                `json:"user_id"`
+			Domain     string                `json:"domain"`
+			Resource   string                `json:"resource"`
+			Action     string                `json:"action"`
+			Attributes map[string]string     `json:"attributes"`
+			Scope      *domain.ResourceScope `json:"scope"`
+		} `json:"requests" binding:"required,min=1,max=100"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
 
-	err := h.casbinService.AssignCMSRole(c.Request.Context(), req.UserID, req.CMSRoleID)
+	authReqs := make([]*domain.AuthorizationRequest, len(req.Requests))
+	for i, r := range req.Requests {
+		authReqs[i] = &domain.AuthorizationRequest{
+			UserID:     r.UserID,
+			Domain:     domain.CasbinDomain(r.Domain),
+			Resource:   r.Resource,
+			Action:     r.Action,
+			Attributes: r.Attributes,
+			Scope:      r.Scope,
+		}
+	}
+
+	results, err := h.casbinService.BatchEnforce(c.Request.Context(), authReqs)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to assign CMS role")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to enforce policies")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusOK, nil, "CMS role assigned successfully")
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"results": results,
+	}, "")
 }
 
-// RemoveCMSRole handles removing CMS role from user
-func (h *GinHandler) RemoveCMSRole(c *gin.Context) {
+// CreatePolicy handles adding a policy rule
+func (h *GinHandler) CreatePolicy(c *gin.Context) {
 	var req struct {
-		UserID    string `json:"user_id" binding:"required"`
-		CMSRoleID string `json:"cms_role_id" binding:"required"`
+		Subject  string `json:"subject" binding:"required"`
+		Domain   string `json:"domain" binding:"required"`
+		Resource string `json:"resource" binding:"required"`
+		Action   string `json:"action" binding:"required"`
+		Effect   string `json:"effect"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
 		return
 	}
 
-	err := h.casbinService.RemoveCMSRole(c.Request.Context(), req.UserID, req.CMSRoleID)
+	policy := &domain.Policy{
+		Subject:  req.Subject,
+		Domain:   domain.CasbinDomain(req.Domain),
+		Resource: req.Resource,
+		Action:   req.Action,
+		Effect:   domain.PolicyEffect(req.Effect),
+	}
+	if policy.Effect == "" {
+		policy.Effect = domain.PolicyEffectAllow
+	}
+
+	err := h.casbinService.AddPolicy(c.Request.Context(), policy.Subject, policy.Domain, policy.Resource, policy.Action, policy.Effect)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to remove CMS role")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create policy")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusOK, nil, "CMS role removed successfully")
+	h.sendSuccess(c, http.StatusCreated, policy, "Policy created successfully")
 }
 
-// GetUserCMSTabs handles getting user's CMS tabs
-func (h *GinHandler) GetUserCMSTabs(c *gin.Context) {
-	userID := c.Param("user_id")
-	if userID == "" {
-		h.sendError(c, http.StatusBadRequest, nil, "User ID is required")
+// DeletePolicy handles removing a policy rule
+func (h *GinHandler) DeletePolicy(c *gin.Context) {
+	var req struct {
+		Subject  string `form:"subject" binding:"required"`
+		Domain   string `form:"domain" binding:"required"`
+		Resource string `form:"resource" binding:"required"`
+		Action   string `form:"action" binding:"required"`
+		Effect   string `form:"effect"`
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid query parameters")
 		return
 	}
 
-	tabs, err := h.casbinService.GetUserCMSTabs(c.Request.Context(), userID)
+	err := h.casbinService.RemovePolicy(c.Request.Context(), req.Subject, domain.CasbinDomain(req.Domain), req.Resource, req.Action, domain.PolicyEffect(req.Effect))
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to get user CMS tabs")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete policy")
 		return
 	}
 
-	// Convert tabs to strings
-	tabStrings := make([]string, len(tabs))
-	for i, tab := range tabs {
-		tabStrings[i] = string(tab)
-	}
-
-	h.sendSuccess(c, http.StatusOK, gin.H{
-		"tabs": tabStrings,
-	}, "")
+	h.sendSuccess(c, http.StatusOK, nil, "Policy deleted successfully")
 }
 
-// API Resource Handlers
-
-// CreateAPIResource handles API resource creation
-func (h *GinHandler) CreateAPIResource(c *gin.Context) {
-	var req struct {
-		Path        string `json:"path" binding:"required"`
-		Method      string `json:"method" binding:"required"`
-		Service     string `json:"service" binding:"required"`
-		Description string `json:"description"`
+// ListPolicies handles listing policy rules matching a filter
+func (h *GinHandler) ListPolicies(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
 	}
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
-		return
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
 	}
 
-	resource, err := h.casbinService.CreateAPIResource(c.Request.Context(), req.Path, req.Method, req.Service, req.Description)
+	filter := domain.PolicyFilter{
+		Subject:  c.Query("subject"),
+		Domain:   domain.CasbinDomain(c.Query("domain")),
+		Resource: c.Query("resource"),
+		Action:   c.Query("action"),
+		Effect:   domain.PolicyEffect(c.Query("effect")),
+	}
+
+	policies, total, err := h.casbinService.ListPolicies(c.Request.Context(), filter, page, pageSize)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to create API resource")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list policies")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusCreated, gin.H{
-		"api_resource_id": resource.ID,
-		"path":            resource.Path,
-		"method":          resource.Method,
-	}, "API resource created successfully")
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"policies": policies,
+		"total":    total,
+	}, "")
 }
 
-// ListAPIResources handles listing API resources
-func (h *GinHandler) ListAPIResources(c *gin.Context) {
-	service := c.Query("service")
+// ExportPolicies handles downloading all policies, role links and CMS tab APIs as a CSV or YAML file
+func (h *GinHandler) ExportPolicies(c *gin.Context) {
+	format := domain.PolicyBundleFormat(c.DefaultQuery("format", string(domain.PolicyBundleFormatCSV)))
 
-	resources, err := h.casbinService.ListAPIResources(c.Request.Context(), service)
+	content, err := h.casbinService.ExportPolicies(c.Request.Context(), format)
 	if err != nil {
-		h.sendError(c, http.StatusInternalServerError, err, "Failed to list API resources")
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to export policies")
 		return
 	}
 
-	h.sendSuccess(c, http.StatusOK, gin.H{
+	contentType := "text/csv; charset=utf-8"
+	if format == domain.PolicyBundleFormatYAML {
+		contentType = "application/yaml; charset=utf-8"
+	}
+	c.Header("Content-Disposition", "attachment; filename=policies."+string(format))
+	c.Data(http.StatusOK, contentType, content)
+}
+
+// ImportPolicies handles replacing the live policies with an uploaded CSV or YAML file.
+// With dry_run=true only the changes are reported.
+func (h *GinHandler) ImportPolicies(c *gin.Context) {
+	format := domain.PolicyBundleFormat(c.DefaultQuery("format", string(domain.PolicyBundleFormatCSV)))
+	dryRun, _ := strconv.ParseBool(c.Query("dry_run"))
+
+	content, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	diff, err := h.casbinService.ImportPolicies(c.Request.Context(), content, format, dryRun)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to import policies")
+		return
+	}
+
+	message := "Policies imported successfully"
+	if dryRun {
+		message = "Dry run: no changes were applied"
+	}
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"dry_run": dryRun,
+		"diff":    diff,
+	}, message)
+}
+
+// SimulatePolicyChanges handles reporting who would gain or lose access from a change set
+func (h *GinHandler) SimulatePolicyChanges(c *gin.Context) {
+	var req domain.PolicyChangeSet
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	simulation, err := h.casbinService.SimulatePolicyChanges(c.Request.Context(), &req)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to simulate policy changes")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, simulation, "")
+}
+
+// SetPolicyCondition handles attaching a condition to a policy
+func (h *GinHandler) SetPolicyCondition(c *gin.Context) {
+	var req struct {
+		Subject    string `json:"subject" binding:"required"`
+		Domain     string `json:"domain" binding:"required"`
+		Resource   string `json:"resource" binding:"required"`
+		Action     string `json:"action" binding:"required"`
+		Effect     string `json:"effect"` // allow (default) or deny
+		Expression string `json:"expression" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	condition, err := h.casbinService.SetPolicyCondition(c.Request.Context(), &domain.PolicyCondition{
+		Subject:    req.Subject,
+		Domain:     domain.CasbinDomain(req.Domain),
+		Resource:   req.Resource,
+		Action:     req.Action,
+		Effect:     domain.PolicyEffect(req.Effect),
+		Expression: req.Expression,
+	})
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to set policy condition")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, condition, "Policy condition saved successfully")
+}
+
+// DeletePolicyCondition handles detaching the condition from a policy
+func (h *GinHandler) DeletePolicyCondition(c *gin.Context) {
+	var req struct {
+		Subject  string `form:"subject" binding:"required"`
+		Domain   string `form:"domain" binding:"required"`
+		Resource string `form:"resource" binding:"required"`
+		Action   string `form:"action" binding:"required"`
+		Effect   string `form:"effect"`
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid query parameters")
+		return
+	}
+
+	err := h.casbinService.RemovePolicyCondition(c.Request.Context(), req.Subject, domain.CasbinDomain(req.Domain),
+		req.Resource, req.Action, domain.PolicyEffect(req.Effect))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete policy condition")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Policy condition deleted successfully")
+}
+
+// ListPolicyConditions handles listing policy conditions
+func (h *GinHandler) ListPolicyConditions(c *gin.Context) {
+	dom := c.Query("domain")
+
+	conditions, err := h.casbinService.ListPolicyConditions(c.Request.Context(), domain.CasbinDomain(dom))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list policy conditions")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"conditions": conditions,
+	}, "")
+}
+
+// CMS Handlers
+
+// CreateCMSRole handles CMS role creation
+func (h *GinHandler) CreateCMSRole(c *gin.Context) {
+	var req struct {
+		Name        string                    `json:"name" binding:"required"`
+		Description string                    `json:"description"`
+		Tabs        []string                  `json:"tabs"`
+		Permissions []domain.CMSTabPermission `json:"permissions"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	// Plain tabs are granted view-only access
+	permissions := req.Permissions
+	for _, tab := range req.Tabs {
+		permissions = append(permissions, domain.CMSTabPermission{Tab: domain.CMSTab(tab)})
+	}
+
+	role, err := h.casbinService.CreateCMSRole(c.Request.Context(), req.Name, req.Description, permissions)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create CMS role")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, gin.H{
+		"cms_role_id": role.ID,
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	}, "CMS role created successfully")
+}
+
+// GetCMSRole handles getting a CMS role by ID
+func (h *GinHandler) GetCMSRole(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+	if cmsRoleID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "CMS role ID is required")
+		return
+	}
+
+	role, err := h.casbinService.GetCMSRole(c.Request.Context(), cmsRoleID)
+	if err != nil {
+		h.sendError(c, http.StatusNotFound, err, "CMS role not found")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, role, "")
+}
+
+// UpdateCMSRole handles CMS role update
+func (h *GinHandler) UpdateCMSRole(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+	var req struct {
+		Name        string                    `json:"name"`
+		Description *string                   `json:"description"`
+		Permissions []domain.CMSTabPermission `json:"permissions"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	role, err := h.casbinService.UpdateCMSRole(c.Request.Context(), cmsRoleID, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to update CMS role")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, role, "CMS role updated successfully")
+}
+
+// DeleteCMSRole handles CMS role deletion
+func (h *GinHandler) DeleteCMSRole(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+	if cmsRoleID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "CMS role ID is required")
+		return
+	}
+
+	cascade, _ := strconv.ParseBool(c.Query("cascade"))
+	impact, err := h.casbinService.DeleteCMSRole(c.Request.Context(), cmsRoleID, cascade)
+	if err != nil {
+		h.sendDeleteRoleError(c, err, "Failed to delete CMS role")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, impact, "CMS role deleted successfully")
+}
+
+// ListCMSRoles handles listing CMS roles
+func (h *GinHandler) ListCMSRoles(c *gin.Context) {
+	// Note: Full implementation pending - returning empty list for now
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"roles": []interface{}{},
+		"total": 0,
+	}, "")
+}
+
+// AssignCMSRole handles assigning CMS role to user
+func (h *GinHandler) AssignCMSRole(c *gin.Context) {
+	var req struct {
+		UserID    string               `json:"user_id" binding:"required"`
+		CMSRoleID string               `json:"cms_role_id" binding:"required"`
+		StartsAt  *time.Time           `json:"starts_at"`
+		ExpiresAt *time.Time           `json:"expires_at"`
+		Scope     domain.ResourceScope `json:"scope"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	window := domain.AssignmentWindow{StartsAt: req.StartsAt, ExpiresAt: req.ExpiresAt}
+	if err := window.Validate(time.Now()); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid assignment window")
+		return
+	}
+	if err := req.Scope.Validate(); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid scope")
+		return
+	}
+
+	err := h.casbinService.AssignCMSRole(c.Request.Context(), req.UserID, req.CMSRoleID, req.Scope, window)
+	if err != nil {
+		h.sendRoleLinkError(c, err, "Failed to assign CMS role")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "CMS role assigned successfully")
+}
+
+// RemoveCMSRole handles removing CMS role from user
+func (h *GinHandler) RemoveCMSRole(c *gin.Context) {
+	var req struct {
+		UserID    string               `json:"user_id" binding:"required"`
+		CMSRoleID string               `json:"cms_role_id" binding:"required"`
+		Scope     domain.ResourceScope `json:"scope"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	err := h.casbinService.RemoveCMSRole(c.Request.Context(), req.UserID, req.CMSRoleID, req.Scope)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to remove CMS role")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "CMS role removed successfully")
+}
+
+// GetUserCMSTabs handles getting user's CMS tabs
+func (h *GinHandler) GetUserCMSTabs(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "User ID is required")
+		return
+	}
+
+	tree, err := h.casbinService.GetUserCMSTabTree(c.Request.Context(), userID)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to get user CMS tabs")
+		return
+	}
+
+	// Convert tabs to strings
+	tabs := domain.FlattenCMSTabTree(tree)
+	tabStrings := make([]string, len(tabs))
+	for i, tab := range tabs {
+		tabStrings[i] = string(tab)
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"tabs": tabStrings,
+		"tree": tree,
+	}, "")
+}
+
+// GetCMSCapabilities handles getting the user's CMS capability map.
+// Conditions see the attributes[name]=value query parameters and scoped roles the scope_type and
+// scope_id ones. The version is sent as an ETag so clients can revalidate with If-None-Match.
+func (h *GinHandler) GetCMSCapabilities(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "User ID is required")
+		return
+	}
+
+	scope := domain.ResourceScope{Type: c.Query("scope_type"), ID: c.Query("scope_id")}
+	capabilities, err := h.casbinService.GetCMSCapabilities(c.Request.Context(), userID, c.QueryMap("attributes"), scope)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to get CMS capabilities")
+		return
+	}
+
+	etag := `"` + capabilities.Version + `"`
+	c.Header("ETag", etag)
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, capabilities, "")
+}
+
+// API Resource Handlers
+
+// SetCMSRoleFieldPermission handles setting a CMS role's field rule for a resource type
+func (h *GinHandler) SetCMSRoleFieldPermission(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+	resourceType := c.Param("resource_type")
+	var req struct {
+		ReadableFields []string `json:"readable_fields"`
+		WritableFields []string `json:"writable_fields"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	perm, err := h.casbinService.SetCMSRoleFieldPermission(c.Request.Context(), cmsRoleID, resourceType, req.ReadableFields, req.WritableFields)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to set CMS field permission")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, perm, "CMS field permission saved successfully")
+}
+
+// ListCMSRoleFieldPermissions handles listing a CMS role's field rules
+func (h *GinHandler) ListCMSRoleFieldPermissions(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+
+	perms, err := h.casbinService.ListCMSRoleFieldPermissions(c.Request.Context(), cmsRoleID)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list CMS field permissions")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"permissions": perms,
+	}, "")
+}
+
+// DeleteCMSRoleFieldPermission handles removing a CMS role's field rule
+func (h *GinHandler) DeleteCMSRoleFieldPermission(c *gin.Context) {
+	cmsRoleID := c.Param("id")
+	resourceType := c.Param("resource_type")
+
+	if err := h.casbinService.DeleteCMSRoleFieldPermission(c.Request.Context(), cmsRoleID, resourceType); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete CMS field permission")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "CMS field permission deleted successfully")
+}
+
+// GetUserFieldPermissions handles getting the fields a user may read and write on a resource type
+func (h *GinHandler) GetUserFieldPermissions(c *gin.Context) {
+	userID := c.Param("user_id")
+	resourceType := c.Param("resource_type")
+
+	fields, err := h.casbinService.GetUserFieldPermissions(c.Request.Context(), userID, resourceType)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to get user field permissions")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, fields, "")
+}
+
+// CreateCMSTab handles registering a CMS tab
+func (h *GinHandler) CreateCMSTab(c *gin.Context) {
+	var req struct {
+		Key         string `json:"key" binding:"required"`
+		DisplayName string `json:"display_name" binding:"required"`
+		Icon        string `json:"icon"`
+		ParentKey   string `json:"parent_key"`
+		SortOrder   int    `json:"sort_order"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	tab, err := h.casbinService.CreateCMSTab(c.Request.Context(), &domain.CMSTabDefinition{
+		Key:         domain.CMSTab(req.Key),
+		DisplayName: req.DisplayName,
+		Icon:        req.Icon,
+		ParentKey:   domain.CMSTab(req.ParentKey),
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create CMS tab")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, tab, "CMS tab created successfully")
+}
+
+// GetCMSTab handles getting a CMS tab by key
+func (h *GinHandler) GetCMSTab(c *gin.Context) {
+	key := c.Param("key")
+	if key == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "CMS tab key is required")
+		return
+	}
+
+	tab, err := h.casbinService.GetCMSTab(c.Request.Context(), domain.CMSTab(key))
+	if err != nil {
+		h.sendError(c, http.StatusNotFound, err, "CMS tab not found")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, tab, "")
+}
+
+// ListCMSTabs handles listing registered CMS tabs
+func (h *GinHandler) ListCMSTabs(c *gin.Context) {
+	tabs, err := h.casbinService.ListCMSTabs(c.Request.Context())
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list CMS tabs")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"tabs":  tabs,
+		"total": len(tabs),
+	}, "")
+}
+
+// UpdateCMSTab handles CMS tab update
+func (h *GinHandler) UpdateCMSTab(c *gin.Context) {
+	key := c.Param("key")
+	var req struct {
+		DisplayName string `json:"display_name"`
+		Icon        string `json:"icon"`
+		ParentKey   string `json:"parent_key"`
+		SortOrder   int    `json:"sort_order"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	tab, err := h.casbinService.UpdateCMSTab(c.Request.Context(), &domain.CMSTabDefinition{
+		Key:         domain.CMSTab(key),
+		DisplayName: req.DisplayName,
+		Icon:        req.Icon,
+		ParentKey:   domain.CMSTab(req.ParentKey),
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to update CMS tab")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, tab, "CMS tab updated successfully")
+}
+
+// DeleteCMSTab handles CMS tab deletion
+func (h *GinHandler) DeleteCMSTab(c *gin.Context) {
+	key := c.Param("key")
+	if key == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "CMS tab key is required")
+		return
+	}
+
+	if err := h.casbinService.DeleteCMSTab(c.Request.Context(), domain.CMSTab(key)); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete CMS tab")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "CMS tab deleted successfully")
+}
+
+// CreateResourceScope handles registering a resource scope below its parent
+func (h *GinHandler) CreateResourceScope(c *gin.Context) {
+	var req struct {
+		Type   string                `json:"type" binding:"required"`
+		ID     string                `json:"id" binding:"required"`
+		Name   string                `json:"name"`
+		Parent *domain.ResourceScope `json:"parent"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	scope, err := h.casbinService.CreateResourceScope(c.Request.Context(), &domain.ResourceScopeDefinition{
+		ResourceScope: domain.ResourceScope{Type: req.Type, ID: req.ID},
+		Name:          req.Name,
+		Parent:        req.Parent,
+	})
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create resource scope")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, scope, "Resource scope created successfully")
+}
+
+// ListResourceScopes handles listing resource scopes, optionally of one type
+func (h *GinHandler) ListResourceScopes(c *gin.Context) {
+	scopes, err := h.casbinService.ListResourceScopes(c.Request.Context(), c.Query("type"))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list resource scopes")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"scopes": scopes,
+		"total":  len(scopes),
+	}, "")
+}
+
+// DeleteResourceScope handles deleting a resource scope without child scopes
+func (h *GinHandler) DeleteResourceScope(c *gin.Context) {
+	scope := domain.ResourceScope{Type: c.Param("type"), ID: c.Param("id")}
+
+	if err := h.casbinService.DeleteResourceScope(c.Request.Context(), scope); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete resource scope")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Resource scope deleted successfully")
+}
+
+// ListUserScopedCMSRoles handles listing the CMS roles a user holds within resource scopes
+func (h *GinHandler) ListUserScopedCMSRoles(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		h.sendError(c, http.StatusBadRequest, nil, "User ID is required")
+		return
+	}
+
+	assignments, err := h.casbinService.ListUserScopedCMSRoles(c.Request.Context(), userID)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list scoped CMS roles")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"assignments": assignments,
+		"total":       len(assignments),
+	}, "")
+}
+
+// CreateAPIResource handles API resource creation
+func (h *GinHandler) CreateAPIResource(c *gin.Context) {
+	var req struct {
+		Path        string `json:"path" binding:"required"`
+		Method      string `json:"method" binding:"required"`
+		Service     string `json:"service" binding:"required"`
+		Description string `json:"description"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	resource, err := h.casbinService.CreateAPIResource(c.Request.Context(), req.Path, req.Method, req.Service, req.Description)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create API resource")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, gin.H{
+		"api_resource_id": resource.ID,
+		"path":            resource.Path,
+		"method":          resource.Method,
+	}, "API resource created successfully")
+}
+
+// ListAPIResources handles listing API resources
+func (h *GinHandler) ListAPIResources(c *gin.Context) {
+	service := c.Query("service")
+
+	resources, err := h.casbinService.ListAPIResources(c.Request.Context(), service)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list API resources")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
 		"resources": resources,
 		"total":     len(resources),
 	}, "")
 }
+
+// CreateAccessRequest handles a user's request to be granted a role
+func (h *GinHandler) CreateAccessRequest(c *gin.Context) {
+	var req struct {
+		RequesterID     string `json:"requester_id" binding:"required"`
+		RoleKind        string `json:"role_kind" binding:"required"`
+		RoleID          string `json:"role_id" binding:"required"`
+		Justification   string `json:"justification"`
+		DurationSeconds int64  `json:"duration_seconds" binding:"min=0"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	request, err := h.accessService.CreateAccessRequest(c.Request.Context(), req.RequesterID,
+		domain.RoleKind(req.RoleKind), req.RoleID, req.Justification, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		h.sendAccessRequestError(c, err, "Failed to create access request")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, request, "Access request created successfully")
+}
+
+// GetAccessRequest handles retrieving an access request with its decision history
+func (h *GinHandler) GetAccessRequest(c *gin.Context) {
+	requestID := c.Param("id")
+
+	request, decisions, err := h.accessService.GetAccessRequest(c.Request.Context(), requestID)
+	if err != nil {
+		h.sendError(c, http.StatusNotFound, err, "Access request not found")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"request":   request,
+		"decisions": decisions,
+	}, "")
+}
+
+// ListAccessRequests handles listing access requests
+func (h *GinHandler) ListAccessRequests(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	filter := domain.AccessRequestFilter{
+		Status:      domain.AccessRequestStatus(c.Query("status")),
+		RequesterID: c.Query("requester_id"),
+		RoleKind:    domain.RoleKind(c.Query("role_kind")),
+		RoleID:      c.Query("role_id"),
+	}
+	requests, total, err := h.accessService.ListAccessRequests(c.Request.Context(), filter, page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list access requests")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"requests": requests,
+		"total":    total,
+	}, "")
+}
+
+// ApproveAccessRequest handles approving an access request, which assigns the role
+func (h *GinHandler) ApproveAccessRequest(c *gin.Context) {
+	approverID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		Comment string `json:"comment"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	request, err := h.accessService.ApproveAccessRequest(c.Request.Context(), c.Param("id"), approverID, req.Comment)
+	if err != nil {
+		h.sendAccessRequestError(c, err, "Failed to approve access request")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, request, "Access request approved successfully")
+}
+
+// DenyAccessRequest handles denying an access request
+func (h *GinHandler) DenyAccessRequest(c *gin.Context) {
+	approverID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		Comment string `json:"comment"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	request, err := h.accessService.DenyAccessRequest(c.Request.Context(), c.Param("id"), approverID, req.Comment)
+	if err != nil {
+		h.sendAccessRequestError(c, err, "Failed to deny access request")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, request, "Access request denied successfully")
+}
+
+// SetAccessRequestApprovers handles replacing the approvers of a role
+func (h *GinHandler) SetAccessRequestApprovers(c *gin.Context) {
+	actorID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		RoleKind    string   `json:"role_kind" binding:"required"`
+		RoleID      string   `json:"role_id" binding:"required"`
+		ApproverIDs []string `json:"approver_ids"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	err := h.accessService.SetAccessRequestApprovers(c.Request.Context(), actorID, domain.RoleKind(req.RoleKind), req.RoleID, req.ApproverIDs)
+	if err != nil {
+		h.sendAccessRequestError(c, err, "Failed to set access request approvers")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Access request approvers set successfully")
+}
+
+// ListAccessRequestApprovers handles listing the approvers of a role
+func (h *GinHandler) ListAccessRequestApprovers(c *gin.Context) {
+	approverIDs, err := h.accessService.ListAccessRequestApprovers(c.Request.Context(),
+		domain.RoleKind(c.Query("role_kind")), c.Query("role_id"))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list access request approvers")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"approver_ids": approverIDs,
+	}, "")
+}
+
+// CreateSoDConstraint handles creating a separation-of-duties constraint
+func (h *GinHandler) CreateSoDConstraint(c *gin.Context) {
+	var req struct {
+		Name        string           `json:"name" binding:"required"`
+		Description string           `json:"description"`
+		Mode        string           `json:"mode" binding:"required"`
+		Roles       []domain.SoDRole `json:"roles" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	constraint, err := h.sodService.CreateSoDConstraint(c.Request.Context(), req.Name, req.Description, domain.SoDMode(req.Mode), req.Roles)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to create SoD constraint")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, constraint, "SoD constraint created successfully")
+}
+
+// GetSoDConstraint handles retrieving a separation-of-duties constraint
+func (h *GinHandler) GetSoDConstraint(c *gin.Context) {
+	constraint, err := h.sodService.GetSoDConstraint(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.sendError(c, http.StatusNotFound, err, "SoD constraint not found")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, constraint, "")
+}
+
+// ListSoDConstraints handles listing separation-of-duties constraints
+func (h *GinHandler) ListSoDConstraints(c *gin.Context) {
+	constraints, err := h.sodService.ListSoDConstraints(c.Request.Context())
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list SoD constraints")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"constraints": constraints,
+		"total":       len(constraints),
+	}, "")
+}
+
+// DeleteSoDConstraint handles deleting a separation-of-duties constraint
+func (h *GinHandler) DeleteSoDConstraint(c *gin.Context) {
+	if err := h.sodService.DeleteSoDConstraint(c.Request.Context(), c.Param("id")); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete SoD constraint")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "SoD constraint deleted successfully")
+}
+
+// ListSoDViolations handles reporting the users and roles breaking static SoD constraints
+func (h *GinHandler) ListSoDViolations(c *gin.Context) {
+	violations, err := h.sodService.ListSoDViolations(c.Request.Context())
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list SoD violations")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"violations": violations,
+		"total":      len(violations),
+	}, "")
+}
+
+// CreateAccessReviewCampaign handles starting an access review over a snapshot of assignments
+func (h *GinHandler) CreateAccessReviewCampaign(c *gin.Context) {
+	creatorID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		Name              string                   `json:"name" binding:"required"`
+		Description       string                   `json:"description"`
+		Scope             domain.AccessReviewScope `json:"scope"`
+		Reviewers         []domain.AccessReviewer  `json:"reviewers"`
+		DefaultReviewerID string                   `json:"default_reviewer_id"`
+		DueAt             *time.Time               `json:"due_at"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	campaign, summary, err := h.reviewService.CreateAccessReviewCampaign(c.Request.Context(), req.Name, req.Description,
+		req.Scope, req.Reviewers, req.DefaultReviewerID, req.DueAt, creatorID)
+	if err != nil {
+		h.sendAccessReviewError(c, err, "Failed to create access review campaign")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusCreated, gin.H{
+		"campaign": campaign,
+		"summary":  summary,
+	}, "Access review campaign created successfully")
+}
+
+// GetAccessReviewCampaign handles retrieving a campaign with its progress
+func (h *GinHandler) GetAccessReviewCampaign(c *gin.Context) {
+	campaign, summary, err := h.reviewService.GetAccessReviewCampaign(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.sendError(c, http.StatusNotFound, err, "Access review campaign not found")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"campaign": campaign,
+		"summary":  summary,
+	}, "")
+}
+
+// ListAccessReviewCampaigns handles listing access review campaigns
+func (h *GinHandler) ListAccessReviewCampaigns(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	campaigns, total, err := h.reviewService.ListAccessReviewCampaigns(c.Request.Context(),
+		domain.AccessReviewStatus(c.Query("status")), page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list access review campaigns")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"campaigns": campaigns,
+		"total":     total,
+	}, "")
+}
+
+// ListAccessReviewItems handles listing the assignments of a campaign, such as a reviewer's queue
+func (h *GinHandler) ListAccessReviewItems(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	filter := domain.AccessReviewItemFilter{
+		ReviewerID: c.Query("reviewer_id"),
+		UserID:     c.Query("user_id"),
+		Decision:   domain.AccessReviewDecision(c.Query("decision")),
+	}
+	items, total, err := h.reviewService.ListAccessReviewItems(c.Request.Context(), c.Param("id"), filter, page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list access review items")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"items": items,
+		"total": total,
+	}, "")
+}
+
+// DecideAccessReviewItem handles a reviewer keeping or revoking an assignment
+func (h *GinHandler) DecideAccessReviewItem(c *gin.Context) {
+	reviewerID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		Decision string `json:"decision" binding:"required"`
+		Comment  string `json:"comment"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	item, err := h.reviewService.DecideAccessReviewItem(c.Request.Context(), c.Param("id"), c.Param("item_id"),
+		reviewerID, domain.AccessReviewDecision(req.Decision), req.Comment)
+	if err != nil {
+		h.sendAccessReviewError(c, err, "Failed to decide access review item")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, item, "Access review decision recorded successfully")
+}
+
+// CloseAccessReviewCampaign handles closing a campaign, which applies its revocations
+func (h *GinHandler) CloseAccessReviewCampaign(c *gin.Context) {
+	closerID, ok := h.callerID(c)
+	if !ok {
+		return
+	}
+
+	campaign, summary, err := h.reviewService.CloseAccessReviewCampaign(c.Request.Context(), c.Param("id"), closerID)
+	if err != nil {
+		h.sendAccessReviewError(c, err, "Failed to close access review campaign")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"campaign": campaign,
+		"summary":  summary,
+	}, "Access review campaign closed successfully")
+}
+
+// ExportAccessReviewReport handles downloading the evidence report of a campaign as a CSV or JSON file
+func (h *GinHandler) ExportAccessReviewReport(c *gin.Context) {
+	format := domain.AccessReviewReportFormat(c.DefaultQuery("format", string(domain.AccessReviewReportCSV)))
+
+	content, err := h.reviewService.ExportAccessReviewReport(c.Request.Context(), c.Param("id"), format)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to export access review report")
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == domain.AccessReviewReportJSON {
+		contentType = "application/json; charset=utf-8"
+	}
+	c.Header("Content-Disposition", "attachment; filename=access-review-"+c.Param("id")+"."+string(format))
+	c.Data(http.StatusOK, contentType, content)
+}
+
+// PutRelationNamespace handles creating a relation namespace or replacing its relations
+func (h *GinHandler) PutRelationNamespace(c *gin.Context) {
+	var req struct {
+		Relations []domain.RelationDefinition `json:"relations" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	namespace, err := h.relationService.PutRelationNamespace(c.Request.Context(), &domain.RelationNamespace{
+		Name:      c.Param("name"),
+		Relations: req.Relations,
+	})
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to save relation namespace")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, namespace, "Relation namespace saved successfully")
+}
+
+// ListRelationNamespaces handles listing relation namespaces
+func (h *GinHandler) ListRelationNamespaces(c *gin.Context) {
+	namespaces, err := h.relationService.ListRelationNamespaces(c.Request.Context())
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list relation namespaces")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"namespaces": namespaces,
+		"total":      len(namespaces),
+	}, "")
+}
+
+// DeleteRelationNamespace handles deleting a relation namespace without tuples
+func (h *GinHandler) DeleteRelationNamespace(c *gin.Context) {
+	if err := h.relationService.DeleteRelationNamespace(c.Request.Context(), c.Param("name")); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to delete relation namespace")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Relation namespace deleted successfully")
+}
+
+// WriteRelationTuples handles inserting and deleting relation tuples in one transaction
+func (h *GinHandler) WriteRelationTuples(c *gin.Context) {
+	var req struct {
+		Writes  []*domain.RelationTuple `json:"writes"`
+		Deletes []*domain.RelationTuple `json:"deletes"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	if err := h.relationService.WriteRelationTuples(c.Request.Context(), req.Writes, req.Deletes); err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to write relation tuples")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, nil, "Relation tuples written successfully")
+}
+
+// ReadRelationTuples handles listing relation tuples
+func (h *GinHandler) ReadRelationTuples(c *gin.Context) {
+	page := 1
+	if p := c.Query("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil {
+			page = parsed
+		}
+	}
+	pageSize := 10
+	if ps := c.Query("page_size"); ps != "" {
+		if parsed, err := strconv.Atoi(ps); err == nil {
+			pageSize = parsed
+		}
+	}
+
+	filter := domain.RelationTupleFilter{
+		Namespace: c.Query("namespace"),
+		ObjectID:  c.Query("object_id"),
+		Relation:  c.Query("relation"),
+	}
+	if s := c.Query("subject"); s != "" {
+		subject, err := domain.ParseRelationSubject(s)
+		if err != nil {
+			h.sendError(c, http.StatusBadRequest, err, "Invalid subject")
+			return
+		}
+		filter.Subject = &subject
+	}
+
+	tuples, total, err := h.relationService.ReadRelationTuples(c.Request.Context(), filter, page, pageSize)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to read relation tuples")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"tuples": tuples,
+		"total":  total,
+	}, "")
+}
+
+// CheckRelation handles checking whether a user holds a relation on an object
+func (h *GinHandler) CheckRelation(c *gin.Context) {
+	var req struct {
+		Namespace string `json:"namespace" binding:"required"`
+		ObjectID  string `json:"object_id" binding:"required"`
+		Relation  string `json:"relation" binding:"required"`
+		UserID    string `json:"user_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.sendError(c, http.StatusBadRequest, err, "Invalid request body")
+		return
+	}
+
+	allowed, err := h.relationService.CheckRelation(c.Request.Context(), req.Namespace, req.ObjectID, req.Relation, req.UserID)
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to check relation")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"allowed": allowed,
+	}, "")
+}
+
+// ExpandRelation handles expanding the userset of a relation on an object
+func (h *GinHandler) ExpandRelation(c *gin.Context) {
+	tree, err := h.relationService.ExpandRelation(c.Request.Context(),
+		c.Query("namespace"), c.Query("object_id"), c.Query("relation"))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to expand relation")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"tree": tree,
+	}, "")
+}
+
+// ListRelationObjects handles listing the objects on which a user holds a relation
+func (h *GinHandler) ListRelationObjects(c *gin.Context) {
+	objectIDs, err := h.relationService.ListRelationObjects(c.Request.Context(),
+		c.Query("namespace"), c.Query("relation"), c.Query("user_id"))
+	if err != nil {
+		h.sendError(c, http.StatusInternalServerError, err, "Failed to list relation objects")
+		return
+	}
+
+	h.sendSuccess(c, http.StatusOK, gin.H{
+		"object_ids": objectIDs,
+		"total":      len(objectIDs),
+	}, "")
+}
@@ -2,11 +2,16 @@ package handler
 
 import (
 	"context"
+	"errors"
+
+	"strings"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/tvttt/iam-services/internal/domain"
 	"github.com/tvttt/iam-services/internal/service"
 	pb "github.com/tvttt/iam-services/pkg/proto"
 )
@@ -14,12 +19,16 @@ import (
 // GRPCHandler implements the gRPC IAMService
 type GRPCHandler struct {
 	pb.UnimplementedIAMServiceServer
-	authService   service.AuthService
-	authzService  service.AuthorizationService
-	roleService   service.RoleService
-	permService   service.PermissionService
-	casbinService service.CasbinService
-	logger        *zap.Logger
+	authService     service.AuthService
+	authzService    service.AuthorizationService
+	roleService     service.RoleService
+	permService     service.PermissionService
+	casbinService   service.CasbinService
+	accessService   service.AccessRequestService
+	sodService      service.SoDService
+	reviewService   service.AccessReviewService
+	relationService service.RelationService
+	logger          *zap.Logger
 }
 
 // NewGRPCHandler creates a new gRPC handler
@@ -29,15 +38,23 @@ func NewGRPCHandler(
 	roleService service.RoleService,
 	permService service.PermissionService,
 	casbinService service.CasbinService,
+	accessService service.AccessRequestService,
+	sodService service.SoDService,
+	reviewService service.AccessReviewService,
+	relationService service.RelationService,
 	logger *zap.Logger,
 ) *GRPCHandler {
 	return &GRPCHandler{
-		authService:   authService,
-		authzService:  authzService,
-		roleService:   roleService,
-		permService:   permService,
-		casbinService: casbinService,
-		logger:        logger,
+		authService:     authService,
+		authzService:    authzService,
+		roleService:     roleService,
+		permService:     permService,
+		casbinService:   casbinService,
+		accessService:   accessService,
+		sodService:      sodService,
+		reviewService:   reviewService,
+		relationService: relationService,
+		logger:          logger,
 	}
 }
 
@@ -63,9 +80,16 @@ func (h *GRPCHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 func (h *GRPCHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	h.logger.Info("Login request received", zap.String("username", req.Username))
 
-	user, tokenPair, err := h.authService.Login(ctx, req.Username, req.Password)
+	user, tokenPair, err := h.authService.Login(ctx, req.Username, req.Password, req.ActiveRoles)
 	if err != nil {
 		h.logger.Error("Failed to login", zap.Error(err))
+		var violation *domain.SoDViolationError
+		switch {
+		case errors.As(err, &violation):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to activate roles: %v", err)
+		case errors.Is(err, domain.ErrSessionRoleNotAssigned):
+			return nil, status.Errorf(codes.PermissionDenied, "failed to activate roles: %v", err)
+		}
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
 
@@ -118,6 +142,37 @@ func (h *GRPCHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.Lo
 	}, nil
 }
 
+// callerID returns the ID of the user authenticated by the bearer token in the call's
+// authorization metadata
+func (h *GRPCHandler) callerID(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(value, "Bearer ")
+		if !ok || token == "" {
+			continue
+		}
+		userID, _, err := h.authService.VerifyToken(ctx, token)
+		if err != nil {
+			return "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		}
+		return userID, nil
+	}
+	return "", status.Error(codes.Unauthenticated, "missing bearer token")
+}
+
+// sessionInactiveRoles verifies the access token of a check made on behalf of a user's session
+// and returns the user's roles the session leaves inactive
+func (h *GRPCHandler) sessionInactiveRoles(ctx context.Context, userID, token string) ([]string, error) {
+	sessionUserID, inactive, err := h.authService.VerifySession(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
+	}
+	if sessionUserID != userID {
+		return nil, status.Error(codes.InvalidArgument, "access token belongs to another user")
+	}
+	return inactive, nil
+}
+
 // VerifyToken handles token verification
 func (h *GRPCHandler) VerifyToken(ctx context.Context, req *pb.VerifyTokenRequest) (*pb.VerifyTokenResponse, error) {
 	h.logger.Info("VerifyToken request received")
@@ -143,9 +198,14 @@ func (h *GRPCHandler) VerifyToken(ctx context.Context, req *pb.VerifyTokenReques
 func (h *GRPCHandler) AssignRole(ctx context.Context, req *pb.AssignRoleRequest) (*pb.AssignRoleResponse, error) {
 	h.logger.Info("AssignRole request received", zap.String("user_id", req.UserId), zap.String("role_id", req.RoleId))
 
-	if err := h.authzService.AssignRole(ctx, req.UserId, req.RoleId); err != nil {
+	window, err := pbAssignmentWindowToDomain(req.StartsAt, req.ExpiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if err := h.authzService.AssignRole(ctx, req.UserId, req.RoleId, window); err != nil {
 		h.logger.Error("Failed to assign role", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "failed to assign role: %v", err)
+		return nil, roleLinkStatusError("failed to assign role", err)
 	}
 
 	return &pb.AssignRoleResponse{
@@ -194,7 +254,17 @@ func (h *GRPCHandler) CheckPermission(ctx context.Context, req *pb.CheckPermissi
 		zap.String("resource", req.Resource),
 		zap.String("action", req.Action))
 
-	allowed, err := h.authzService.CheckPermission(ctx, req.UserId, req.Resource, req.Action)
+	var allowed bool
+	var err error
+	if req.AccessToken != "" {
+		inactive, sessionErr := h.sessionInactiveRoles(ctx, req.UserId, req.AccessToken)
+		if sessionErr != nil {
+			return nil, sessionErr
+		}
+		allowed, err = h.authzService.CheckPermissionInSession(ctx, req.UserId, inactive, req.Resource, req.Action)
+	} else {
+		allowed, err = h.authzService.CheckPermission(ctx, req.UserId, req.Resource, req.Action)
+	}
 	if err != nil {
 		h.logger.Error("Failed to check permission", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
@@ -245,13 +315,19 @@ func (h *GRPCHandler) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest)
 func (h *GRPCHandler) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
 	h.logger.Info("DeleteRole request received", zap.String("role_id", req.RoleId))
 
-	if err := h.roleService.DeleteRole(ctx, req.RoleId); err != nil {
+	impact, err := h.roleService.DeleteRole(ctx, req.RoleId, req.Cascade)
+	if err != nil {
 		h.logger.Error("Failed to delete role", zap.Error(err))
+		var inUse *domain.RoleInUseError
+		if errors.As(err, &inUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to delete role: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
 	}
 
 	return &pb.DeleteRoleResponse{
 		Message: "Role deleted successfully",
+		Impact:  domainRoleImpactToPB(impact),
 	}, nil
 }
 
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	pb "github.com/tvttt/iam-services/pkg/proto"
+)
+
+// PutRelationNamespace handles creating a relation namespace or replacing its relations
+func (h *GRPCHandler) PutRelationNamespace(ctx context.Context, req *pb.PutRelationNamespaceRequest) (*pb.RelationNamespaceResponse, error) {
+	h.logger.Info("PutRelationNamespace request received",
+		zap.String("name", req.Name),
+		zap.Int("relations", len(req.Relations)))
+
+	namespace, err := h.relationService.PutRelationNamespace(ctx, &domain.RelationNamespace{
+		Name:      req.Name,
+		Relations: pbRelationDefinitionsToDomain(req.Relations),
+	})
+	if err != nil {
+		h.logger.Error("Failed to put relation namespace", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to put relation namespace: %v", err)
+	}
+
+	return &pb.RelationNamespaceResponse{
+		Namespace: domainRelationNamespaceToPB(namespace),
+		Message:   "Relation namespace saved successfully",
+	}, nil
+}
+
+// ListRelationNamespaces handles listing relation namespaces
+func (h *GRPCHandler) ListRelationNamespaces(ctx context.Context, req *pb.ListRelationNamespacesRequest) (*pb.ListRelationNamespacesResponse, error) {
+	h.logger.Info("ListRelationNamespaces request received")
+
+	namespaces, err := h.relationService.ListRelationNamespaces(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list relation namespaces", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list relation namespaces: %v", err)
+	}
+
+	pbNamespaces := make([]*pb.RelationNamespace, len(namespaces))
+	for i, namespace := range namespaces {
+		pbNamespaces[i] = domainRelationNamespaceToPB(namespace)
+	}
+
+	return &pb.ListRelationNamespacesResponse{
+		Namespaces: pbNamespaces,
+	}, nil
+}
+
+// DeleteRelationNamespace handles deleting a relation namespace without tuples
+func (h *GRPCHandler) DeleteRelationNamespace(ctx context.Context, req *pb.DeleteRelationNamespaceRequest) (*pb.DeleteRelationNamespaceResponse, error) {
+	h.logger.Info("DeleteRelationNamespace request received", zap.String("name", req.Name))
+
+	if err := h.relationService.DeleteRelationNamespace(ctx, req.Name); err != nil {
+		h.logger.Error("Failed to delete relation namespace", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete relation namespace: %v", err)
+	}
+
+	return &pb.DeleteRelationNamespaceResponse{
+		Message: "Relation namespace deleted successfully",
+	}, nil
+}
+
+// WriteRelationTuples handles inserting and deleting relation tuples in one transaction
+func (h *GRPCHandler) WriteRelationTuples(ctx context.Context, req *pb.WriteRelationTuplesRequest) (*pb.WriteRelationTuplesResponse, error) {
+	h.logger.Info("WriteRelationTuples request received",
+		zap.Int("writes", len(req.Writes)),
+		zap.Int("deletes", len(req.Deletes)))
+
+	writes, err := pbRelationTuplesToDomain(req.Writes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relation tuple: %v", err)
+	}
+	deletes, err := pbRelationTuplesToDomain(req.Deletes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relation tuple: %v", err)
+	}
+
+	if err := h.relationService.WriteRelationTuples(ctx, writes, deletes); err != nil {
+		h.logger.Error("Failed to write relation tuples", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to write relation tuples: %v", err)
+	}
+
+	return &pb.WriteRelationTuplesResponse{
+		Message: "Relation tuples written successfully",
+	}, nil
+}
+
+// ReadRelationTuples handles listing relation tuples
+func (h *GRPCHandler) ReadRelationTuples(ctx context.Context, req *pb.ReadRelationTuplesRequest) (*pb.ReadRelationTuplesResponse, error) {
+	h.logger.Info("ReadRelationTuples request received",
+		zap.String("namespace", req.Namespace),
+		zap.String("object_id", req.ObjectId),
+		zap.String("relation", req.Relation),
+		zap.String("subject", req.Subject))
+
+	filter := domain.RelationTupleFilter{
+		Namespace: req.Namespace,
+		ObjectID:  req.ObjectId,
+		Relation:  req.Relation,
+	}
+	if req.Subject != "" {
+		subject, err := domain.ParseRelationSubject(req.Subject)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subject: %v", err)
+		}
+		filter.Subject = &subject
+	}
+
+	tuples, total, err := h.relationService.ReadRelationTuples(ctx, filter, int(req.Page), int(req.PageSize))
+	if err != nil {
+		h.logger.Error("Failed to read relation tuples", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to read relation tuples: %v", err)
+	}
+
+	return &pb.ReadRelationTuplesResponse{
+		Tuples: domainRelationTuplesToPB(tuples),
+		Total:  int32(total),
+	}, nil
+}
+
+// CheckRelation handles checking whether a user holds a relation on an object
+func (h *GRPCHandler) CheckRelation(ctx context.Context, req *pb.CheckRelationRequest) (*pb.CheckRelationResponse, error) {
+	h.logger.Info("CheckRelation request received",
+		zap.String("namespace", req.Namespace),
+		zap.String("object_id", req.ObjectId),
+		zap.String("relation", req.Relation),
+		zap.String("user_id", req.UserId))
+
+	allowed, err := h.relationService.CheckRelation(ctx, req.Namespace, req.ObjectId, req.Relation, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to check relation", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to check relation: %v", err)
+	}
+
+	return &pb.CheckRelationResponse{
+		Allowed: allowed,
+	}, nil
+}
+
+// ExpandRelation handles expanding the userset of a relation on an object
+func (h *GRPCHandler) ExpandRelation(ctx context.Context, req *pb.ExpandRelationRequest) (*pb.ExpandRelationResponse, error) {
+	h.logger.Info("ExpandRelation request received",
+		zap.String("namespace", req.Namespace),
+		zap.String("object_id", req.ObjectId),
+		zap.String("relation", req.Relation))
+
+	tree, err := h.relationService.ExpandRelation(ctx, req.Namespace, req.ObjectId, req.Relation)
+	if err != nil {
+		h.logger.Error("Failed to expand relation", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to expand relation: %v", err)
+	}
+
+	return &pb.ExpandRelationResponse{
+		Tree: domainRelationTreeToPB(tree),
+	}, nil
+}
+
+// ListRelationObjects handles listing the objects on which a user holds a relation
+func (h *GRPCHandler) ListRelationObjects(ctx context.Context, req *pb.ListRelationObjectsRequest) (*pb.ListRelationObjectsResponse, error) {
+	h.logger.Info("ListRelationObjects request received",
+		zap.String("namespace", req.Namespace),
+		zap.String("relation", req.Relation),
+		zap.String("user_id", req.UserId))
+
+	objectIDs, err := h.relationService.ListRelationObjects(ctx, req.Namespace, req.Relation, req.UserId)
+	if err != nil {
+		h.logger.Error("Failed to list relation objects", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list relation objects: %v", err)
+	}
+
+	return &pb.ListRelationObjectsResponse{
+		ObjectIds: objectIDs,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	pb "github.com/tvttt/iam-services/pkg/proto"
+)
+
+// CreateSoDConstraint handles creating a separation-of-duties constraint
+func (h *GRPCHandler) CreateSoDConstraint(ctx context.Context, req *pb.CreateSoDConstraintRequest) (*pb.SoDConstraintResponse, error) {
+	h.logger.Info("CreateSoDConstraint request received",
+		zap.String("name", req.Name),
+		zap.String("mode", req.Mode))
+
+	constraint, err := h.sodService.CreateSoDConstraint(ctx, req.Name, req.Description, domain.SoDMode(req.Mode), pbSoDRolesToDomain(req.Roles))
+	if err != nil {
+		h.logger.Error("Failed to create SoD constraint", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create SoD constraint: %v", err)
+	}
+
+	return &pb.SoDConstraintResponse{
+		Constraint: domainSoDConstraintToPB(constraint),
+		Message:    "SoD constraint created successfully",
+	}, nil
+}
+
+// GetSoDConstraint handles retrieving a separation-of-duties constraint
+func (h *GRPCHandler) GetSoDConstraint(ctx context.Context, req *pb.GetSoDConstraintRequest) (*pb.SoDConstraintResponse, error) {
+	h.logger.Info("GetSoDConstraint request received", zap.String("id", req.Id))
+
+	constraint, err := h.sodService.GetSoDConstraint(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to get SoD constraint", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "SoD constraint not found: %v", err)
+	}
+
+	return &pb.SoDConstraintResponse{
+		Constraint: domainSoDConstraintToPB(constraint),
+	}, nil
+}
+
+// ListSoDConstraints handles listing separation-of-duties constraints
+func (h *GRPCHandler) ListSoDConstraints(ctx context.Context, req *pb.ListSoDConstraintsRequest) (*pb.ListSoDConstraintsResponse, error) {
+	h.logger.Info("ListSoDConstraints request received")
+
+	constraints, err := h.sodService.ListSoDConstraints(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list SoD constraints", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list SoD constraints: %v", err)
+	}
+
+	pbConstraints := make([]*pb.SoDConstraint, len(constraints))
+	for i, constraint := range constraints {
+		pbConstraints[i] = domainSoDConstraintToPB(constraint)
+	}
+
+	return &pb.ListSoDConstraintsResponse{
+		Constraints: pbConstraints,
+	}, nil
+}
+
+// DeleteSoDConstraint handles deleting a separation-of-duties constraint
+func (h *GRPCHandler) DeleteSoDConstraint(ctx context.Context, req *pb.DeleteSoDConstraintRequest) (*pb.DeleteSoDConstraintResponse, error) {
+	h.logger.Info("DeleteSoDConstraint request received", zap.String("id", req.Id))
+
+	if err := h.sodService.DeleteSoDConstraint(ctx, req.Id); err != nil {
+		h.logger.Error("Failed to delete SoD constraint", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete SoD constraint: %v", err)
+	}
+
+	return &pb.DeleteSoDConstraintResponse{
+		Message: "SoD constraint deleted successfully",
+	}, nil
+}
+
+// ListSoDViolations handles reporting the users and roles breaking static SoD constraints
+func (h *GRPCHandler) ListSoDViolations(ctx context.Context, req *pb.ListSoDViolationsRequest) (*pb.ListSoDViolationsResponse, error) {
+	h.logger.Info("ListSoDViolations request received")
+
+	violations, err := h.sodService.ListSoDViolations(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list SoD violations", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list SoD violations: %v", err)
+	}
+
+	return &pb.ListSoDViolationsResponse{
+		Violations: domainSoDViolationsToPB(violations),
+	}, nil
+}
+
+// roleLinkStatusError maps errors of role assignments and inheritance to gRPC status codes
+func roleLinkStatusError(msg string, err error) error {
+	var violation *domain.SoDViolationError
+	if errors.As(err, &violation) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -23,12 +23,12 @@ func (s *casbinServiceImpl) Enforce(ctx context.Context, subject string, domain
 	return s.enforcer.Enforce(subject, string(domain), object, action)
 }
 
-func (s *casbinServiceImpl) AddPolicy(ctx context.Context, subject string, domain service.CasbinDomain, object, action string) error {
-	return s.enforcer.AddPolicy(subject, string(domain), object, action)
+func (s *casbinServiceImpl) AddPolicy(ctx context.Context, subject string, domain service.CasbinDomain, object, action string, effect service.PolicyEffect) error {
+	return s.enforcer.AddPolicyWithEffect(subject, string(domain), object, action, string(effect))
 }
 
-func (s *casbinServiceImpl) RemovePolicy(ctx context.Context, subject string, domain service.CasbinDomain, object, action string) error {
-	return s.enforcer.RemovePolicy(subject, string(domain), object, action)
+func (s *casbinServiceImpl) RemovePolicy(ctx context.Context, subject string, domain service.CasbinDomain, object, action string, effect service.PolicyEffect) error {
+	return s.enforcer.RemovePolicyWithEffect(subject, string(domain), object, action, string(effect))
 }
 
 func (s *casbinServiceImpl) AddRoleForUser(ctx context.Context, userID, role string, domain service.CasbinDomain) error {
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/tvttt/iam-services/internal/config"
@@ -32,6 +33,21 @@ func LoadConfig() (*config.Config, error) {
 			Level:    getEnv("LOG_LEVEL", "info"),
 			Encoding: getEnv("LOG_ENCODING", "json"),
 		},
+		Casbin: config.CasbinConfig{
+			ModelPath:      getEnv("CASBIN_MODEL_PATH", "configs/rbac_model.conf"),
+			WatcherEnabled: parseBool(getEnv("CASBIN_WATCHER_ENABLED", "true"), true),
+		},
+		Assignment: config.AssignmentConfig{
+			ReapInterval: parseDuration(getEnv("ASSIGNMENT_REAP_INTERVAL", "1m"), time.Minute),
+			NotifyRole:   getEnv("ASSIGNMENT_EXPIRY_NOTIFY_ROLE", "admin"),
+		},
+		AccessRequest: config.AccessRequestConfig{
+			PendingTTL:          parseDuration(getEnv("ACCESS_REQUEST_TTL", "168h"), 168*time.Hour),
+			DefaultApproverRole: getEnv("ACCESS_REQUEST_DEFAULT_APPROVER_ROLE", "admin"),
+		},
+		AccessReview: config.AccessReviewConfig{
+			ManagerRole: getEnv("ACCESS_REVIEW_MANAGER_ROLE", "admin"),
+		},
 	}
 
 	return cfg, nil
@@ -53,6 +69,14 @@ func parseDuration(value string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// parseBool parses a boolean string or returns a default value
+func parseBool(value string, defaultValue bool) bool {
+	if parsed, err := strconv.ParseBool(value); err == nil {
+		return parsed
+	}
+	return defaultValue
+}
+
 // ValidateConfig validates the configuration
 func ValidateConfig(cfg *config.Config) error {
 	if cfg.Server.Port == "" {
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/dao"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// AccessRequestRepository provides operations for access requests and their approvers
+type AccessRequestRepository interface {
+	CreateAccessRequest(ctx context.Context, request *domain.AccessRequest, submitted *domain.AccessRequestDecision) error
+	GetAccessRequestByID(ctx context.Context, id string) (*domain.AccessRequest, error)
+	ListAccessRequests(ctx context.Context, filter domain.AccessRequestFilter, page, pageSize int) ([]*domain.AccessRequest, int, error)
+	DecideAccessRequest(ctx context.Context, decision *domain.AccessRequestDecision) (bool, error)
+	ReopenAccessRequest(ctx context.Context, decision *domain.AccessRequestDecision, now time.Time) error
+	HoldsRolePermanently(ctx context.Context, kind domain.RoleKind, userID, roleID string, now time.Time) (bool, error)
+	ExpirePendingAccessRequests(ctx context.Context, now time.Time, newID func() string) ([]string, error)
+	ListAccessRequestDecisions(ctx context.Context, requestID string) ([]*domain.AccessRequestDecision, error)
+	SetAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string, approverIDs []string) error
+	ListAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error)
+}
+
+type accessRequestRepository struct {
+	accessRequestDAO dao.AccessRequestDAO
+}
+
+// NewAccessRequestRepository creates a new instance of AccessRequestRepository
+func NewAccessRequestRepository(accessRequestDAO dao.AccessRequestDAO) AccessRequestRepository {
+	return &accessRequestRepository{
+		accessRequestDAO: accessRequestDAO,
+	}
+}
+
+func (r *accessRequestRepository) CreateAccessRequest(ctx context.Context, request *domain.AccessRequest, submitted *domain.AccessRequestDecision) error {
+	return r.accessRequestDAO.Create(ctx, request, submitted)
+}
+
+func (r *accessRequestRepository) GetAccessRequestByID(ctx context.Context, id string) (*domain.AccessRequest, error) {
+	request, err := r.accessRequestDAO.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access request by ID: %w", err)
+	}
+	if request == nil {
+		return nil, fmt.Errorf("access request not found")
+	}
+	return request, nil
+}
+
+func (r *accessRequestRepository) ListAccessRequests(ctx context.Context, filter domain.AccessRequestFilter, page, pageSize int) ([]*domain.AccessRequest, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+	requests, err := r.accessRequestDAO.List(ctx, filter, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list access requests: %w", err)
+	}
+
+	total, err := r.accessRequestDAO.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count access requests: %w", err)
+	}
+
+	return requests, total, nil
+}
+
+func (r *accessRequestRepository) DecideAccessRequest(ctx context.Context, decision *domain.AccessRequestDecision) (bool, error) {
+	return r.accessRequestDAO.Decide(ctx, decision)
+}
+
+func (r *accessRequestRepository) ReopenAccessRequest(ctx context.Context, decision *domain.AccessRequestDecision, now time.Time) error {
+	return r.accessRequestDAO.Reopen(ctx, decision, now.UTC())
+}
+
+func (r *accessRequestRepository) HoldsRolePermanently(ctx context.Context, kind domain.RoleKind, userID, roleID string, now time.Time) (bool, error) {
+	return r.accessRequestDAO.HoldsRolePermanently(ctx, kind, userID, roleID, now.UTC())
+}
+
+func (r *accessRequestRepository) ExpirePendingAccessRequests(ctx context.Context, now time.Time, newID func() string) ([]string, error) {
+	return r.accessRequestDAO.ExpirePending(ctx, now.UTC(), newID)
+}
+
+func (r *accessRequestRepository) ListAccessRequestDecisions(ctx context.Context, requestID string) ([]*domain.AccessRequestDecision, error) {
+	return r.accessRequestDAO.ListDecisions(ctx, requestID)
+}
+
+func (r *accessRequestRepository) SetAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string, approverIDs []string) error {
+	return r.accessRequestDAO.SetApprovers(ctx, kind, roleID, approverIDs)
+}
+
+func (r *accessRequestRepository) ListAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error) {
+	return r.accessRequestDAO.ListApprovers(ctx, kind, roleID)
+}
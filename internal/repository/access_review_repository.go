@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/dao"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// AccessReviewRepository provides operations for access review campaigns and their items
+type AccessReviewRepository interface {
+	ListReviewableAssignments(ctx context.Context, scope domain.AccessReviewScope) ([]*domain.AccessReviewItem, error)
+	CreateAccessReviewCampaign(ctx context.Context, campaign *domain.AccessReviewCampaign, items []*domain.AccessReviewItem) error
+	GetAccessReviewCampaignByID(ctx context.Context, id string) (*domain.AccessReviewCampaign, error)
+	ListAccessReviewCampaigns(ctx context.Context, status domain.AccessReviewStatus, page, pageSize int) ([]*domain.AccessReviewCampaign, int, error)
+	CloseAccessReviewCampaign(ctx context.Context, id, closedBy string, closedAt time.Time) (bool, error)
+	GetAccessReviewItemByID(ctx context.Context, campaignID, itemID string) (*domain.AccessReviewItem, error)
+	ListAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, page, pageSize int) ([]*domain.AccessReviewItem, int, error)
+	ListAllAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) ([]*domain.AccessReviewItem, error)
+	DecideAccessReviewItem(ctx context.Context, item *domain.AccessReviewItem) (bool, error)
+	RecordAccessReviewRevocation(ctx context.Context, itemID string, revokedAt *time.Time, revokeError string) error
+	SummarizeAccessReviewCampaign(ctx context.Context, campaignID string) (*domain.AccessReviewSummary, error)
+}
+
+type accessReviewRepository struct {
+	accessReviewDAO dao.AccessReviewDAO
+}
+
+// NewAccessReviewRepository creates a new instance of AccessReviewRepository
+func NewAccessReviewRepository(accessReviewDAO dao.AccessReviewDAO) AccessReviewRepository {
+	return &accessReviewRepository{
+		accessReviewDAO: accessReviewDAO,
+	}
+}
+
+func (r *accessReviewRepository) ListReviewableAssignments(ctx context.Context, scope domain.AccessReviewScope) ([]*domain.AccessReviewItem, error) {
+	items, err := r.accessReviewDAO.ListAssignments(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	return items, nil
+}
+
+func (r *accessReviewRepository) CreateAccessReviewCampaign(ctx context.Context, campaign *domain.AccessReviewCampaign, items []*domain.AccessReviewItem) error {
+	return r.accessReviewDAO.Create(ctx, campaign, items)
+}
+
+func (r *accessReviewRepository) GetAccessReviewCampaignByID(ctx context.Context, id string) (*domain.AccessReviewCampaign, error) {
+	campaign, err := r.accessReviewDAO.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access review campaign by ID: %w", err)
+	}
+	if campaign == nil {
+		return nil, fmt.Errorf("access review campaign not found")
+	}
+	return campaign, nil
+}
+
+func (r *accessReviewRepository) ListAccessReviewCampaigns(ctx context.Context, status domain.AccessReviewStatus, page, pageSize int) ([]*domain.AccessReviewCampaign, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+	campaigns, err := r.accessReviewDAO.List(ctx, status, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list access review campaigns: %w", err)
+	}
+
+	total, err := r.accessReviewDAO.Count(ctx, status)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count access review campaigns: %w", err)
+	}
+
+	return campaigns, total, nil
+}
+
+func (r *accessReviewRepository) CloseAccessReviewCampaign(ctx context.Context, id, closedBy string, closedAt time.Time) (bool, error) {
+	return r.accessReviewDAO.Close(ctx, id, closedBy, closedAt.UTC())
+}
+
+func (r *accessReviewRepository) GetAccessReviewItemByID(ctx context.Context, campaignID, itemID string) (*domain.AccessReviewItem, error) {
+	item, err := r.accessReviewDAO.FindItemByID(ctx, campaignID, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access review item by ID: %w", err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("access review item not found")
+	}
+	return item, nil
+}
+
+func (r *accessReviewRepository) ListAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, page, pageSize int) ([]*domain.AccessReviewItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+	items, err := r.accessReviewDAO.ListItems(ctx, campaignID, filter, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list access review items: %w", err)
+	}
+
+	total, err := r.accessReviewDAO.CountItems(ctx, campaignID, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count access review items: %w", err)
+	}
+
+	return items, total, nil
+}
+
+func (r *accessReviewRepository) ListAllAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter) ([]*domain.AccessReviewItem, error) {
+	return r.accessReviewDAO.ListAllItems(ctx, campaignID, filter)
+}
+
+func (r *accessReviewRepository) DecideAccessReviewItem(ctx context.Context, item *domain.AccessReviewItem) (bool, error) {
+	return r.accessReviewDAO.Decide(ctx, item)
+}
+
+func (r *accessReviewRepository) RecordAccessReviewRevocation(ctx context.Context, itemID string, revokedAt *time.Time, revokeError string) error {
+	return r.accessReviewDAO.RecordRevocation(ctx, itemID, revokedAt, revokeError)
+}
+
+func (r *accessReviewRepository) SummarizeAccessReviewCampaign(ctx context.Context, campaignID string) (*domain.AccessReviewSummary, error) {
+	return r.accessReviewDAO.Summarize(ctx, campaignID)
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/tvttt/iam-services/internal/dao"
 	"github.com/tvttt/iam-services/internal/domain"
@@ -10,9 +12,12 @@ import (
 
 // AuthorizationRepository provides operations for authorization (user-role-permission relationships)
 type AuthorizationRepository interface {
-	AssignRoleToUser(ctx context.Context, userID, roleID string) error
+	AssignRoleToUser(ctx context.Context, userID, roleID string, window domain.AssignmentWindow) error
 	RemoveRoleFromUser(ctx context.Context, userID, roleID string) error
+	ListExpiredRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredRoleAssignmentTx(ctx context.Context, tx *sql.Tx, userID, roleID string, now time.Time) (bool, error)
 	GetUserRoles(ctx context.Context, userID string) ([]*domain.Role, error)
+	GetRoleUsers(ctx context.Context, roleID string) ([]*domain.User, error)
 	GetUserPermissions(ctx context.Context, userID string) ([]*domain.Permission, error)
 	UserHasPermission(ctx context.Context, userID, resource, action string) (bool, error)
 	AssignPermissionToRole(ctx context.Context, roleID, permissionID string) error
@@ -40,10 +45,13 @@ func NewAuthorizationRepository(
 	}
 }
 
-func (r *authorizationRepository) AssignRoleToUser(ctx context.Context, userID, roleID string) error {
+func (r *authorizationRepository) AssignRoleToUser(ctx context.Context, userID, roleID string, window domain.AssignmentWindow) error {
+	window = window.UTC()
 	userRole := &domain.UserRole{
-		UserID: userID,
-		RoleID: roleID,
+		UserID:    userID,
+		RoleID:    roleID,
+		StartsAt:  window.StartsAt,
+		ExpiresAt: window.ExpiresAt,
 	}
 	return r.userRoleDAO.AssignRole(ctx, userRole)
 }
@@ -52,10 +60,22 @@ func (r *authorizationRepository) RemoveRoleFromUser(ctx context.Context, userID
 	return r.userRoleDAO.RemoveRole(ctx, userID, roleID)
 }
 
+func (r *authorizationRepository) ListExpiredRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	return r.userRoleDAO.ListExpiredRoles(ctx, now.UTC())
+}
+
+func (r *authorizationRepository) RemoveExpiredRoleAssignmentTx(ctx context.Context, tx *sql.Tx, userID, roleID string, now time.Time) (bool, error) {
+	return r.userRoleDAO.RemoveExpiredRoleTx(ctx, tx, userID, roleID, now.UTC())
+}
+
 func (r *authorizationRepository) GetUserRoles(ctx context.Context, userID string) ([]*domain.Role, error) {
 	return r.userRoleDAO.GetUserRoles(ctx, userID)
 }
 
+func (r *authorizationRepository) GetRoleUsers(ctx context.Context, roleID string) ([]*domain.User, error) {
+	return r.userRoleDAO.GetRoleUsers(ctx, roleID)
+}
+
 func (r *authorizationRepository) GetUserPermissions(ctx context.Context, userID string) ([]*domain.Permission, error) {
 	// Get all roles for the user
 	roles, err := r.userRoleDAO.GetUserRoles(ctx, userID)
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/tvttt/iam-services/internal/dao"
 	"github.com/tvttt/iam-services/internal/domain"
@@ -15,24 +17,55 @@ type CMSRepository interface {
 	GetCMSRoleByName(ctx context.Context, name string) (*domain.CMSRole, error)
 	ListCMSRoles(ctx context.Context, page, pageSize int) ([]*domain.CMSRole, int, error)
 	UpdateCMSRole(ctx context.Context, role *domain.CMSRole) error
+	UpdateCMSRoleTx(ctx context.Context, tx *sql.Tx, role *domain.CMSRole) error
 	DeleteCMSRole(ctx context.Context, id string) error
+	DeleteCMSRoleTx(ctx context.Context, tx *sql.Tx, id string) error
 
-	AssignCMSRoleToUser(ctx context.Context, userID, cmsRoleID string) error
+	AssignCMSRoleToUser(ctx context.Context, userID, cmsRoleID string, window domain.AssignmentWindow) error
 	RemoveCMSRoleFromUser(ctx context.Context, userID, cmsRoleID string) error
+	ListExpiredCMSRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredCMSRoleAssignmentTx(ctx context.Context, tx *sql.Tx, userID, cmsRoleID string, now time.Time) (bool, error)
 	GetUserCMSRoles(ctx context.Context, userID string) ([]*domain.CMSRole, error)
 	GetUserCMSTabs(ctx context.Context, userID string) ([]domain.CMSTab, error)
+
+	CreateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) error
+	GetCMSTab(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error)
+	ListCMSTabs(ctx context.Context) ([]*domain.CMSTabDefinition, error)
+	UpdateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) error
+	DeleteCMSTab(ctx context.Context, key domain.CMSTab) error
+	CountRolesUsingCMSTab(ctx context.Context, key domain.CMSTab) (int, error)
+
+	ListCMSTabAPIs(ctx context.Context) ([]domain.CMSTabAPI, error)
+	ApplyCMSTabAPIChangesTx(ctx context.Context, tx *sql.Tx, add, remove []domain.CMSTabAPI) error
+
+	SetCMSFieldPermission(ctx context.Context, perm *domain.CMSFieldPermission) error
+	ListCMSFieldPermissions(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error)
+	GetCMSFieldPermissions(ctx context.Context, cmsRoleIDs []string, resourceType string) ([]*domain.CMSFieldPermission, error)
+	DeleteCMSFieldPermission(ctx context.Context, cmsRoleID, resourceType string) error
 }
 
 type cmsRepository struct {
 	cmsRoleDAO     dao.CMSRoleDAO
 	userCMSRoleDAO dao.UserCMSRoleDAO
+	cmsTabDAO      dao.CMSTabDAO
+	cmsTabAPIDAO   dao.CMSTabAPIDAO
+	fieldPermDAO   dao.CMSFieldPermissionDAO
 }
 
 // NewCMSRepository creates a new instance of CMSRepository
-func NewCMSRepository(cmsRoleDAO dao.CMSRoleDAO, userCMSRoleDAO dao.UserCMSRoleDAO) CMSRepository {
+func NewCMSRepository(
+	cmsRoleDAO dao.CMSRoleDAO,
+	userCMSRoleDAO dao.UserCMSRoleDAO,
+	cmsTabDAO dao.CMSTabDAO,
+	cmsTabAPIDAO dao.CMSTabAPIDAO,
+	fieldPermDAO dao.CMSFieldPermissionDAO,
+) CMSRepository {
 	return &cmsRepository{
 		cmsRoleDAO:     cmsRoleDAO,
 		userCMSRoleDAO: userCMSRoleDAO,
+		cmsTabDAO:      cmsTabDAO,
+		cmsTabAPIDAO:   cmsTabAPIDAO,
+		fieldPermDAO:   fieldPermDAO,
 	}
 }
 
@@ -97,14 +130,25 @@ func (r *cmsRepository) UpdateCMSRole(ctx context.Context, role *domain.CMSRole)
 	return r.cmsRoleDAO.Update(ctx, role)
 }
 
+func (r *cmsRepository) UpdateCMSRoleTx(ctx context.Context, tx *sql.Tx, role *domain.CMSRole) error {
+	return r.cmsRoleDAO.UpdateTx(ctx, tx, role)
+}
+
 func (r *cmsRepository) DeleteCMSRole(ctx context.Context, id string) error {
 	return r.cmsRoleDAO.Delete(ctx, id)
 }
 
-func (r *cmsRepository) AssignCMSRoleToUser(ctx context.Context, userID, cmsRoleID string) error {
+func (r *cmsRepository) DeleteCMSRoleTx(ctx context.Context, tx *sql.Tx, id string) error {
+	return r.cmsRoleDAO.DeleteTx(ctx, tx, id)
+}
+
+func (r *cmsRepository) AssignCMSRoleToUser(ctx context.Context, userID, cmsRoleID string, window domain.AssignmentWindow) error {
+	window = window.UTC()
 	userCMSRole := &domain.UserCMSRole{
 		UserID:    userID,
 		CMSRoleID: cmsRoleID,
+		StartsAt:  window.StartsAt,
+		ExpiresAt: window.ExpiresAt,
 	}
 	return r.userCMSRoleDAO.AssignCMSRole(ctx, userCMSRole)
 }
@@ -113,6 +157,14 @@ func (r *cmsRepository) RemoveCMSRoleFromUser(ctx context.Context, userID, cmsRo
 	return r.userCMSRoleDAO.RemoveCMSRole(ctx, userID, cmsRoleID)
 }
 
+func (r *cmsRepository) ListExpiredCMSRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	return r.userCMSRoleDAO.ListExpiredCMSRoles(ctx, now.UTC())
+}
+
+func (r *cmsRepository) RemoveExpiredCMSRoleAssignmentTx(ctx context.Context, tx *sql.Tx, userID, cmsRoleID string, now time.Time) (bool, error) {
+	return r.userCMSRoleDAO.RemoveExpiredCMSRoleTx(ctx, tx, userID, cmsRoleID, now.UTC())
+}
+
 func (r *cmsRepository) GetUserCMSRoles(ctx context.Context, userID string) ([]*domain.CMSRole, error) {
 	return r.userCMSRoleDAO.GetUserCMSRoles(ctx, userID)
 }
@@ -139,3 +191,120 @@ func (r *cmsRepository) GetUserCMSTabs(ctx context.Context, userID string) ([]do
 
 	return tabs, nil
 }
+
+func (r *cmsRepository) CreateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) error {
+	// Check if tab already exists
+	existingTab, err := r.cmsTabDAO.FindByKey(ctx, tab.Key)
+	if err != nil {
+		return fmt.Errorf("failed to check CMS tab existence: %w", err)
+	}
+	if existingTab != nil {
+		return fmt.Errorf("CMS tab '%s' already exists", tab.Key)
+	}
+
+	return r.cmsTabDAO.Create(ctx, tab)
+}
+
+func (r *cmsRepository) GetCMSTab(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error) {
+	tab, err := r.cmsTabDAO.FindByKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CMS tab: %w", err)
+	}
+	if tab == nil {
+		return nil, fmt.Errorf("CMS tab not found")
+	}
+	return tab, nil
+}
+
+func (r *cmsRepository) ListCMSTabs(ctx context.Context) ([]*domain.CMSTabDefinition, error) {
+	tabs, err := r.cmsTabDAO.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CMS tabs: %w", err)
+	}
+	return tabs, nil
+}
+
+func (r *cmsRepository) UpdateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) error {
+	return r.cmsTabDAO.Update(ctx, tab)
+}
+
+func (r *cmsRepository) DeleteCMSTab(ctx context.Context, key domain.CMSTab) error {
+	return r.cmsTabDAO.Delete(ctx, key)
+}
+
+func (r *cmsRepository) CountRolesUsingCMSTab(ctx context.Context, key domain.CMSTab) (int, error) {
+	count, err := r.cmsTabDAO.CountRolesUsingTab(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count CMS roles using tab: %w", err)
+	}
+	return count, nil
+}
+
+func (r *cmsRepository) ListCMSTabAPIs(ctx context.Context) ([]domain.CMSTabAPI, error) {
+	tabAPIs, err := r.cmsTabAPIDAO.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apis := make([]domain.CMSTabAPI, len(tabAPIs))
+	for i, tabAPI := range tabAPIs {
+		apis[i] = domain.CMSTabAPI{
+			Tab:         domain.CMSTab(tabAPI.TabName),
+			Path:        tabAPI.APIPath,
+			Method:      tabAPI.APIMethod,
+			Description: tabAPI.Description,
+		}
+	}
+	return apis, nil
+}
+
+// ApplyCMSTabAPIChangesTx removes and adds tab API mappings within tx
+func (r *cmsRepository) ApplyCMSTabAPIChangesTx(ctx context.Context, tx *sql.Tx, add, remove []domain.CMSTabAPI) error {
+	for _, api := range remove {
+		if err := r.cmsTabAPIDAO.DeleteByKeyTx(ctx, tx, string(api.Tab), api.Path, api.Method); err != nil {
+			return err
+		}
+	}
+	for _, api := range add {
+		tabAPI := &dao.CMSTabAPI{
+			TabName:     string(api.Tab),
+			APIPath:     api.Path,
+			APIMethod:   api.Method,
+			Description: api.Description,
+		}
+		if err := r.cmsTabAPIDAO.UpsertTx(ctx, tx, tabAPI); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *cmsRepository) SetCMSFieldPermission(ctx context.Context, perm *domain.CMSFieldPermission) error {
+	if err := r.fieldPermDAO.Upsert(ctx, perm); err != nil {
+		return fmt.Errorf("failed to save CMS field permission: %w", err)
+	}
+	return nil
+}
+
+func (r *cmsRepository) ListCMSFieldPermissions(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error) {
+	perms, err := r.fieldPermDAO.FindByRole(ctx, cmsRoleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CMS field permissions: %w", err)
+	}
+	return perms, nil
+}
+
+func (r *cmsRepository) GetCMSFieldPermissions(ctx context.Context, cmsRoleIDs []string, resourceType string) ([]*domain.CMSFieldPermission, error) {
+	if len(cmsRoleIDs) == 0 {
+		return nil, nil
+	}
+	perms, err := r.fieldPermDAO.FindByRolesAndResource(ctx, cmsRoleIDs, resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CMS field permissions: %w", err)
+	}
+	return perms, nil
+}
+
+func (r *cmsRepository) DeleteCMSFieldPermission(ctx context.Context, cmsRoleID, resourceType string) error {
+	return r.fieldPermDAO.Delete(ctx, cmsRoleID, resourceType)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tvttt/iam-services/internal/dao"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// RelationTupleRepository provides operations for relation tuples and namespace configs
+type RelationTupleRepository interface {
+	SaveRelationNamespace(ctx context.Context, namespace *domain.RelationNamespace) error
+	GetRelationNamespace(ctx context.Context, name string) (*domain.RelationNamespace, error)
+	ListRelationNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error)
+	DeleteRelationNamespace(ctx context.Context, name string) error
+	ListRelationsInUse(ctx context.Context, namespace string) ([]string, error)
+
+	WriteRelationTuples(ctx context.Context, writes, deletes []*domain.RelationTuple) error
+	// FindRelationTuplesByObjects returns the tuples of every relation on the given objects
+	FindRelationTuplesByObjects(ctx context.Context, namespace string, objectIDs []string) ([]*domain.RelationTuple, error)
+	ListRelationTuples(ctx context.Context, filter domain.RelationTupleFilter, page, pageSize int) ([]*domain.RelationTuple, int, error)
+	ListRelationObjectIDs(ctx context.Context, namespace string) ([]string, error)
+}
+
+type relationTupleRepository struct {
+	relationTupleDAO dao.RelationTupleDAO
+}
+
+// NewRelationTupleRepository creates a new instance of RelationTupleRepository
+func NewRelationTupleRepository(relationTupleDAO dao.RelationTupleDAO) RelationTupleRepository {
+	return &relationTupleRepository{
+		relationTupleDAO: relationTupleDAO,
+	}
+}
+
+func (r *relationTupleRepository) SaveRelationNamespace(ctx context.Context, namespace *domain.RelationNamespace) error {
+	return r.relationTupleDAO.SaveNamespace(ctx, namespace)
+}
+
+func (r *relationTupleRepository) GetRelationNamespace(ctx context.Context, name string) (*domain.RelationNamespace, error) {
+	namespace, err := r.relationTupleDAO.FindNamespace(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relation namespace: %w", err)
+	}
+	if namespace == nil {
+		return nil, fmt.Errorf("relation namespace %s not found", name)
+	}
+	return namespace, nil
+}
+
+func (r *relationTupleRepository) ListRelationNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error) {
+	return r.relationTupleDAO.ListNamespaces(ctx)
+}
+
+func (r *relationTupleRepository) DeleteRelationNamespace(ctx context.Context, name string) error {
+	return r.relationTupleDAO.DeleteNamespace(ctx, name)
+}
+
+func (r *relationTupleRepository) ListRelationsInUse(ctx context.Context, namespace string) ([]string, error) {
+	return r.relationTupleDAO.ListRelationsInUse(ctx, namespace)
+}
+
+func (r *relationTupleRepository) WriteRelationTuples(ctx context.Context, writes, deletes []*domain.RelationTuple) error {
+	return r.relationTupleDAO.Write(ctx, writes, deletes)
+}
+
+func (r *relationTupleRepository) FindRelationTuplesByObjects(ctx context.Context, namespace string, objectIDs []string) ([]*domain.RelationTuple, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+	return r.relationTupleDAO.FindByObjects(ctx, namespace, objectIDs)
+}
+
+func (r *relationTupleRepository) ListRelationTuples(ctx context.Context, filter domain.RelationTupleFilter, page, pageSize int) ([]*domain.RelationTuple, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+	tuples, err := r.relationTupleDAO.List(ctx, filter, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list relation tuples: %w", err)
+	}
+
+	total, err := r.relationTupleDAO.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count relation tuples: %w", err)
+	}
+
+	return tuples, total, nil
+}
+
+func (r *relationTupleRepository) ListRelationObjectIDs(ctx context.Context, namespace string) ([]string, error) {
+	return r.relationTupleDAO.ListObjectIDs(ctx, namespace)
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/dao"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// ResourceScopeRepository provides operations for resource scopes and scoped CMS role assignments
+type ResourceScopeRepository interface {
+	CreateResourceScope(ctx context.Context, scope *domain.ResourceScopeDefinition) error
+	GetResourceScope(ctx context.Context, scope domain.ResourceScope) (*domain.ResourceScopeDefinition, error)
+	ListResourceScopes(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error)
+	CountResourceScopeChildren(ctx context.Context, scope domain.ResourceScope) (int, error)
+	DeleteResourceScope(ctx context.Context, scope domain.ResourceScope) error
+
+	AssignScopedCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, window domain.AssignmentWindow) error
+	RemoveScopedCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error
+	ListUserScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error)
+	ListCMSRoleNamesInScope(ctx context.Context, userID string, scope domain.ResourceScope, now time.Time) ([]string, error)
+	ListExpiredScopedCMSRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error)
+	RemoveExpiredScopedCMSRoleAssignment(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, now time.Time) (bool, error)
+}
+
+type resourceScopeRepository struct {
+	resourceScopeDAO dao.ResourceScopeDAO
+}
+
+// NewResourceScopeRepository creates a new instance of ResourceScopeRepository
+func NewResourceScopeRepository(resourceScopeDAO dao.ResourceScopeDAO) ResourceScopeRepository {
+	return &resourceScopeRepository{
+		resourceScopeDAO: resourceScopeDAO,
+	}
+}
+
+func (r *resourceScopeRepository) CreateResourceScope(ctx context.Context, scope *domain.ResourceScopeDefinition) error {
+	existing, err := r.resourceScopeDAO.FindByKey(ctx, scope.ResourceScope)
+	if err != nil {
+		return fmt.Errorf("failed to check resource scope existence: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("resource scope %s already exists", scope.ResourceScope)
+	}
+	return r.resourceScopeDAO.Create(ctx, scope)
+}
+
+func (r *resourceScopeRepository) GetResourceScope(ctx context.Context, key domain.ResourceScope) (*domain.ResourceScopeDefinition, error) {
+	scope, err := r.resourceScopeDAO.FindByKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource scope: %w", err)
+	}
+	if scope == nil {
+		return nil, fmt.Errorf("resource scope %s not found", key)
+	}
+	return scope, nil
+}
+
+func (r *resourceScopeRepository) ListResourceScopes(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error) {
+	return r.resourceScopeDAO.List(ctx, scopeType)
+}
+
+func (r *resourceScopeRepository) CountResourceScopeChildren(ctx context.Context, scope domain.ResourceScope) (int, error) {
+	return r.resourceScopeDAO.CountChildren(ctx, scope)
+}
+
+func (r *resourceScopeRepository) DeleteResourceScope(ctx context.Context, scope domain.ResourceScope) error {
+	return r.resourceScopeDAO.Delete(ctx, scope)
+}
+
+func (r *resourceScopeRepository) AssignScopedCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, window domain.AssignmentWindow) error {
+	window = window.UTC()
+	assignment := &domain.ScopedCMSRoleAssignment{
+		UserID:    userID,
+		CMSRoleID: cmsRoleID,
+		Scope:     scope,
+		StartsAt:  window.StartsAt,
+		ExpiresAt: window.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	return r.resourceScopeDAO.AssignCMSRole(ctx, assignment)
+}
+
+func (r *resourceScopeRepository) RemoveScopedCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error {
+	return r.resourceScopeDAO.RemoveCMSRole(ctx, userID, cmsRoleID, scope)
+}
+
+func (r *resourceScopeRepository) ListUserScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error) {
+	return r.resourceScopeDAO.ListUserCMSRoles(ctx, userID)
+}
+
+func (r *resourceScopeRepository) ListCMSRoleNamesInScope(ctx context.Context, userID string, scope domain.ResourceScope, now time.Time) ([]string, error) {
+	return r.resourceScopeDAO.ListCMSRoleNamesInScope(ctx, userID, scope, now.UTC())
+}
+
+func (r *resourceScopeRepository) ListExpiredScopedCMSRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	return r.resourceScopeDAO.ListExpiredCMSRoles(ctx, now.UTC())
+}
+
+func (r *resourceScopeRepository) RemoveExpiredScopedCMSRoleAssignment(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, now time.Time) (bool, error) {
+	return r.resourceScopeDAO.RemoveExpiredCMSRole(ctx, userID, cmsRoleID, scope, now.UTC())
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/tvttt/iam-services/internal/dao"
@@ -16,7 +17,9 @@ type RoleRepository interface {
 	GetRoleWithPermissions(ctx context.Context, id string) (*domain.Role, error)
 	ListRoles(ctx context.Context, page, pageSize int) ([]*domain.Role, int, error)
 	UpdateRole(ctx context.Context, role *domain.Role) error
+	UpdateRoleTx(ctx context.Context, tx *sql.Tx, role *domain.Role) error
 	DeleteRole(ctx context.Context, id string) error
+	DeleteRoleTx(ctx context.Context, tx *sql.Tx, id string) error
 }
 
 type roleRepository struct {
@@ -115,6 +118,14 @@ func (r *roleRepository) UpdateRole(ctx context.Context, role *domain.Role) erro
 	return r.roleDAO.Update(ctx, role)
 }
 
+func (r *roleRepository) UpdateRoleTx(ctx context.Context, tx *sql.Tx, role *domain.Role) error {
+	return r.roleDAO.UpdateTx(ctx, tx, role)
+}
+
 func (r *roleRepository) DeleteRole(ctx context.Context, id string) error {
 	return r.roleDAO.Delete(ctx, id)
 }
+
+func (r *roleRepository) DeleteRoleTx(ctx context.Context, tx *sql.Tx, id string) error {
+	return r.roleDAO.DeleteTx(ctx, tx, id)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tvttt/iam-services/internal/dao"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// SoDConstraintRepository provides operations for separation-of-duties constraints
+type SoDConstraintRepository interface {
+	CreateSoDConstraint(ctx context.Context, constraint *domain.SoDConstraint) error
+	GetSoDConstraintByID(ctx context.Context, id string) (*domain.SoDConstraint, error)
+	ListSoDConstraints(ctx context.Context) ([]*domain.SoDConstraint, error)
+	DeleteSoDConstraint(ctx context.Context, id string) error
+}
+
+type sodConstraintRepository struct {
+	sodConstraintDAO dao.SoDConstraintDAO
+}
+
+// NewSoDConstraintRepository creates a new instance of SoDConstraintRepository
+func NewSoDConstraintRepository(sodConstraintDAO dao.SoDConstraintDAO) SoDConstraintRepository {
+	return &sodConstraintRepository{
+		sodConstraintDAO: sodConstraintDAO,
+	}
+}
+
+func (r *sodConstraintRepository) CreateSoDConstraint(ctx context.Context, constraint *domain.SoDConstraint) error {
+	return r.sodConstraintDAO.Create(ctx, constraint)
+}
+
+func (r *sodConstraintRepository) GetSoDConstraintByID(ctx context.Context, id string) (*domain.SoDConstraint, error) {
+	constraint, err := r.sodConstraintDAO.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SoD constraint by ID: %w", err)
+	}
+	if constraint == nil {
+		return nil, fmt.Errorf("SoD constraint not found")
+	}
+	return constraint, nil
+}
+
+func (r *sodConstraintRepository) ListSoDConstraints(ctx context.Context) ([]*domain.SoDConstraint, error) {
+	constraints, err := r.sodConstraintDAO.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SoD constraints: %w", err)
+	}
+	return constraints, nil
+}
+
+func (r *sodConstraintRepository) DeleteSoDConstraint(ctx context.Context, id string) error {
+	return r.sodConstraintDAO.Delete(ctx, id)
+}
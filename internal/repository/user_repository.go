@@ -12,6 +12,8 @@ import (
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *domain.User) error
 	GetUserByID(ctx context.Context, id string) (*domain.User, error)
+	// GetExistingUserIDs reports which of ids are users, e.g. to tell users from roles among Casbin subjects
+	GetExistingUserIDs(ctx context.Context, ids []string) (map[string]bool, error)
 	GetUserByUsername(ctx context.Context, username string) (*domain.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
@@ -54,6 +56,18 @@ func (r *userRepository) GetUserByID(ctx context.Context, id string) (*domain.Us
 	return user, nil
 }
 
+func (r *userRepository) GetExistingUserIDs(ctx context.Context, ids []string) (map[string]bool, error) {
+	existing, err := r.userDAO.FindExistingIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user ids: %w", err)
+	}
+	users := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		users[id] = true
+	}
+	return users, nil
+}
+
 func (r *userRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	user, err := r.userDAO.FindByUsername(ctx, username)
 	if err != nil {
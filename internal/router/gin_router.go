@@ -8,12 +8,14 @@ import (
 	"github.com/tvttt/iam-services/internal/config"
 	"github.com/tvttt/iam-services/internal/handler"
 	"github.com/tvttt/iam-services/internal/middleware"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
 )
 
 // SetupGinRouter sets up the Gin router with all routes
 func SetupGinRouter(
 	cfg *config.Config,
 	ginHandler *handler.GinHandler,
+	syncMetrics *casbinPkg.SyncMetrics,
 	logger *zap.Logger,
 ) *gin.Engine {
 	// Set Gin mode based on environment
@@ -34,6 +36,11 @@ func SetupGinRouter(
 	// Health check endpoint
 	r.GET("/health", ginHandler.Health)
 
+	// Policy sync metrics (only when the Casbin watcher is enabled)
+	if syncMetrics != nil {
+		r.GET("/metrics", gin.WrapH(syncMetrics))
+	}
+
 	// API v1 routes
 	v1 := r.Group("/v1")
 	{
@@ -57,12 +64,16 @@ func SetupGinRouter(
 			roles.DELETE("/:id", ginHandler.DeleteRole)
 			roles.POST("/assign", ginHandler.AssignRole)
 			roles.POST("/remove", ginHandler.RemoveRole)
+			roles.GET("/inheritance", ginHandler.GetRoleHierarchy)
+			roles.POST("/inheritance", ginHandler.AddRoleParent)
+			roles.POST("/inheritance/remove", ginHandler.RemoveRoleParent)
 		}
 
 		// User roles routes
 		users := v1.Group("/users")
 		{
 			users.GET("/:user_id/roles", ginHandler.GetUserRoles)
+			users.GET("/:user_id/effective-permissions", ginHandler.GetEffectivePermissions)
 		}
 
 		// Permission management routes
@@ -78,13 +89,73 @@ func SetupGinRouter(
 		access := v1.Group("/access")
 		{
 			access.POST("/api", ginHandler.CheckAPIAccess)
+			access.POST("/api/batch", ginHandler.BatchCheckAPIAccess)
 			access.POST("/cms", ginHandler.CheckCMSAccess)
+			access.GET("/api/holders", ginHandler.ListAPIAccessHolders)
+			access.GET("/cms/holders", ginHandler.ListCMSAccessHolders)
+		}
+
+		// Access request routes
+		accessRequests := v1.Group("/access-requests")
+		{
+			accessRequests.POST("", ginHandler.CreateAccessRequest)
+			accessRequests.GET("", ginHandler.ListAccessRequests)
+			accessRequests.GET("/approvers", ginHandler.ListAccessRequestApprovers)
+			accessRequests.PUT("/approvers", ginHandler.SetAccessRequestApprovers)
+			accessRequests.GET("/:id", ginHandler.GetAccessRequest)
+			accessRequests.POST("/:id/approve", ginHandler.ApproveAccessRequest)
+			accessRequests.POST("/:id/deny", ginHandler.DenyAccessRequest)
+		}
+
+		// Separation-of-duties routes
+		sod := v1.Group("/sod")
+		{
+			sod.POST("/constraints", ginHandler.CreateSoDConstraint)
+			sod.GET("/constraints", ginHandler.ListSoDConstraints)
+			sod.GET("/constraints/:id", ginHandler.GetSoDConstraint)
+			sod.DELETE("/constraints/:id", ginHandler.DeleteSoDConstraint)
+			sod.GET("/violations", ginHandler.ListSoDViolations)
+		}
+
+		// Access review routes
+		accessReviews := v1.Group("/access-reviews")
+		{
+			accessReviews.POST("", ginHandler.CreateAccessReviewCampaign)
+			accessReviews.GET("", ginHandler.ListAccessReviewCampaigns)
+			accessReviews.GET("/:id", ginHandler.GetAccessReviewCampaign)
+			accessReviews.GET("/:id/items", ginHandler.ListAccessReviewItems)
+			accessReviews.POST("/:id/items/:item_id/decision", ginHandler.DecideAccessReviewItem)
+			accessReviews.POST("/:id/close", ginHandler.CloseAccessReviewCampaign)
+			accessReviews.GET("/:id/report", ginHandler.ExportAccessReviewReport)
+		}
+
+		// Relation tuple routes
+		relations := v1.Group("/relations")
+		{
+			relations.GET("/namespaces", ginHandler.ListRelationNamespaces)
+			relations.PUT("/namespaces/:name", ginHandler.PutRelationNamespace)
+			relations.DELETE("/namespaces/:name", ginHandler.DeleteRelationNamespace)
+			relations.POST("/tuples", ginHandler.WriteRelationTuples)
+			relations.GET("/tuples", ginHandler.ReadRelationTuples)
+			relations.POST("/check", ginHandler.CheckRelation)
+			relations.GET("/expand", ginHandler.ExpandRelation)
+			relations.GET("/objects", ginHandler.ListRelationObjects)
 		}
 
 		// Policy routes
 		policies := v1.Group("/policies")
 		{
+			policies.POST("", ginHandler.CreatePolicy)
+			policies.GET("", ginHandler.ListPolicies)
+			policies.DELETE("", ginHandler.DeletePolicy)
+			policies.GET("/export", ginHandler.ExportPolicies)
+			policies.POST("/import", ginHandler.ImportPolicies)
+			policies.POST("/simulate", ginHandler.SimulatePolicyChanges)
 			policies.POST("/enforce", ginHandler.EnforcePolicy)
+			policies.POST("/enforce/batch", ginHandler.BatchEnforcePolicy)
+			policies.GET("/conditions", ginHandler.ListPolicyConditions)
+			policies.PUT("/conditions", ginHandler.SetPolicyCondition)
+			policies.DELETE("/conditions", ginHandler.DeletePolicyCondition)
 		}
 
 		// CMS routes
@@ -94,13 +165,38 @@ func SetupGinRouter(
 			{
 				cmsRoles.POST("", ginHandler.CreateCMSRole)
 				cmsRoles.GET("", ginHandler.ListCMSRoles)
+				cmsRoles.GET("/:id", ginHandler.GetCMSRole)
+				cmsRoles.PUT("/:id", ginHandler.UpdateCMSRole)
+				cmsRoles.DELETE("/:id", ginHandler.DeleteCMSRole)
+				cmsRoles.GET("/:id/fields", ginHandler.ListCMSRoleFieldPermissions)
+				cmsRoles.PUT("/:id/fields/:resource_type", ginHandler.SetCMSRoleFieldPermission)
+				cmsRoles.DELETE("/:id/fields/:resource_type", ginHandler.DeleteCMSRoleFieldPermission)
 				cmsRoles.POST("/assign", ginHandler.AssignCMSRole)
 				cmsRoles.POST("/remove", ginHandler.RemoveCMSRole)
 			}
 
+			cmsTabs := cms.Group("/tabs")
+			{
+				cmsTabs.POST("", ginHandler.CreateCMSTab)
+				cmsTabs.GET("", ginHandler.ListCMSTabs)
+				cmsTabs.GET("/:key", ginHandler.GetCMSTab)
+				cmsTabs.PUT("/:key", ginHandler.UpdateCMSTab)
+				cmsTabs.DELETE("/:key", ginHandler.DeleteCMSTab)
+			}
+
+			cmsScopes := cms.Group("/scopes")
+			{
+				cmsScopes.POST("", ginHandler.CreateResourceScope)
+				cmsScopes.GET("", ginHandler.ListResourceScopes)
+				cmsScopes.DELETE("/:type/:id", ginHandler.DeleteResourceScope)
+			}
+
 			cmsUsers := cms.Group("/users")
 			{
 				cmsUsers.GET("/:user_id/tabs", ginHandler.GetUserCMSTabs)
+				cmsUsers.GET("/:user_id/capabilities", ginHandler.GetCMSCapabilities)
+				cmsUsers.GET("/:user_id/fields/:resource_type", ginHandler.GetUserFieldPermissions)
+				cmsUsers.GET("/:user_id/scoped-roles", ginHandler.ListUserScopedCMSRoles)
 			}
 		}
 
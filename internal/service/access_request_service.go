@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// AccessRequestService handles requests for roles and their approval
+type AccessRequestService interface {
+	CreateAccessRequest(ctx context.Context, requesterID string, kind domain.RoleKind, roleID, justification string, duration time.Duration) (*domain.AccessRequest, error)
+	GetAccessRequest(ctx context.Context, requestID string) (*domain.AccessRequest, []*domain.AccessRequestDecision, error)
+	ListAccessRequests(ctx context.Context, filter domain.AccessRequestFilter, page, pageSize int) ([]*domain.AccessRequest, int, error)
+	ApproveAccessRequest(ctx context.Context, requestID, approverID, comment string) (*domain.AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, requestID, approverID, comment string) (*domain.AccessRequest, error)
+
+	// Approvers. Only holders of the default approver role may change them.
+	SetAccessRequestApprovers(ctx context.Context, actorID string, kind domain.RoleKind, roleID string, approverIDs []string) error
+	ListAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error)
+
+	// ExpireAccessRequests expires the pending requests nobody decided in time
+	ExpireAccessRequests(ctx context.Context) (int, error)
+	// Run expires pending requests every interval until ctx is done
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type accessRequestService struct {
+	accessRequestRepo   repository.AccessRequestRepository
+	userRepo            repository.UserRepository
+	roleRepo            repository.RoleRepository
+	cmsRepo             repository.CMSRepository
+	authzService        AuthorizationService
+	casbinService       CasbinService
+	enforcer            *casbinPkg.Enforcer
+	pendingTTL          time.Duration
+	defaultApproverRole string
+	logger              *zap.Logger
+}
+
+// NewAccessRequestService creates a new instance of AccessRequestService.
+// Pending requests expire after pendingTTL. Requests for roles without approvers are decided by
+// the users holding defaultApproverRole.
+func NewAccessRequestService(
+	accessRequestRepo repository.AccessRequestRepository,
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	cmsRepo repository.CMSRepository,
+	authzService AuthorizationService,
+	casbinService CasbinService,
+	enforcer *casbinPkg.Enforcer,
+	pendingTTL time.Duration,
+	defaultApproverRole string,
+	logger *zap.Logger,
+) AccessRequestService {
+	return &accessRequestService{
+		accessRequestRepo:   accessRequestRepo,
+		userRepo:            userRepo,
+		roleRepo:            roleRepo,
+		cmsRepo:             cmsRepo,
+		authzService:        authzService,
+		casbinService:       casbinService,
+		enforcer:            enforcer,
+		pendingTTL:          pendingTTL,
+		defaultApproverRole: defaultApproverRole,
+		logger:              logger,
+	}
+}
+
+func (s *accessRequestService) CreateAccessRequest(ctx context.Context, requesterID string, kind domain.RoleKind, roleID, justification string, duration time.Duration) (*domain.AccessRequest, error) {
+	justification = strings.TrimSpace(justification)
+	if requesterID == "" || roleID == "" {
+		return nil, fmt.Errorf("requester ID and role ID are required")
+	}
+	if justification == "" {
+		return nil, fmt.Errorf("justification is required")
+	}
+	if duration < 0 {
+		return nil, fmt.Errorf("duration must not be negative")
+	}
+	if _, err := s.userRepo.GetUserByID(ctx, requesterID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	roleName, err := s.roleName(ctx, kind, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pending, err := s.accessRequestRepo.ListAccessRequests(ctx, domain.AccessRequestFilter{
+		Status:      domain.AccessRequestPending,
+		RequesterID: requesterID,
+		RoleKind:    kind,
+		RoleID:      roleID,
+	}, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, fmt.Errorf("a request for role %s is already pending", roleName)
+	}
+
+	now := time.Now().UTC()
+	request := &domain.AccessRequest{
+		ID:            uuid.New().String(),
+		RequesterID:   requesterID,
+		RoleKind:      kind,
+		RoleID:        roleID,
+		RoleName:      roleName,
+		Justification: justification,
+		Duration:      duration.Truncate(time.Second),
+		Status:        domain.AccessRequestPending,
+		ExpiresAt:     now.Add(s.pendingTTL),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	submitted := &domain.AccessRequestDecision{
+		ID:        uuid.New().String(),
+		RequestID: request.ID,
+		ActorID:   requesterID,
+		Status:    domain.AccessRequestPending,
+		Comment:   justification,
+		CreatedAt: now,
+	}
+	if err := s.accessRequestRepo.CreateAccessRequest(ctx, request, submitted); err != nil {
+		return nil, fmt.Errorf("failed to create access request: %w", err)
+	}
+	return request, nil
+}
+
+func (s *accessRequestService) GetAccessRequest(ctx context.Context, requestID string) (*domain.AccessRequest, []*domain.AccessRequestDecision, error) {
+	request, err := s.accessRequestRepo.GetAccessRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	decisions, err := s.accessRequestRepo.ListAccessRequestDecisions(ctx, requestID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list access request decisions: %w", err)
+	}
+	return request, decisions, nil
+}
+
+func (s *accessRequestService) ListAccessRequests(ctx context.Context, filter domain.AccessRequestFilter, page, pageSize int) ([]*domain.AccessRequest, int, error) {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, 0, fmt.Errorf("invalid status: %s", filter.Status)
+	}
+	if filter.RoleKind != "" && !filter.RoleKind.IsValid() {
+		return nil, 0, fmt.Errorf("invalid role kind: %s", filter.RoleKind)
+	}
+	return s.accessRequestRepo.ListAccessRequests(ctx, filter, page, pageSize)
+}
+
+// ApproveAccessRequest records the approval and then assigns the requested role, for the
+// requested duration if any. Recording first makes concurrent decisions race on the request
+// rather than on the assignment; an assignment that fails puts the request back to pending.
+func (s *accessRequestService) ApproveAccessRequest(ctx context.Context, requestID, approverID, comment string) (*domain.AccessRequest, error) {
+	request, err := s.pendingRequest(ctx, requestID, approverID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	decision := newAccessRequestDecision(request.ID, approverID, domain.AccessRequestApproved, comment, now)
+	if err := s.decide(ctx, decision); err != nil {
+		return nil, err
+	}
+	if err := s.assignRequestedRole(ctx, request, now); err != nil {
+		if reopenErr := s.accessRequestRepo.ReopenAccessRequest(ctx, decision, time.Now()); reopenErr != nil {
+			s.logger.Error("Failed to reopen access request",
+				zap.String("request_id", request.ID),
+				zap.Error(reopenErr),
+			)
+		}
+		return nil, fmt.Errorf("failed to assign requested role: %w", err)
+	}
+	return s.accessRequestRepo.GetAccessRequestByID(ctx, request.ID)
+}
+
+func (s *accessRequestService) DenyAccessRequest(ctx context.Context, requestID, approverID, comment string) (*domain.AccessRequest, error) {
+	request, err := s.pendingRequest(ctx, requestID, approverID)
+	if err != nil {
+		return nil, err
+	}
+	decision := newAccessRequestDecision(request.ID, approverID, domain.AccessRequestDenied, comment, time.Now().UTC())
+	if err := s.decide(ctx, decision); err != nil {
+		return nil, err
+	}
+	return s.accessRequestRepo.GetAccessRequestByID(ctx, request.ID)
+}
+
+func (s *accessRequestService) SetAccessRequestApprovers(ctx context.Context, actorID string, kind domain.RoleKind, roleID string, approverIDs []string) error {
+	admin, err := s.holdsDefaultApproverRole(actorID)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return domain.ErrNotAccessRequestAdmin
+	}
+	if _, err := s.roleName(ctx, kind, roleID); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(approverIDs))
+	unique := make([]string, 0, len(approverIDs))
+	for _, approverID := range approverIDs {
+		if approverID == "" || seen[approverID] {
+			continue
+		}
+		if _, err := s.userRepo.GetUserByID(ctx, approverID); err != nil {
+			return fmt.Errorf("approver %s not found: %w", approverID, err)
+		}
+		seen[approverID] = true
+		unique = append(unique, approverID)
+	}
+
+	if err := s.accessRequestRepo.SetAccessRequestApprovers(ctx, kind, roleID, unique); err != nil {
+		return fmt.Errorf("failed to set access request approvers: %w", err)
+	}
+	return nil
+}
+
+func (s *accessRequestService) ListAccessRequestApprovers(ctx context.Context, kind domain.RoleKind, roleID string) ([]string, error) {
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("invalid role kind: %s", kind)
+	}
+	approverIDs, err := s.accessRequestRepo.ListAccessRequestApprovers(ctx, kind, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access request approvers: %w", err)
+	}
+	return approverIDs, nil
+}
+
+func (s *accessRequestService) ExpireAccessRequests(ctx context.Context) (int, error) {
+	ids, err := s.accessRequestRepo.ExpirePendingAccessRequests(ctx, time.Now(), func() string { return uuid.New().String() })
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire access requests: %w", err)
+	}
+	if len(ids) > 0 {
+		s.logger.Info("Access requests expired", zap.Strings("request_ids", ids))
+	}
+	return len(ids), nil
+}
+
+func (s *accessRequestService) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		s.logger.Info("Access request expiry disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ExpireAccessRequests(ctx); err != nil {
+			s.logger.Error("Failed to expire access requests", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pendingRequest returns a request the approver may still decide
+func (s *accessRequestService) pendingRequest(ctx context.Context, requestID, approverID string) (*domain.AccessRequest, error) {
+	if requestID == "" || approverID == "" {
+		return nil, fmt.Errorf("request ID and approver ID are required")
+	}
+
+	request, err := s.accessRequestRepo.GetAccessRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != domain.AccessRequestPending {
+		return nil, fmt.Errorf("%w: it is %s", domain.ErrAccessRequestNotPending, request.Status)
+	}
+	// The expiry job may not have run yet
+	if !time.Now().Before(request.ExpiresAt) {
+		return nil, fmt.Errorf("%w: it expired at %s", domain.ErrAccessRequestNotPending, request.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if approverID == request.RequesterID {
+		return nil, fmt.Errorf("%w: requesters cannot decide their own requests", domain.ErrNotAccessRequestApprover)
+	}
+	allowed, err := s.isApprover(ctx, request, approverID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, domain.ErrNotAccessRequestApprover
+	}
+	return request, nil
+}
+
+// isApprover reports whether a user may decide requests for the request's role: one of the role's
+// approvers or, when it has none, a holder of the default approver role
+func (s *accessRequestService) isApprover(ctx context.Context, request *domain.AccessRequest, userID string) (bool, error) {
+	approverIDs, err := s.accessRequestRepo.ListAccessRequestApprovers(ctx, request.RoleKind, request.RoleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list access request approvers: %w", err)
+	}
+	if len(approverIDs) > 0 {
+		for _, approverID := range approverIDs {
+			if approverID == userID {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	return s.holdsDefaultApproverRole(userID)
+}
+
+// holdsDefaultApproverRole reports whether a user holds the default approver role
+func (s *accessRequestService) holdsDefaultApproverRole(userID string) (bool, error) {
+	return holdsUserRole(s.enforcer, userID, s.defaultApproverRole)
+}
+
+// holdsUserRole reports whether a user holds a role in the user domain, directly or through
+// inheritance. Nobody holds an empty role.
+func holdsUserRole(enforcer *casbinPkg.Enforcer, userID, role string) (bool, error) {
+	if role == "" || userID == "" {
+		return false, nil
+	}
+	roles, err := enforcer.GetRoleAncestors(userID, string(domain.DomainUser))
+	if err != nil {
+		return false, fmt.Errorf("failed to get roles of user %s: %w", userID, err)
+	}
+	for _, held := range roles {
+		if held == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// assignRequestedRole assigns an approved request's role. A requester already holding the role
+// without an expiry keeps that assignment rather than having it replaced by the requested window.
+func (s *accessRequestService) assignRequestedRole(ctx context.Context, request *domain.AccessRequest, now time.Time) error {
+	held, err := s.accessRequestRepo.HoldsRolePermanently(ctx, request.RoleKind, request.RequesterID, request.RoleID, now)
+	if err != nil {
+		return fmt.Errorf("failed to check existing assignment: %w", err)
+	}
+	if held {
+		s.logger.Info("Requester already holds the role permanently",
+			zap.String("request_id", request.ID),
+			zap.String("role_id", request.RoleID),
+		)
+		return nil
+	}
+
+	var window domain.AssignmentWindow
+	if request.Duration > 0 {
+		expiresAt := now.Add(request.Duration)
+		window.ExpiresAt = &expiresAt
+	}
+	switch request.RoleKind {
+	case domain.RoleKindRole:
+		return s.authzService.AssignRole(ctx, request.RequesterID, request.RoleID, window)
+	case domain.RoleKindCMSRole:
+		return s.casbinService.AssignCMSRole(ctx, request.RequesterID, request.RoleID, domain.ResourceScope{}, window)
+	}
+	return fmt.Errorf("invalid role kind: %s", request.RoleKind)
+}
+
+func newAccessRequestDecision(requestID, approverID string, status domain.AccessRequestStatus, comment string, now time.Time) *domain.AccessRequestDecision {
+	return &domain.AccessRequestDecision{
+		ID:        uuid.New().String(),
+		RequestID: requestID,
+		ActorID:   approverID,
+		Status:    status,
+		Comment:   strings.TrimSpace(comment),
+		CreatedAt: now,
+	}
+}
+
+// decide moves a pending request to the decision's status and records the decision
+func (s *accessRequestService) decide(ctx context.Context, decision *domain.AccessRequestDecision) error {
+	decided, err := s.accessRequestRepo.DecideAccessRequest(ctx, decision)
+	if err != nil {
+		return fmt.Errorf("failed to record access request decision: %w", err)
+	}
+	if !decided {
+		return fmt.Errorf("%w: it was decided concurrently", domain.ErrAccessRequestNotPending)
+	}
+	return nil
+}
+
+// roleName returns the name of the requested role, checking that it exists
+func (s *accessRequestService) roleName(ctx context.Context, kind domain.RoleKind, roleID string) (string, error) {
+	switch kind {
+	case domain.RoleKindRole:
+		role, err := s.roleRepo.GetRoleByID(ctx, roleID)
+		if err != nil {
+			return "", fmt.Errorf("role not found: %w", err)
+		}
+		return role.Name, nil
+	case domain.RoleKindCMSRole:
+		role, err := s.cmsRepo.GetCMSRoleByID(ctx, roleID)
+		if err != nil {
+			return "", fmt.Errorf("CMS role not found: %w", err)
+		}
+		return role.Name, nil
+	}
+	return "", fmt.Errorf("invalid role kind %q: expected role or cms_role", kind)
+}
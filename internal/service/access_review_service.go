@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// AccessReviewService runs access review campaigns: reviewers certify or revoke a snapshot of role
+// assignments, and the revocations are applied when the campaign closes
+type AccessReviewService interface {
+	// CreateAccessReviewCampaign snapshots the assignments in scope. Each user's assignments go to
+	// the reviewer whose team lists the user, otherwise to the default reviewer. Only holders of
+	// the manager role may create campaigns.
+	CreateAccessReviewCampaign(ctx context.Context, name, description string, scope domain.AccessReviewScope, reviewers []domain.AccessReviewer, defaultReviewerID string, dueAt *time.Time, createdBy string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error)
+	GetAccessReviewCampaign(ctx context.Context, id string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error)
+	ListAccessReviewCampaigns(ctx context.Context, status domain.AccessReviewStatus, page, pageSize int) ([]*domain.AccessReviewCampaign, int, error)
+	ListAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, page, pageSize int) ([]*domain.AccessReviewItem, int, error)
+	DecideAccessReviewItem(ctx context.Context, campaignID, itemID, reviewerID string, decision domain.AccessReviewDecision, comment string) (*domain.AccessReviewItem, error)
+
+	// CloseAccessReviewCampaign stops collecting decisions and removes the assignments reviewers
+	// revoked. A revocation that fails is recorded on its item and does not stop the others.
+	// Only holders of the manager role may close campaigns.
+	CloseAccessReviewCampaign(ctx context.Context, id, closedBy string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error)
+	ExportAccessReviewReport(ctx context.Context, id string, format domain.AccessReviewReportFormat) ([]byte, error)
+}
+
+type accessReviewService struct {
+	accessReviewRepo repository.AccessReviewRepository
+	userRepo         repository.UserRepository
+	authzService     AuthorizationService
+	casbinService    CasbinService
+	enforcer         *casbinPkg.Enforcer
+	managerRole      string
+	logger           *zap.Logger
+}
+
+// NewAccessReviewService creates a new instance of AccessReviewService.
+// Campaigns are created and closed by the users holding managerRole.
+func NewAccessReviewService(
+	accessReviewRepo repository.AccessReviewRepository,
+	userRepo repository.UserRepository,
+	authzService AuthorizationService,
+	casbinService CasbinService,
+	enforcer *casbinPkg.Enforcer,
+	managerRole string,
+	logger *zap.Logger,
+) AccessReviewService {
+	return &accessReviewService{
+		accessReviewRepo: accessReviewRepo,
+		userRepo:         userRepo,
+		authzService:     authzService,
+		casbinService:    casbinService,
+		enforcer:         enforcer,
+		managerRole:      managerRole,
+		logger:           logger,
+	}
+}
+
+func (s *accessReviewService) CreateAccessReviewCampaign(ctx context.Context, name, description string, scope domain.AccessReviewScope, reviewers []domain.AccessReviewer, defaultReviewerID string, dueAt *time.Time, createdBy string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil, fmt.Errorf("name is required")
+	}
+	if createdBy == "" {
+		return nil, nil, fmt.Errorf("creator ID is required")
+	}
+	if err := s.checkManager(createdBy); err != nil {
+		return nil, nil, err
+	}
+	for _, kind := range scope.RoleKinds {
+		if !kind.IsValid() {
+			return nil, nil, fmt.Errorf("invalid role kind %q: expected role or cms_role", kind)
+		}
+	}
+	now := time.Now().UTC()
+	if dueAt != nil {
+		if !dueAt.After(now) {
+			return nil, nil, fmt.Errorf("due date must be in the future")
+		}
+		due := dueAt.UTC()
+		dueAt = &due
+	}
+
+	// The first team listing a user wins
+	reviewerOf := make(map[string]string)
+	checked := make(map[string]bool)
+	for _, reviewer := range append(reviewers, domain.AccessReviewer{ReviewerID: defaultReviewerID}) {
+		if reviewer.ReviewerID == "" || checked[reviewer.ReviewerID] {
+			continue
+		}
+		if _, err := s.userRepo.GetUserByID(ctx, reviewer.ReviewerID); err != nil {
+			return nil, nil, fmt.Errorf("reviewer %s not found: %w", reviewer.ReviewerID, err)
+		}
+		checked[reviewer.ReviewerID] = true
+	}
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewerID == "" {
+			return nil, nil, fmt.Errorf("reviewer ID is required")
+		}
+		for _, userID := range reviewer.UserIDs {
+			if _, ok := reviewerOf[userID]; !ok {
+				reviewerOf[userID] = reviewer.ReviewerID
+			}
+		}
+	}
+
+	assignments, err := s.accessReviewRepo.ListReviewableAssignments(ctx, scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(assignments) == 0 {
+		return nil, nil, fmt.Errorf("no assignments in scope to review")
+	}
+
+	campaign := &domain.AccessReviewCampaign{
+		ID:                uuid.New().String(),
+		Name:              name,
+		Description:       description,
+		Scope:             scope,
+		DefaultReviewerID: defaultReviewerID,
+		Status:            domain.AccessReviewOpen,
+		DueAt:             dueAt,
+		CreatedBy:         createdBy,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	for _, item := range assignments {
+		reviewerID := reviewerOf[item.UserID]
+		// Nobody certifies their own access
+		if reviewerID == "" || reviewerID == item.UserID {
+			reviewerID = defaultReviewerID
+		}
+		if reviewerID == "" || reviewerID == item.UserID {
+			return nil, nil, fmt.Errorf("no reviewer for the assignments of user %s", item.UserID)
+		}
+		item.ID = uuid.New().String()
+		item.CampaignID = campaign.ID
+		item.ReviewerID = reviewerID
+		item.Decision = domain.AccessReviewPending
+	}
+
+	if err := s.accessReviewRepo.CreateAccessReviewCampaign(ctx, campaign, assignments); err != nil {
+		return nil, nil, fmt.Errorf("failed to create access review campaign: %w", err)
+	}
+	return campaign, &domain.AccessReviewSummary{Total: len(assignments), Pending: len(assignments)}, nil
+}
+
+func (s *accessReviewService) GetAccessReviewCampaign(ctx context.Context, id string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error) {
+	campaign, err := s.accessReviewRepo.GetAccessReviewCampaignByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	summary, err := s.accessReviewRepo.SummarizeAccessReviewCampaign(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to summarize access review campaign: %w", err)
+	}
+	return campaign, summary, nil
+}
+
+func (s *accessReviewService) ListAccessReviewCampaigns(ctx context.Context, status domain.AccessReviewStatus, page, pageSize int) ([]*domain.AccessReviewCampaign, int, error) {
+	if status != "" && status != domain.AccessReviewOpen && status != domain.AccessReviewClosed {
+		return nil, 0, fmt.Errorf("invalid status: %s", status)
+	}
+	return s.accessReviewRepo.ListAccessReviewCampaigns(ctx, status, page, pageSize)
+}
+
+func (s *accessReviewService) ListAccessReviewItems(ctx context.Context, campaignID string, filter domain.AccessReviewItemFilter, page, pageSize int) ([]*domain.AccessReviewItem, int, error) {
+	if filter.Decision != "" && !filter.Decision.IsValid() {
+		return nil, 0, fmt.Errorf("invalid decision: %s", filter.Decision)
+	}
+	if _, err := s.accessReviewRepo.GetAccessReviewCampaignByID(ctx, campaignID); err != nil {
+		return nil, 0, err
+	}
+	return s.accessReviewRepo.ListAccessReviewItems(ctx, campaignID, filter, page, pageSize)
+}
+
+// DecideAccessReviewItem records a reviewer's decision. Decisions can be changed until the
+// campaign closes.
+func (s *accessReviewService) DecideAccessReviewItem(ctx context.Context, campaignID, itemID, reviewerID string, decision domain.AccessReviewDecision, comment string) (*domain.AccessReviewItem, error) {
+	if reviewerID == "" {
+		return nil, fmt.Errorf("reviewer ID is required")
+	}
+	if decision != domain.AccessReviewKeep && decision != domain.AccessReviewRevoke {
+		return nil, fmt.Errorf("invalid decision %q: expected keep or revoke", decision)
+	}
+
+	campaign, err := s.accessReviewRepo.GetAccessReviewCampaignByID(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status != domain.AccessReviewOpen {
+		return nil, domain.ErrAccessReviewClosed
+	}
+	item, err := s.accessReviewRepo.GetAccessReviewItemByID(ctx, campaignID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.ReviewerID != reviewerID {
+		return nil, domain.ErrNotAccessReviewer
+	}
+
+	now := time.Now().UTC()
+	item.Decision = decision
+	item.Comment = strings.TrimSpace(comment)
+	item.DecidedAt = &now
+	decided, err := s.accessReviewRepo.DecideAccessReviewItem(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record access review decision: %w", err)
+	}
+	if !decided {
+		return nil, fmt.Errorf("%w: it was closed concurrently", domain.ErrAccessReviewClosed)
+	}
+	return item, nil
+}
+
+func (s *accessReviewService) CloseAccessReviewCampaign(ctx context.Context, id, closedBy string) (*domain.AccessReviewCampaign, *domain.AccessReviewSummary, error) {
+	if closedBy == "" {
+		return nil, nil, fmt.Errorf("closer ID is required")
+	}
+	if err := s.checkManager(closedBy); err != nil {
+		return nil, nil, err
+	}
+	if _, err := s.accessReviewRepo.GetAccessReviewCampaignByID(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	// Closing first freezes the decisions the revocations are applied from
+	closed, err := s.accessReviewRepo.CloseAccessReviewCampaign(ctx, id, closedBy, time.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to close access review campaign: %w", err)
+	}
+	if !closed {
+		return nil, nil, domain.ErrAccessReviewClosed
+	}
+
+	revoked, err := s.accessReviewRepo.ListAllAccessReviewItems(ctx, id, domain.AccessReviewItemFilter{Decision: domain.AccessReviewRevoke})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list revoked assignments: %w", err)
+	}
+	for _, item := range revoked {
+		var revokedAt *time.Time
+		revokeError := ""
+		if err := s.revoke(ctx, item); err != nil {
+			revokeError = err.Error()
+			s.logger.Error("Failed to revoke reviewed assignment",
+				zap.String("campaign_id", id),
+				zap.String("user_id", item.UserID),
+				zap.String("role_id", item.RoleID),
+				zap.Error(err))
+		} else {
+			now := time.Now().UTC()
+			revokedAt = &now
+		}
+		if err := s.accessReviewRepo.RecordAccessReviewRevocation(ctx, item.ID, revokedAt, revokeError); err != nil {
+			return nil, nil, fmt.Errorf("failed to record revocation: %w", err)
+		}
+	}
+
+	return s.GetAccessReviewCampaign(ctx, id)
+}
+
+// checkManager rejects users not holding the manager role: a campaign's revocations remove
+// anyone's roles, so running one is an admin task
+func (s *accessReviewService) checkManager(userID string) error {
+	manager, err := holdsUserRole(s.enforcer, userID, s.managerRole)
+	if err != nil {
+		return err
+	}
+	if !manager {
+		return domain.ErrNotAccessReviewManager
+	}
+	return nil
+}
+
+func (s *accessReviewService) ExportAccessReviewReport(ctx context.Context, id string, format domain.AccessReviewReportFormat) ([]byte, error) {
+	if format == "" {
+		format = domain.AccessReviewReportCSV
+	}
+	campaign, summary, err := s.GetAccessReviewCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.accessReviewRepo.ListAllAccessReviewItems(ctx, id, domain.AccessReviewItemFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access review items: %w", err)
+	}
+	return domain.EncodeAccessReviewReport(&domain.AccessReviewReport{
+		Campaign:    campaign,
+		Summary:     summary,
+		Items:       items,
+		GeneratedAt: time.Now().UTC(),
+	}, format)
+}
+
+// revoke removes a reviewed assignment, in its scope for a scoped CMS role
+func (s *accessReviewService) revoke(ctx context.Context, item *domain.AccessReviewItem) error {
+	switch item.RoleKind {
+	case domain.RoleKindRole:
+		return s.authzService.RemoveRole(ctx, item.UserID, item.RoleID)
+	case domain.RoleKindCMSRole:
+		return s.casbinService.RemoveCMSRole(ctx, item.UserID, item.RoleID, item.Scope)
+	}
+	return fmt.Errorf("invalid role kind: %s", item.RoleKind)
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+var errCampaignNotFound = errors.New("access review campaign not found")
+
+// campaignlessAccessReviewRepository knows no campaigns; calls past the manager check reach it
+type campaignlessAccessReviewRepository struct {
+	repository.AccessReviewRepository
+}
+
+func (r *campaignlessAccessReviewRepository) GetAccessReviewCampaignByID(ctx context.Context, id string) (*domain.AccessReviewCampaign, error) {
+	return nil, errCampaignNotFound
+}
+
+func TestAccessReviewCampaignsRequireManagerRole(t *testing.T) {
+	enforcer, err := casbinPkg.NewMemoryEnforcer("../../configs/rbac_model.conf", zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, enforcer.AddRoleInheritance("security_admin", "admin", string(domain.DomainUser)))
+	require.NoError(t, enforcer.AddRoleForUser("u-security", "security_admin", string(domain.DomainUser)))
+	require.NoError(t, enforcer.AddRoleForUser("u-mallory", "customer", string(domain.DomainUser)))
+
+	s := NewAccessReviewService(&campaignlessAccessReviewRepository{}, nil, nil, nil, enforcer, "admin", zap.NewNop())
+	ctx := context.Background()
+
+	// Any other user could otherwise name themselves reviewer of an admin and revoke the admin's roles
+	_, _, err = s.CreateAccessReviewCampaign(ctx, "Q4", "", domain.AccessReviewScope{}, nil, "u-mallory", nil, "u-mallory")
+	assert.ErrorIs(t, err, domain.ErrNotAccessReviewManager)
+	_, _, err = s.CloseAccessReviewCampaign(ctx, "c-1", "u-mallory")
+	assert.ErrorIs(t, err, domain.ErrNotAccessReviewManager)
+
+	// The role also counts when inherited
+	_, _, err = s.CloseAccessReviewCampaign(ctx, "c-1", "u-security")
+	assert.ErrorIs(t, err, errCampaignNotFound)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// AssignmentNotifier tells users about role assignments that ended
+type AssignmentNotifier interface {
+	// AssignmentExpired notifies the recipients, user IDs, that an assignment expired
+	AssignmentExpired(ctx context.Context, assignment *domain.ExpiredAssignment, recipients []string) error
+}
+
+type logAssignmentNotifier struct {
+	logger *zap.Logger
+}
+
+// NewLogAssignmentNotifier creates an AssignmentNotifier that records notifications in the log
+func NewLogAssignmentNotifier(logger *zap.Logger) AssignmentNotifier {
+	return &logAssignmentNotifier{logger: logger}
+}
+
+func (n *logAssignmentNotifier) AssignmentExpired(ctx context.Context, assignment *domain.ExpiredAssignment, recipients []string) error {
+	var scope domain.ResourceScope
+	if assignment.Scope != nil {
+		scope = *assignment.Scope
+	}
+	n.logger.Info("Role assignment expired",
+		zap.String("user_id", assignment.UserID),
+		zap.String("role", assignment.RoleName),
+		zap.String("domain", string(assignment.Domain)),
+		zap.Stringer("scope", scope),
+		zap.Time("expires_at", assignment.ExpiresAt),
+		zap.Strings("recipients", recipients))
+	return nil
+}
+
+// AssignmentReaper removes role assignments once they expire
+type AssignmentReaper interface {
+	// ReapExpiredAssignments removes the expired assignments and their Casbin links, notifies the
+	// users and admins, and returns the assignments removed
+	ReapExpiredAssignments(ctx context.Context) ([]*domain.ExpiredAssignment, error)
+	// Run reaps expired assignments every interval until ctx is done
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type assignmentReaper struct {
+	userRepo   repository.UserRepository
+	authzRepo  repository.AuthorizationRepository
+	cmsRepo    repository.CMSRepository
+	scopeRepo  repository.ResourceScopeRepository
+	enforcer   *casbinPkg.Enforcer
+	notifier   AssignmentNotifier
+	notifyRole string
+	logger     *zap.Logger
+}
+
+// NewAssignmentReaper creates a new instance of AssignmentReaper.
+// Besides the user, the users holding notifyRole, directly or through inheritance, are notified of
+// every expiry.
+func NewAssignmentReaper(
+	userRepo repository.UserRepository,
+	authzRepo repository.AuthorizationRepository,
+	cmsRepo repository.CMSRepository,
+	scopeRepo repository.ResourceScopeRepository,
+	enforcer *casbinPkg.Enforcer,
+	notifier AssignmentNotifier,
+	notifyRole string,
+	logger *zap.Logger,
+) AssignmentReaper {
+	return &assignmentReaper{
+		userRepo:   userRepo,
+		authzRepo:  authzRepo,
+		cmsRepo:    cmsRepo,
+		scopeRepo:  scopeRepo,
+		enforcer:   enforcer,
+		notifier:   notifier,
+		notifyRole: notifyRole,
+		logger:     logger,
+	}
+}
+
+func (r *assignmentReaper) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		r.logger.Info("Assignment reaper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.ReapExpiredAssignments(ctx); err != nil {
+			r.logger.Error("Failed to reap expired role assignments", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *assignmentReaper) ReapExpiredAssignments(ctx context.Context) ([]*domain.ExpiredAssignment, error) {
+	now := time.Now()
+
+	roles, err := r.authzRepo.ListExpiredRoleAssignments(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired role assignments: %w", err)
+	}
+	cmsRoles, err := r.cmsRepo.ListExpiredCMSRoleAssignments(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired CMS role assignments: %w", err)
+	}
+	scopedCMSRoles, err := r.scopeRepo.ListExpiredScopedCMSRoleAssignments(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired scoped CMS role assignments: %w", err)
+	}
+	if len(roles) == 0 && len(cmsRoles) == 0 && len(scopedCMSRoles) == 0 {
+		return nil, nil
+	}
+
+	var reaped []*domain.ExpiredAssignment
+	for _, assignment := range roles {
+		removed, err := r.reap(ctx, roleLinks(assignment.UserID, assignment.RoleName), func(tx *sql.Tx) (bool, error) {
+			return r.authzRepo.RemoveExpiredRoleAssignmentTx(ctx, tx, assignment.UserID, assignment.RoleID, now)
+		})
+		if err != nil {
+			return reaped, fmt.Errorf("failed to remove expired role assignment: %w", err)
+		}
+		if removed {
+			reaped = append(reaped, assignment)
+		}
+	}
+	for _, assignment := range cmsRoles {
+		links := [][]string{{assignment.UserID, assignment.RoleName, string(domain.DomainCMS)}}
+		removed, err := r.reap(ctx, links, func(tx *sql.Tx) (bool, error) {
+			return r.cmsRepo.RemoveExpiredCMSRoleAssignmentTx(ctx, tx, assignment.UserID, assignment.RoleID, now)
+		})
+		if err != nil {
+			return reaped, fmt.Errorf("failed to remove expired CMS role assignment: %w", err)
+		}
+		if removed {
+			reaped = append(reaped, assignment)
+		}
+	}
+	// Scoped assignments have no Casbin link; scoped checks already ignore them once expired
+	for _, assignment := range scopedCMSRoles {
+		removed, err := r.scopeRepo.RemoveExpiredScopedCMSRoleAssignment(ctx, assignment.UserID, assignment.RoleID, *assignment.Scope, now)
+		if err != nil {
+			return reaped, fmt.Errorf("failed to remove expired scoped CMS role assignment: %w", err)
+		}
+		if removed {
+			reaped = append(reaped, assignment)
+		}
+	}
+
+	// The links are gone, so dropping their windows grants nothing
+	if err := r.enforcer.ReloadLinkWindows(); err != nil {
+		return reaped, fmt.Errorf("failed to reload role assignment windows: %w", err)
+	}
+
+	admins := r.notifyUsers(ctx)
+	for _, assignment := range reaped {
+		if err := r.notifier.AssignmentExpired(ctx, assignment, recipients(assignment.UserID, admins)); err != nil {
+			r.logger.Warn("Failed to notify expired role assignment",
+				zap.String("user_id", assignment.UserID),
+				zap.String("role", assignment.RoleName),
+				zap.Error(err))
+		}
+	}
+	return reaped, nil
+}
+
+// errAssignmentKept rolls back reaping an assignment that is no longer expired
+var errAssignmentKept = errors.New("assignment is no longer expired")
+
+// reap removes an expired assignment's Casbin links and its row, which removeRow deletes, in one
+// transaction, so a failure leaves both for the next run and the link never outlives its window.
+// Another instance may have reaped the assignment, or it was renewed in the meantime; it is then
+// kept and reap reports false.
+func (r *assignmentReaper) reap(ctx context.Context, links [][]string, removeRow func(tx *sql.Tx) (bool, error)) (bool, error) {
+	changes := &casbinPkg.RuleChanges{RemoveLinks: links}
+	err := r.enforcer.ApplyRuleChanges(ctx, changes, func(tx *sql.Tx) error {
+		removed, err := removeRow(tx)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return errAssignmentKept
+		}
+		return nil
+	})
+	if errors.Is(err, errAssignmentKept) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// notifyUsers returns the users holding the notify role, directly or through roles inheriting it
+func (r *assignmentReaper) notifyUsers(ctx context.Context) []string {
+	if r.notifyRole == "" {
+		return nil
+	}
+
+	subjects, err := r.enforcer.GetRoleDescendants(r.notifyRole, string(domain.DomainUser))
+	if err != nil {
+		r.logger.Warn("Failed to get users to notify", zap.String("role", r.notifyRole), zap.Error(err))
+		return nil
+	}
+	users, err := r.userRepo.GetExistingUserIDs(ctx, subjects)
+	if err != nil {
+		r.logger.Warn("Failed to get users to notify", zap.String("role", r.notifyRole), zap.Error(err))
+		return nil
+	}
+
+	var admins []string
+	for _, subject := range subjects {
+		if users[subject] {
+			admins = append(admins, subject)
+		}
+	}
+	return admins
+}
+
+// recipients returns the user and the admins, once each
+func recipients(userID string, admins []string) []string {
+	recipients := []string{userID}
+	for _, admin := range admins {
+		if admin != userID {
+			recipients = append(recipients, admin)
+		}
+	}
+	return recipients
+}
@@ -15,17 +15,24 @@ import (
 // AuthService handles authentication business logic
 type AuthService interface {
 	Register(ctx context.Context, username, email, password, fullName string) (*domain.User, error)
-	Login(ctx context.Context, username, password string) (*domain.User, *domain.TokenPair, error)
+	// Login activates activeRoles in the session, or every role a dynamic separation-of-duties
+	// constraint does not keep apart when none are given
+	Login(ctx context.Context, username, password string, activeRoles []string) (*domain.User, *domain.TokenPair, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
 	VerifyToken(ctx context.Context, token string) (string, []string, error)
+	// VerifySession verifies an access token and returns its user and the roles assigned to the
+	// user that the session leaves inactive: those a dynamic separation-of-duties constraint kept
+	// out of it and those assigned after the token was issued
+	VerifySession(ctx context.Context, token string) (string, []string, error)
 	Logout(ctx context.Context, userID string) error
 }
 
 type authService struct {
-	userRepo    repository.UserRepository
-	authzRepo   repository.AuthorizationRepository
-	jwtManager  *jwt.JWTManager
-	passwordMgr *password.PasswordManager
+	userRepo     repository.UserRepository
+	authzRepo    repository.AuthorizationRepository
+	jwtManager   *jwt.JWTManager
+	passwordMgr  *password.PasswordManager
+	sessionRoles SessionRoleActivator
 }
 
 // NewAuthService creates a new instance of AuthService
@@ -34,12 +41,14 @@ func NewAuthService(
 	authzRepo repository.AuthorizationRepository,
 	jwtManager *jwt.JWTManager,
 	passwordMgr *password.PasswordManager,
+	sessionRoles SessionRoleActivator,
 ) AuthService {
 	return &authService{
-		userRepo:    userRepo,
-		authzRepo:   authzRepo,
-		jwtManager:  jwtManager,
-		passwordMgr: passwordMgr,
+		userRepo:     userRepo,
+		authzRepo:    authzRepo,
+		jwtManager:   jwtManager,
+		passwordMgr:  passwordMgr,
+		sessionRoles: sessionRoles,
 	}
 }
 
@@ -74,7 +83,7 @@ func (s *authService) Register(ctx context.Context, username, email, password, f
 	return user, nil
 }
 
-func (s *authService) Login(ctx context.Context, username, password string) (*domain.User, *domain.TokenPair, error) {
+func (s *authService) Login(ctx context.Context, username, password string, activeRoles []string) (*domain.User, *domain.TokenPair, error) {
 	// Get user by username
 	user, err := s.userRepo.GetUserByUsername(ctx, username)
 	if err != nil {
@@ -102,13 +111,18 @@ func (s *authService) Login(ctx context.Context, username, password string) (*do
 		roleNames[i] = role.Name
 	}
 
+	sessionRoles, err := s.sessionRoles.SessionRoles(ctx, user.ID, roleNames, activeRoles)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Generate tokens
-	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Username, roleNames)
+	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Username, sessionRoles)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID)
+	refreshToken, err := s.jwtManager.GenerateRefreshTokenWithRoles(user.ID, activeRoles)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -148,17 +162,31 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*d
 	}
 
 	roleNames := make([]string, len(roles))
+	assigned := make(map[string]bool, len(roles))
 	for i, role := range roles {
 		roleNames[i] = role.Name
+		assigned[role.Name] = true
+	}
+
+	// Keep the roles chosen at login active, unless they were removed since
+	var activeRoles []string
+	for _, role := range claims.Roles {
+		if assigned[role] {
+			activeRoles = append(activeRoles, role)
+		}
+	}
+	sessionRoles, err := s.sessionRoles.SessionRoles(ctx, user.ID, roleNames, activeRoles)
+	if err != nil {
+		return nil, err
 	}
 
 	// Generate new tokens
-	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Username, roleNames)
+	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Username, sessionRoles)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	newRefreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID)
+	newRefreshToken, err := s.jwtManager.GenerateRefreshTokenWithRoles(user.ID, activeRoles)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -182,6 +210,30 @@ func (s *authService) VerifyToken(ctx context.Context, token string) (string, []
 	return claims.UserID, claims.Roles, nil
 }
 
+func (s *authService) VerifySession(ctx context.Context, token string) (string, []string, error) {
+	claims, err := s.jwtManager.VerifyToken(token)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	roles, err := s.authzRepo.GetUserRoles(ctx, claims.UserID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	active := make(map[string]bool, len(claims.Roles))
+	for _, role := range claims.Roles {
+		active[role] = true
+	}
+	var inactive []string
+	for _, role := range roles {
+		if !active[role.Name] {
+			inactive = append(inactive, role.Name)
+		}
+	}
+	return claims.UserID, inactive, nil
+}
+
 func (s *authService) Logout(ctx context.Context, userID string) error {
 	// In a production system, you would invalidate the token here
 	// For now, we just return nil
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -31,6 +32,14 @@ func (m *MockUserRepository) GetUserByID(ctx context.Context, id string) (*domai
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
+func (m *MockUserRepository) GetExistingUserIDs(ctx context.Context, ids []string) (map[string]bool, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
 func (m *MockUserRepository) GetUserByUsername(ctx context.Context, username string) (*domain.User, error) {
 	args := m.Called(ctx, username)
 	if args.Get(0) == nil {
@@ -75,11 +84,32 @@ func (m *MockAuthorizationRepository) GetUserRoles(ctx context.Context, userID s
 	return args.Get(0).([]*domain.Role), args.Error(1)
 }
 
-func (m *MockAuthorizationRepository) AssignRoleToUser(ctx context.Context, userID, roleID string) error {
-	args := m.Called(ctx, userID, roleID)
+func (m *MockAuthorizationRepository) GetRoleUsers(ctx context.Context, roleID string) ([]*domain.User, error) {
+	args := m.Called(ctx, roleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.User), args.Error(1)
+}
+
+func (m *MockAuthorizationRepository) AssignRoleToUser(ctx context.Context, userID, roleID string, window domain.AssignmentWindow) error {
+	args := m.Called(ctx, userID, roleID, window)
 	return args.Error(0)
 }
 
+func (m *MockAuthorizationRepository) ListExpiredRoleAssignments(ctx context.Context, now time.Time) ([]*domain.ExpiredAssignment, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ExpiredAssignment), args.Error(1)
+}
+
+func (m *MockAuthorizationRepository) RemoveExpiredRoleAssignmentTx(ctx context.Context, tx *sql.Tx, userID, roleID string, now time.Time) (bool, error) {
+	args := m.Called(ctx, tx, userID, roleID, now)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockAuthorizationRepository) RemoveRoleFromUser(ctx context.Context, userID, roleID string) error {
 	args := m.Called(ctx, userID, roleID)
 	return args.Error(0)
@@ -121,6 +151,16 @@ func (m *MockAuthorizationRepository) UpdateRolePermissions(ctx context.Context,
 	return args.Error(0)
 }
 
+// activateAllRoles activates the requested roles, or every assigned role
+type activateAllRoles struct{}
+
+func (activateAllRoles) SessionRoles(ctx context.Context, userID string, assigned, requested []string) ([]string, error) {
+	if len(requested) > 0 {
+		return requested, nil
+	}
+	return assigned, nil
+}
+
 func TestRegister_Success(t *testing.T) {
 	// Setup
 	mockUserRepo := new(MockUserRepository)
@@ -128,7 +168,7 @@ func TestRegister_Success(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Mock expectations
 	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*domain.User")).Return(nil)
@@ -157,7 +197,7 @@ func TestRegister_EmptyUsername(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Execute
 	ctx := context.Background()
@@ -176,7 +216,7 @@ func TestLogin_Success(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Create hashed password
 	hashedPassword, _ := passwordManager.HashPassword("password123")
@@ -203,7 +243,7 @@ func TestLogin_Success(t *testing.T) {
 
 	// Execute
 	ctx := context.Background()
-	user, tokenPair, err := service.Login(ctx, "testuser", "password123")
+	user, tokenPair, err := service.Login(ctx, "testuser", "password123", nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -225,7 +265,7 @@ func TestLogin_InvalidPassword(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Create hashed password
 	hashedPassword, _ := passwordManager.HashPassword("password123")
@@ -247,7 +287,7 @@ func TestLogin_InvalidPassword(t *testing.T) {
 
 	// Execute with wrong password
 	ctx := context.Background()
-	user, tokenPair, err := service.Login(ctx, "testuser", "wrongpassword")
+	user, tokenPair, err := service.Login(ctx, "testuser", "wrongpassword", nil)
 
 	// Assert
 	assert.Error(t, err)
@@ -265,7 +305,7 @@ func TestLogin_InactiveUser(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Create hashed password
 	hashedPassword, _ := passwordManager.HashPassword("password123")
@@ -287,7 +327,7 @@ func TestLogin_InactiveUser(t *testing.T) {
 
 	// Execute
 	ctx := context.Background()
-	user, tokenPair, err := service.Login(ctx, "testuser", "password123")
+	user, tokenPair, err := service.Login(ctx, "testuser", "password123", nil)
 
 	// Assert
 	assert.Error(t, err)
@@ -305,7 +345,7 @@ func TestVerifyToken_Success(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Generate a valid token
 	token, err := jwtManager.GenerateAccessToken("user-123", "testuser", []string{"user", "admin"})
@@ -321,6 +361,37 @@ func TestVerifyToken_Success(t *testing.T) {
 	assert.Equal(t, []string{"user", "admin"}, roles)
 }
 
+func TestVerifySession_InactiveRoles(t *testing.T) {
+	// Setup
+	mockUserRepo := new(MockUserRepository)
+	mockAuthzRepo := new(MockAuthorizationRepository)
+	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
+	passwordManager := password.NewPasswordManager()
+
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
+
+	// The session activated the requester role only
+	token, err := jwtManager.GenerateAccessToken("user-123", "testuser", []string{"refund_requester"})
+	require.NoError(t, err)
+
+	mockRoles := []*domain.Role{
+		{ID: "role-1", Name: "refund_requester"},
+		{ID: "role-2", Name: "refund_approver"},
+	}
+	mockAuthzRepo.On("GetUserRoles", mock.Anything, "user-123").Return(mockRoles, nil)
+
+	// Execute
+	ctx := context.Background()
+	userID, inactive, err := service.VerifySession(ctx, token)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "user-123", userID)
+	assert.Equal(t, []string{"refund_approver"}, inactive)
+
+	mockAuthzRepo.AssertExpectations(t)
+}
+
 func TestVerifyToken_InvalidToken(t *testing.T) {
 	// Setup
 	mockUserRepo := new(MockUserRepository)
@@ -328,7 +399,7 @@ func TestVerifyToken_InvalidToken(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Execute with invalid token
 	ctx := context.Background()
@@ -347,7 +418,7 @@ func TestLogout_Success(t *testing.T) {
 	jwtManager := jwt.NewJWTManager("test-secret-min-32-chars-long", time.Hour, time.Hour*24)
 	passwordManager := password.NewPasswordManager()
 
-	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager)
+	service := NewAuthService(mockUserRepo, mockAuthzRepo, jwtManager, passwordManager, activateAllRoles{})
 
 	// Execute
 	ctx := context.Background()
@@ -3,42 +3,64 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/tvttt/iam-services/internal/domain"
 	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
 )
 
 // AuthorizationService handles authorization business logic
 type AuthorizationService interface {
-	AssignRole(ctx context.Context, userID, roleID string) error
+	AssignRole(ctx context.Context, userID, roleID string, window domain.AssignmentWindow) error
 	RemoveRole(ctx context.Context, userID, roleID string) error
 	GetUserRoles(ctx context.Context, userID string) ([]*domain.Role, error)
 	CheckPermission(ctx context.Context, userID, resource, action string) (bool, error)
+	// CheckPermissionInSession checks a permission with only the roles active in the user's
+	// session, leaving out the inactive roles the session's access token does not activate
+	CheckPermissionInSession(ctx context.Context, userID string, inactiveRoles []string, resource, action string) (bool, error)
+	ProjectExistingRoles(ctx context.Context) error
 }
 
+// projectRolesPageSize is how many roles are loaded per page when existing roles are projected
+const projectRolesPageSize = 100
+
 type authorizationService struct {
-	authzRepo repository.AuthorizationRepository
-	userRepo  repository.UserRepository
-	roleRepo  repository.RoleRepository
+	authzRepo  repository.AuthorizationRepository
+	userRepo   repository.UserRepository
+	roleRepo   repository.RoleRepository
+	sod        SoDService
+	projection *rbacProjection
 }
 
-// NewAuthorizationService creates a new instance of AuthorizationService
+// NewAuthorizationService creates a new instance of AuthorizationService.
+// Role assignments are projected into Casbin through the enforcer and must respect the static
+// separation-of-duties constraints.
 func NewAuthorizationService(
 	authzRepo repository.AuthorizationRepository,
 	userRepo repository.UserRepository,
 	roleRepo repository.RoleRepository,
+	sod SoDService,
+	enforcer *casbinPkg.Enforcer,
 ) AuthorizationService {
 	return &authorizationService{
-		authzRepo: authzRepo,
-		userRepo:  userRepo,
-		roleRepo:  roleRepo,
+		authzRepo:  authzRepo,
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		sod:        sod,
+		projection: newRBACProjection(enforcer, authzRepo),
 	}
 }
 
-func (s *authorizationService) AssignRole(ctx context.Context, userID, roleID string) error {
+// AssignRole assigns a role to a user, limited to the window if it has bounds. Assigning a role
+// the user already holds replaces its window.
+func (s *authorizationService) AssignRole(ctx context.Context, userID, roleID string, window domain.AssignmentWindow) error {
 	if userID == "" || roleID == "" {
 		return fmt.Errorf("user ID and role ID are required")
 	}
+	if err := window.Validate(time.Now()); err != nil {
+		return fmt.Errorf("invalid assignment window: %w", err)
+	}
 
 	// Verify user exists
 	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
@@ -46,11 +68,26 @@ func (s *authorizationService) AssignRole(ctx context.Context, userID, roleID st
 	}
 
 	// Verify role exists
-	if _, err := s.roleRepo.GetRoleByID(ctx, roleID); err != nil {
+	role, err := s.roleRepo.GetRoleByID(ctx, roleID)
+	if err != nil {
 		return fmt.Errorf("role not found: %w", err)
 	}
 
-	return s.authzRepo.AssignRoleToUser(ctx, userID, roleID)
+	if err := s.sod.CheckRoleLink(ctx, userID, role.Name, domain.DomainUser); err != nil {
+		return err
+	}
+
+	if err := s.authzRepo.AssignRoleToUser(ctx, userID, roleID, window); err != nil {
+		return err
+	}
+	// Load the window before the link exists, so the link never grants outside it
+	if err := s.projection.enforcer.ReloadLinkWindows(); err != nil {
+		return fmt.Errorf("failed to load role assignment window: %w", err)
+	}
+	if err := s.projection.assignRole(userID, role.Name); err != nil {
+		return fmt.Errorf("failed to project role assignment into Casbin: %w", err)
+	}
+	return nil
 }
 
 func (s *authorizationService) RemoveRole(ctx context.Context, userID, roleID string) error {
@@ -58,7 +95,18 @@ func (s *authorizationService) RemoveRole(ctx context.Context, userID, roleID st
 		return fmt.Errorf("user ID and role ID are required")
 	}
 
-	return s.authzRepo.RemoveRoleFromUser(ctx, userID, roleID)
+	role, err := s.roleRepo.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("role not found: %w", err)
+	}
+
+	if err := s.authzRepo.RemoveRoleFromUser(ctx, userID, roleID); err != nil {
+		return err
+	}
+	if err := s.projection.removeRole(userID, role.Name); err != nil {
+		return fmt.Errorf("failed to remove role assignment from Casbin: %w", err)
+	}
+	return nil
 }
 
 func (s *authorizationService) GetUserRoles(ctx context.Context, userID string) ([]*domain.Role, error) {
@@ -96,3 +144,32 @@ func (s *authorizationService) CheckPermission(ctx context.Context, userID, reso
 
 	return s.authzRepo.UserHasPermission(ctx, userID, resource, action)
 }
+
+func (s *authorizationService) CheckPermissionInSession(ctx context.Context, userID string, inactiveRoles []string, resource, action string) (bool, error) {
+	if userID == "" || resource == "" || action == "" {
+		return false, fmt.Errorf("user ID, resource, and action are required")
+	}
+
+	// The projected policies mirror the relational permissions and can leave roles out
+	return s.projection.enforcer.EnforceWithoutRoles(userID, inactiveRoles, string(domain.DomainUser),
+		domain.PermissionResource(resource), action, nil)
+}
+
+// ProjectExistingRoles projects every role's permissions and assignments into Casbin where rules
+// are missing. It runs at startup so data that predates the Casbin tables is authorized too.
+func (s *authorizationService) ProjectExistingRoles(ctx context.Context) error {
+	for page := 1; ; page++ {
+		roles, total, err := s.roleRepo.ListRoles(ctx, page, projectRolesPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list roles: %w", err)
+		}
+		for _, role := range roles {
+			if err := s.projection.backfill(ctx, role); err != nil {
+				return fmt.Errorf("failed to project role %s into Casbin: %w", role.Name, err)
+			}
+		}
+		if len(roles) < projectRolesPageSize || page*projectRolesPageSize >= total {
+			return nil
+		}
+	}
+}
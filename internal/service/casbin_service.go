@@ -2,7 +2,14 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,45 +22,114 @@ import (
 type CasbinService interface {
 	// Authorization checks
 	CheckAPIAccess(ctx context.Context, userID, apiPath, method string) (bool, error)
-	CheckCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string) (bool, error)
+	// CheckAPIAccessInSession checks API access with only the roles active in the user's session,
+	// leaving out the inactive roles the session's access token does not activate
+	CheckAPIAccessInSession(ctx context.Context, userID string, inactiveRoles []string, apiPath, method string) (bool, error)
+	CheckCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string, scope domain.ResourceScope) (bool, error)
 	Enforce(ctx context.Context, req *domain.AuthorizationRequest) (*domain.AuthorizationResponse, error)
+	ExplainAPIAccess(ctx context.Context, userID, apiPath, method string) (*domain.AuthorizationExplanation, error)
+	ExplainCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string) (*domain.AuthorizationExplanation, error)
+	BatchCheckAPIAccess(ctx context.Context, userID string, checks []domain.APIAccessCheck) ([]*domain.BatchAuthorizationResult, error)
+	BatchEnforce(ctx context.Context, reqs []*domain.AuthorizationRequest) ([]*domain.BatchAuthorizationResult, error)
 
 	// Role management with Casbin
 	AssignUserRole(ctx context.Context, userID, roleName string, domain domain.CasbinDomain) error
 	RemoveUserRole(ctx context.Context, userID, roleName string, domain domain.CasbinDomain) error
 	GetUserRolesInDomain(ctx context.Context, userID string, domain domain.CasbinDomain) ([]string, error)
+	GetEffectivePermissions(ctx context.Context, userID string, domain domain.CasbinDomain) ([]*domain.EffectivePermission, error)
+	ListAPIAccessHolders(ctx context.Context, apiResourceID, apiPath, method string, page, pageSize int) ([]*domain.AccessHolder, int, error)
+	ListCMSAccessHolders(ctx context.Context, cmsTab domain.CMSTab, action string, page, pageSize int) ([]*domain.AccessHolder, int, error)
+
+	// Role hierarchy
+	AddRoleParent(ctx context.Context, role, parent string, domain domain.CasbinDomain) error
+	RemoveRoleParent(ctx context.Context, role, parent string, domain domain.CasbinDomain) error
+	GetRoleHierarchy(ctx context.Context, role string, domain domain.CasbinDomain) (*domain.RoleHierarchy, error)
 
 	// Policy management
-	AddPolicy(ctx context.Context, role string, domain domain.CasbinDomain, resource, action string) error
-	RemovePolicy(ctx context.Context, role string, domain domain.CasbinDomain, resource, action string) error
+	AddPolicy(ctx context.Context, role string, domain domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error
+	RemovePolicy(ctx context.Context, role string, domain domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error
 	GetPoliciesForRole(ctx context.Context, role string, domain domain.CasbinDomain) ([][]string, error)
+	ListPolicies(ctx context.Context, filter domain.PolicyFilter, page, pageSize int) ([]*domain.Policy, int, error)
+
+	// Policy import/export
+	ExportPolicies(ctx context.Context, format domain.PolicyBundleFormat) ([]byte, error)
+	ImportPolicies(ctx context.Context, data []byte, format domain.PolicyBundleFormat, dryRun bool) (*domain.PolicyBundleDiff, error)
+
+	// What-if simulation
+	SimulatePolicyChanges(ctx context.Context, changes *domain.PolicyChangeSet) (*domain.PolicySimulation, error)
+
+	// Policy conditions (attribute-based access control)
+	SetPolicyCondition(ctx context.Context, condition *domain.PolicyCondition) (*domain.PolicyCondition, error)
+	RemovePolicyCondition(ctx context.Context, subject string, domain domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error
+	ListPolicyConditions(ctx context.Context, domain domain.CasbinDomain) ([]*domain.PolicyCondition, error)
 
 	// CMS Role management
-	CreateCMSRole(ctx context.Context, name, description string, tabs []domain.CMSTab) (*domain.CMSRole, error)
-	AssignCMSRole(ctx context.Context, userID, cmsRoleID string) error
-	RemoveCMSRole(ctx context.Context, userID, cmsRoleID string) error
+	CreateCMSRole(ctx context.Context, name, description string, permissions []domain.CMSTabPermission) (*domain.CMSRole, error)
+	UpdateCMSRole(ctx context.Context, cmsRoleID, name string, description *string, permissions []domain.CMSTabPermission) (*domain.CMSRole, error)
+	DeleteCMSRole(ctx context.Context, cmsRoleID string, cascade bool) (*domain.RoleImpact, error)
+	GetCMSRole(ctx context.Context, cmsRoleID string) (*domain.CMSRole, error)
+	AssignCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, window domain.AssignmentWindow) error
+	RemoveCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error
+	ListUserScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error)
 	GetUserCMSTabs(ctx context.Context, userID string) ([]domain.CMSTab, error)
+	GetUserCMSTabTree(ctx context.Context, userID string) ([]*domain.CMSTabNode, error)
+	GetCMSCapabilities(ctx context.Context, userID string, attributes map[string]string, scope domain.ResourceScope) (*domain.CMSCapabilityMap, error)
+
+	// CMS field-level permissions
+	SetCMSRoleFieldPermission(ctx context.Context, cmsRoleID, resourceType string, readable, writable []string) (*domain.CMSFieldPermission, error)
+	ListCMSRoleFieldPermissions(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error)
+	DeleteCMSRoleFieldPermission(ctx context.Context, cmsRoleID, resourceType string) error
+	GetUserFieldPermissions(ctx context.Context, userID, resourceType string) (*domain.FieldPermissionSet, error)
+
+	// CMS Tab registry
+	CreateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) (*domain.CMSTabDefinition, error)
+	GetCMSTab(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error)
+	ListCMSTabs(ctx context.Context) ([]*domain.CMSTabDefinition, error)
+	UpdateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) (*domain.CMSTabDefinition, error)
+	DeleteCMSTab(ctx context.Context, key domain.CMSTab) error
+
+	// Resource scopes for scoped CMS role assignments
+	CreateResourceScope(ctx context.Context, scope *domain.ResourceScopeDefinition) (*domain.ResourceScopeDefinition, error)
+	ListResourceScopes(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error)
+	DeleteResourceScope(ctx context.Context, scope domain.ResourceScope) error
 
 	// API Resource management
 	CreateAPIResource(ctx context.Context, path, method, service, description string) (*domain.APIResource, error)
 	ListAPIResources(ctx context.Context, service string) ([]*domain.APIResource, error)
 }
 
+// cmsTabKeyPattern restricts tab keys to values that are safe inside /cms/<tab>/* policy paths
+var cmsTabKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// cmsRoleResyncPageSize is how many CMS roles are loaded per page when policies are rebuilt
+const cmsRoleResyncPageSize = 500
+
 type casbinService struct {
 	enforcer        *casbinPkg.Enforcer
 	cmsRepo         repository.CMSRepository
 	apiResourceRepo repository.APIResourceRepository
 	userRepo        repository.UserRepository
 	roleRepo        repository.RoleRepository
+	sod             SoDService
+	relations       RelationChecker
+	scopeRepo       repository.ResourceScopeRepository
+	tabIndex        *cmsTabIndex
 }
 
-// NewCasbinService creates a new instance of CasbinService
+// NewCasbinService creates a new instance of CasbinService.
+// Role assignments and inheritance must respect the static separation-of-duties constraints.
+// Enforce combines policy decisions with relation checks when a request names a relation.
+// CMS roles assigned within a resource scope only count for checks naming that scope or a scope
+// below it.
 func NewCasbinService(
 	enforcer *casbinPkg.Enforcer,
 	cmsRepo repository.CMSRepository,
 	apiResourceRepo repository.APIResourceRepository,
 	userRepo repository.UserRepository,
 	roleRepo repository.RoleRepository,
+	sod SoDService,
+	relations RelationChecker,
+	scopeRepo repository.ResourceScopeRepository,
 ) CasbinService {
 	return &casbinService{
 		enforcer:        enforcer,
@@ -61,6 +137,10 @@ func NewCasbinService(
 		apiResourceRepo: apiResourceRepo,
 		userRepo:        userRepo,
 		roleRepo:        roleRepo,
+		sod:             sod,
+		relations:       relations,
+		scopeRepo:       scopeRepo,
+		tabIndex:        newCMSTabIndex(cmsRepo),
 	}
 }
 
@@ -73,24 +153,162 @@ func (s *casbinService) CheckAPIAccess(ctx context.Context, userID, apiPath, met
 	return allowed, nil
 }
 
-func (s *casbinService) CheckCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string) (bool, error) {
-	// Convert CMS tab to resource path
-	resource := fmt.Sprintf("/cms/%s/*", string(cmsTab))
+func (s *casbinService) CheckAPIAccessInSession(ctx context.Context, userID string, inactiveRoles []string, apiPath, method string) (bool, error) {
+	allowed, err := s.enforcer.EnforceWithoutRoles(userID, inactiveRoles, string(domain.DomainAPI), apiPath, method, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to enforce policy: %w", err)
+	}
+	return allowed, nil
+}
+
+func (s *casbinService) CheckCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string, scope domain.ResourceScope) (bool, error) {
+	resource, action, err := s.cmsAccessRequest(ctx, cmsTab, action)
+	if err != nil {
+		return false, err
+	}
 
 	// Check in CMS domain
-	allowed, err := s.enforcer.Enforce(userID, string(domain.DomainCMS), resource, action)
+	allowed, err := s.enforceInScope(ctx, userID, domain.DomainCMS, resource, action, scope, nil)
 	if err != nil {
 		return false, fmt.Errorf("failed to enforce policy: %w", err)
 	}
 	return allowed, nil
 }
 
+// enforceInScope enforces a request with the CMS roles the user holds within the scope, or the
+// scopes above it, added to the roles linked to the user. A global scope only uses the links.
+func (s *casbinService) enforceInScope(ctx context.Context, userID string, dom domain.CasbinDomain, resource, action string, scope domain.ResourceScope, attributes map[string]string) (bool, error) {
+	roles, err := s.scopedRoles(ctx, userID, dom, scope)
+	if err != nil {
+		return false, err
+	}
+	return s.enforcer.EnforceWithRoles(userID, roles, string(dom), resource, action, attributes)
+}
+
+// scopedRoles returns the CMS roles the user holds within the scope or the scopes above it; a
+// global scope has none
+func (s *casbinService) scopedRoles(ctx context.Context, userID string, dom domain.CasbinDomain, scope domain.ResourceScope) ([]string, error) {
+	if scope.IsZero() {
+		return nil, nil
+	}
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	if dom != domain.DomainCMS {
+		return nil, fmt.Errorf("resource scopes only apply to the %s domain", domain.DomainCMS)
+	}
+
+	roles, err := s.scopeRepo.ListCMSRoleNamesInScope(ctx, userID, scope, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CMS roles in scope %s: %w", scope, err)
+	}
+	return roles, nil
+}
+
+// cmsAccessRequest converts a CMS tab and action into the resource and action enforced by Casbin
+func (s *casbinService) cmsAccessRequest(ctx context.Context, cmsTab domain.CMSTab, action string) (string, string, error) {
+	// Only registered tabs can be checked
+	registered, err := s.tabIndex.registered(ctx, cmsTab)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get CMS tab: %w", err)
+	}
+	if !registered {
+		return "", "", fmt.Errorf("invalid CMS tab %s: CMS tab not found", cmsTab)
+	}
+
+	// Accept HTTP methods from older callers by mapping them to CMS actions
+	if cmsAction, ok := domain.CMSActionFromMethod(action); ok {
+		action = string(cmsAction)
+	}
+
+	// Convert CMS tab to resource path
+	return domain.CMSTabResource(cmsTab), action, nil
+}
+
+func (s *casbinService) ExplainAPIAccess(ctx context.Context, userID, apiPath, method string) (*domain.AuthorizationExplanation, error) {
+	return s.explain(userID, domain.DomainAPI, apiPath, method, nil)
+}
+
+func (s *casbinService) ExplainCMSAccess(ctx context.Context, userID string, cmsTab domain.CMSTab, action string) (*domain.AuthorizationExplanation, error) {
+	resource, action, err := s.cmsAccessRequest(ctx, cmsTab, action)
+	if err != nil {
+		return nil, err
+	}
+	return s.explain(userID, domain.DomainCMS, resource, action, nil)
+}
+
+// explain enforces a request and converts the enforcer's explanation
+func (s *casbinService) explain(userID string, dom domain.CasbinDomain, resource, action string, attributes map[string]string) (*domain.AuthorizationExplanation, error) {
+	explanation, err := s.enforcer.Explain(userID, string(dom), resource, action, attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain policy decision: %w", err)
+	}
+
+	result := &domain.AuthorizationExplanation{
+		Allowed:       explanation.Allowed,
+		MatchedPolicy: explanation.MatchedPolicy,
+		RoleChain:     explanation.RoleChain,
+	}
+	for _, candidate := range explanation.Candidates {
+		result.Candidates = append(result.Candidates, &domain.PolicyCandidate{
+			Policy:       candidate.Policy,
+			RoleChain:    candidate.RoleChain,
+			FailedFields: candidate.FailedFields,
+		})
+	}
+	return result, nil
+}
+
 func (s *casbinService) Enforce(ctx context.Context, req *domain.AuthorizationRequest) (*domain.AuthorizationResponse, error) {
-	allowed, err := s.enforcer.Enforce(
+	response, err := s.enforcePolicy(ctx, req)
+	if err != nil || req.Relation == nil {
+		return response, err
+	}
+	return s.combineRelation(ctx, req, response)
+}
+
+// enforcePolicy decides a request from the Casbin policies alone
+func (s *casbinService) enforcePolicy(ctx context.Context, req *domain.AuthorizationRequest) (*domain.AuthorizationResponse, error) {
+	scoped := req.Scope != nil && !req.Scope.IsZero()
+	if req.Explain && scoped {
+		err := fmt.Errorf("explain is not supported for requests with a resource scope")
+		return &domain.AuthorizationResponse{
+			Allowed: false,
+			Reason:  fmt.Sprintf("Authorization check failed: %v", err),
+		}, err
+	}
+	if req.Explain {
+		explanation, err := s.explain(req.UserID, req.Domain, req.Resource, req.Action, req.Attributes)
+		if err != nil {
+			return &domain.AuthorizationResponse{
+				Allowed: false,
+				Reason:  fmt.Sprintf("Authorization check failed: %v", err),
+			}, err
+		}
+
+		reason := "Permission granted"
+		if !explanation.Allowed {
+			reason = "Permission denied"
+		}
+		return &domain.AuthorizationResponse{
+			Allowed:     explanation.Allowed,
+			Reason:      reason,
+			Explanation: explanation,
+		}, nil
+	}
+
+	var scope domain.ResourceScope
+	if scoped {
+		scope = *req.Scope
+	}
+	allowed, err := s.enforceInScope(
+		ctx,
 		req.UserID,
-		string(req.Domain),
+		req.Domain,
 		req.Resource,
 		req.Action,
+		scope,
+		req.Attributes,
 	)
 
 	if err != nil {
@@ -111,6 +329,140 @@ func (s *casbinService) Enforce(ctx context.Context, req *domain.AuthorizationRe
 	}, nil
 }
 
+// combineRelation checks the request's relation and combines it with the policy decision
+func (s *casbinService) combineRelation(ctx context.Context, req *domain.AuthorizationRequest, response *domain.AuthorizationResponse) (*domain.AuthorizationResponse, error) {
+	mode := req.RelationMode
+	if mode == "" {
+		mode = domain.RelationModeAll
+	}
+	if !mode.IsValid() {
+		err := fmt.Errorf("invalid relation mode %q: expected all or any", mode)
+		return &domain.AuthorizationResponse{
+			Allowed: false,
+			Reason:  fmt.Sprintf("Authorization check failed: %v", err),
+		}, err
+	}
+
+	check := *req.Relation
+	related, err := s.relations.CheckRelation(ctx, check.Namespace, check.ObjectID, check.Relation, req.UserID)
+	if err != nil {
+		return &domain.AuthorizationResponse{
+			Allowed: false,
+			Reason:  fmt.Sprintf("Relation check failed: %v", err),
+		}, err
+	}
+
+	decision := &domain.RelationDecision{
+		Check:           check,
+		Mode:            mode,
+		PolicyAllowed:   response.Allowed,
+		RelationAllowed: related,
+	}
+	userset := domain.Userset(check.Namespace, check.ObjectID, check.Relation)
+	switch {
+	case mode == domain.RelationModeAll && !response.Allowed:
+		response.Reason = "Permission denied by policy"
+	case mode == domain.RelationModeAll && !related:
+		response.Allowed = false
+		response.Reason = "Permission denied: user is not in " + userset
+	case mode == domain.RelationModeAny && !response.Allowed && related:
+		response.Allowed = true
+		response.Reason = "Permission granted by relation " + userset
+	case mode == domain.RelationModeAny && !response.Allowed:
+		response.Reason = "Permission denied by policy and relation"
+	}
+	response.Relation = decision
+	return response, nil
+}
+
+func (s *casbinService) BatchCheckAPIAccess(ctx context.Context, userID string, checks []domain.APIAccessCheck) ([]*domain.BatchAuthorizationResult, error) {
+	reqs := make([]*domain.AuthorizationRequest, len(checks))
+	for i, check := range checks {
+		reqs[i] = &domain.AuthorizationRequest{
+			UserID:   userID,
+			Domain:   domain.DomainAPI,
+			Resource: check.APIPath,
+			Action:   check.Method,
+		}
+	}
+	return s.BatchEnforce(ctx, reqs)
+}
+
+func (s *casbinService) BatchEnforce(ctx context.Context, reqs []*domain.AuthorizationRequest) ([]*domain.BatchAuthorizationResult, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("at least one check is required")
+	}
+	if len(reqs) > domain.MaxBatchAuthorizationSize {
+		return nil, fmt.Errorf("too many checks: %d exceeds the limit of %d", len(reqs), domain.MaxBatchAuthorizationSize)
+	}
+
+	results := make([]*domain.BatchAuthorizationResult, len(reqs))
+
+	// Incomplete checks fail on their own without affecting the rest of the batch
+	requests := make([][]string, 0, len(reqs))
+	attributes := make([]map[string]string, 0, len(reqs))
+	indexes := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if req.UserID == "" || req.Domain == "" || req.Resource == "" || req.Action == "" {
+			results[i] = &domain.BatchAuthorizationResult{
+				Reason: "Authorization check failed",
+				Error:  "user_id, domain, resource and action are required",
+			}
+			continue
+		}
+		// Scoped checks look up the roles held in their scope, so they are not batched
+		if req.Scope != nil && !req.Scope.IsZero() {
+			results[i] = s.enforceBatchItemInScope(ctx, req)
+			continue
+		}
+		requests = append(requests, []string{req.UserID, string(req.Domain), req.Resource, req.Action})
+		attributes = append(attributes, req.Attributes)
+		indexes = append(indexes, i)
+	}
+
+	allowed, errs := s.enforcer.BatchEnforceWithAttributes(requests, attributes)
+	for j, i := range indexes {
+		if errs[j] != nil {
+			results[i] = &domain.BatchAuthorizationResult{
+				Reason: "Authorization check failed",
+				Error:  errs[j].Error(),
+			}
+			continue
+		}
+
+		reason := "Permission granted"
+		if !allowed[j] {
+			reason = "Permission denied"
+		}
+		results[i] = &domain.BatchAuthorizationResult{
+			Allowed: allowed[j],
+			Reason:  reason,
+		}
+	}
+
+	return results, nil
+}
+
+// enforceBatchItemInScope decides one scoped check of a batch
+func (s *casbinService) enforceBatchItemInScope(ctx context.Context, req *domain.AuthorizationRequest) *domain.BatchAuthorizationResult {
+	allowed, err := s.enforceInScope(ctx, req.UserID, req.Domain, req.Resource, req.Action, *req.Scope, req.Attributes)
+	if err != nil {
+		return &domain.BatchAuthorizationResult{
+			Reason: "Authorization check failed",
+			Error:  err.Error(),
+		}
+	}
+
+	reason := "Permission granted"
+	if !allowed {
+		reason = "Permission denied"
+	}
+	return &domain.BatchAuthorizationResult{
+		Allowed: allowed,
+		Reason:  reason,
+	}
+}
+
 func (s *casbinService) AssignUserRole(ctx context.Context, userID, roleName string, dom domain.CasbinDomain) error {
 	// Verify user exists
 	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
@@ -124,6 +476,10 @@ func (s *casbinService) AssignUserRole(ctx context.Context, userID, roleName str
 		}
 	}
 
+	if err := s.sod.CheckRoleLink(ctx, userID, roleName, dom); err != nil {
+		return err
+	}
+
 	// Add role in Casbin
 	return s.enforcer.AddRoleForUser(userID, roleName, string(dom))
 }
@@ -136,78 +492,1644 @@ func (s *casbinService) GetUserRolesInDomain(ctx context.Context, userID string,
 	return s.enforcer.GetRolesForUser(userID, string(dom))
 }
 
-func (s *casbinService) AddPolicy(ctx context.Context, role string, dom domain.CasbinDomain, resource, action string) error {
-	return s.enforcer.AddPolicy(role, string(dom), resource, action)
-}
+// GetEffectivePermissions returns what a user holds once role inheritance, domain policies and CMS
+// roles are combined, in one domain or, when dom is empty, in all of them. Each entry is listed
+// once with every policy granting it.
+func (s *casbinService) GetEffectivePermissions(ctx context.Context, userID string, dom domain.CasbinDomain) ([]*domain.EffectivePermission, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	domains := []domain.CasbinDomain{domain.DomainUser, domain.DomainAPI, domain.DomainCMS}
+	if dom != "" {
+		if !dom.IsValid() {
+			return nil, fmt.Errorf("invalid domain: %s", dom)
+		}
+		domains = []domain.CasbinDomain{dom}
+	}
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	index := make(map[[4]string]*domain.EffectivePermission)
+	var permissions []*domain.EffectivePermission
+	addGrant := func(d domain.CasbinDomain, grant casbinPkg.Grant, scope *domain.ResourceScope) {
+		resource, actions := effectiveResourceActions(d, grant.Policy[2], grant.Policy[3])
+		source := domain.PermissionSource{
+			Role:      grant.Policy[0],
+			RoleChain: grant.RoleChain,
+			Condition: grant.Condition,
+			Scope:     scope,
+		}
+
+		for _, action := range actions {
+			key := [4]string{string(d), resource, action, grant.Policy[4]}
+			permission, ok := index[key]
+			if !ok {
+				permission = &domain.EffectivePermission{
+					Domain:   d,
+					Resource: resource,
+					Action:   action,
+					Effect:   domain.PolicyEffect(grant.Policy[4]),
+				}
+				index[key] = permission
+				permissions = append(permissions, permission)
+			}
+			permission.Sources = append(permission.Sources, source)
+		}
+	}
+	for _, d := range domains {
+		for _, grant := range s.enforcer.Grants(userID, string(d)) {
+			addGrant(d, grant, nil)
+		}
+		if d != domain.DomainCMS {
+			continue
+		}
+		// CMS roles held within resource scopes have no role links
+		scoped, err := s.activeScopedCMSRoles(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, assignment := range scoped {
+			for _, grant := range s.enforcer.Grants(assignment.CMSRoleName, string(d)) {
+				grant.RoleChain = append([]string{userID}, grant.RoleChain...)
+				addGrant(d, grant, &assignment.Scope)
+			}
+		}
+	}
 
-func (s *casbinService) RemovePolicy(ctx context.Context, role string, dom domain.CasbinDomain, resource, action string) error {
-	return s.enforcer.RemovePolicy(role, string(dom), resource, action)
+	sort.SliceStable(permissions, func(i, j int) bool {
+		a, b := permissions[i], permissions[j]
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		if a.Action != b.Action {
+			return a.Action < b.Action
+		}
+		return a.Effect < b.Effect
+	})
+	return permissions, nil
 }
 
-func (s *casbinService) GetPoliciesForRole(ctx context.Context, role string, dom domain.CasbinDomain) ([][]string, error) {
-	return s.enforcer.GetPermissionsForUser(role, string(dom))
+// ListAPIAccessHolders returns the users and roles allowed to call an API, given as a registered
+// API resource or as a raw path and method. Paths may be patterns such as /api/v1/products/*.
+func (s *casbinService) ListAPIAccessHolders(ctx context.Context, apiResourceID, apiPath, method string, page, pageSize int) ([]*domain.AccessHolder, int, error) {
+	if apiResourceID != "" {
+		resource, err := s.apiResourceRepo.GetAPIResourceByID(ctx, apiResourceID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("API resource not found: %w", err)
+		}
+		apiPath, method = resource.Path, resource.Method
+	}
+	if apiPath == "" || method == "" {
+		return nil, 0, fmt.Errorf("API resource ID, or path and method, are required")
+	}
+
+	return s.accessHolders(ctx, domain.DomainAPI, apiPath, strings.ToUpper(method), page, pageSize)
 }
 
-func (s *casbinService) CreateCMSRole(ctx context.Context, name, description string, tabs []domain.CMSTab) (*domain.CMSRole, error) {
-	cmsRole := &domain.CMSRole{
-		ID:          uuid.New().String(),
-		Name:        name,
-		Description: description,
-		Tabs:        tabs,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+// ListCMSAccessHolders returns the users and roles allowed an action on a CMS tab; the action
+// defaults to view, i.e. who can open the tab
+func (s *casbinService) ListCMSAccessHolders(ctx context.Context, cmsTab domain.CMSTab, action string, page, pageSize int) ([]*domain.AccessHolder, int, error) {
+	if action == "" {
+		action = string(domain.CMSActionView)
+	}
+	resource, action, err := s.cmsAccessRequest(ctx, cmsTab, action)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if err := s.cmsRepo.CreateCMSRole(ctx, cmsRole); err != nil {
-		return nil, fmt.Errorf("failed to create CMS role: %w", err)
+	return s.accessHolders(ctx, domain.DomainCMS, resource, action, page, pageSize)
+}
+
+// accessHolders pages through the subjects allowed a request, telling users and roles apart. In
+// the cms domain it includes users allowed only within resource scopes, once per scope.
+func (s *casbinService) accessHolders(ctx context.Context, dom domain.CasbinDomain, resource, action string, page, pageSize int) ([]*domain.AccessHolder, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
 	}
 
-	// Add Casbin policies for each tab
-	for _, tab := range tabs {
-		resource := fmt.Sprintf("/cms/%s/*", string(tab))
-		// Default: allow GET (read) access
-		if err := s.enforcer.AddPolicy(name, string(domain.DomainCMS), resource, "GET"); err != nil {
-			return nil, fmt.Errorf("failed to add policy for tab %s: %w", tab, err)
+	var holders []*domain.AccessHolder
+	for _, holder := range s.enforcer.AccessHolders(string(dom), resource, action) {
+		holders = append(holders, newAccessHolder(holder, nil))
+	}
+	if dom == domain.DomainCMS {
+		scoped, err := s.scopedAccessHolders(ctx, resource, action)
+		if err != nil {
+			return nil, 0, err
 		}
+		holders = append(holders, scoped...)
+		sort.SliceStable(holders, func(i, j int) bool { return holders[i].Subject < holders[j].Subject })
 	}
+	total := len(holders)
 
-	return cmsRole, nil
+	start := (page - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	subjects := make([]string, 0, end-start)
+	for _, holder := range holders[start:end] {
+		subjects = append(subjects, holder.Subject)
+	}
+	users, err := s.userRepo.GetExistingUserIDs(ctx, subjects)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := holders[start:end]
+	for _, holder := range result {
+		holder.Kind = domain.AccessHolderRole
+		if users[holder.Subject] {
+			holder.Kind = domain.AccessHolderUser
+		}
+	}
+	return result, total, nil
 }
 
-func (s *casbinService) AssignCMSRole(ctx context.Context, userID, cmsRoleID string) error {
-	// Get CMS role
-	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+// scopedAccessHolders returns the users allowed a CMS request only through CMS roles they hold
+// within resource scopes, with the scope they hold them in
+func (s *casbinService) scopedAccessHolders(ctx context.Context, resource, action string) ([]*domain.AccessHolder, error) {
+	assignments, err := s.activeScopedCMSRoles(ctx, "")
 	if err != nil {
-		return fmt.Errorf("CMS role not found: %w", err)
+		return nil, err
 	}
 
-	// Assign in database
-	if err := s.cmsRepo.AssignCMSRoleToUser(ctx, userID, cmsRoleID); err != nil {
-		return fmt.Errorf("failed to assign CMS role: %w", err)
+	var scopes []domain.ResourceScope
+	rolesByScope := make(map[domain.ResourceScope]map[string][]string)
+	for _, assignment := range assignments {
+		if rolesByScope[assignment.Scope] == nil {
+			rolesByScope[assignment.Scope] = make(map[string][]string)
+			scopes = append(scopes, assignment.Scope)
+		}
+		roles := rolesByScope[assignment.Scope]
+		roles[assignment.UserID] = append(roles[assignment.UserID], assignment.CMSRoleName)
 	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].String() < scopes[j].String() })
 
-	// Add role in Casbin
-	return s.enforcer.AddRoleForUser(userID, cmsRole.Name, string(domain.DomainCMS))
+	var holders []*domain.AccessHolder
+	for _, scope := range scopes {
+		for _, holder := range s.enforcer.AccessHoldersThroughRoles(string(domain.DomainCMS), resource, action, rolesByScope[scope]) {
+			holders = append(holders, newAccessHolder(holder, &scope))
+		}
+	}
+	return holders, nil
 }
 
-func (s *casbinService) RemoveCMSRole(ctx context.Context, userID, cmsRoleID string) error {
-	// Get CMS role
-	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+// newAccessHolder converts an enforcer access holder; the kind is filled in by the caller
+func newAccessHolder(holder casbinPkg.AccessHolder, scope *domain.ResourceScope) *domain.AccessHolder {
+	return &domain.AccessHolder{
+		Subject:   holder.Subject,
+		Policy:    *toDomainPolicy(holder.Policy),
+		RoleChain: holder.RoleChain,
+		Condition: holder.Condition,
+		Scope:     scope,
+	}
+}
+
+// effectiveResourceActions reports CMS tab policies per tab and CMS action; other policies keep
+// their object and action pattern
+func effectiveResourceActions(dom domain.CasbinDomain, object, action string) (string, []string) {
+	if dom != domain.DomainCMS {
+		return object, []string{action}
+	}
+	tab, ok := domain.ParseCMSTabResource(object)
+	if !ok {
+		return object, []string{action}
+	}
+	cmsActions, ok := domain.ParseCMSActionPattern(action)
+	if !ok {
+		return object, []string{action}
+	}
+
+	actions := make([]string, len(cmsActions))
+	for i, cmsAction := range cmsActions {
+		actions[i] = string(cmsAction)
+	}
+	return string(tab), actions
+}
+
+func (s *casbinService) AddRoleParent(ctx context.Context, role, parent string, dom domain.CasbinDomain) error {
+	if role == "" || parent == "" {
+		return fmt.Errorf("role and parent are required")
+	}
+	if err := s.validateRole(ctx, role, dom); err != nil {
+		return err
+	}
+	if err := s.validateRole(ctx, parent, dom); err != nil {
+		return err
+	}
+	if err := s.sod.CheckRoleLink(ctx, role, parent, dom); err != nil {
+		return err
+	}
+	return s.enforcer.AddRoleInheritance(role, parent, string(dom))
+}
+
+func (s *casbinService) RemoveRoleParent(ctx context.Context, role, parent string, dom domain.CasbinDomain) error {
+	return s.enforcer.RemoveRoleInheritance(role, parent, string(dom))
+}
+
+func (s *casbinService) GetRoleHierarchy(ctx context.Context, role string, dom domain.CasbinDomain) (*domain.RoleHierarchy, error) {
+	if err := s.validateRole(ctx, role, dom); err != nil {
+		return nil, err
+	}
+
+	parents, err := s.enforcer.GetRolesForUser(role, string(dom))
 	if err != nil {
-		return fmt.Errorf("CMS role not found: %w", err)
+		return nil, err
+	}
+	ancestors, err := s.enforcer.GetRoleAncestors(role, string(dom))
+	if err != nil {
+		return nil, err
+	}
+	inheriting, err := s.enforcer.GetRoleDescendants(role, string(dom))
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.enforcer.GetImplicitPermissionsForUser(role, string(dom))
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*domain.Policy, 0, len(rules))
+	for _, rule := range rules {
+		policies = append(policies, toDomainPolicy(rule))
 	}
 
-	// Remove from database
-	if err := s.cmsRepo.RemoveCMSRoleFromUser(ctx, userID, cmsRoleID); err != nil {
-		return fmt.Errorf("failed to remove CMS role: %w", err)
+	// Users are linked to roles the same way roles are; only report roles
+	descendants := make([]string, 0, len(inheriting))
+	for _, subject := range inheriting {
+		if s.validateRole(ctx, subject, dom) == nil {
+			descendants = append(descendants, subject)
+		}
 	}
 
-	// Remove role in Casbin
-	return s.enforcer.DeleteRoleForUser(userID, cmsRole.Name, string(domain.DomainCMS))
+	return &domain.RoleHierarchy{
+		Role:        role,
+		Domain:      dom,
+		Parents:     parents,
+		Ancestors:   ancestors,
+		Descendants: descendants,
+		Policies:    policies,
+	}, nil
 }
 
-func (s *casbinService) GetUserCMSTabs(ctx context.Context, userID string) ([]domain.CMSTab, error) {
-	return s.cmsRepo.GetUserCMSTabs(ctx, userID)
+// validateRole checks that a role is defined for the domain: CMS roles for the cms domain,
+// user/app roles otherwise
+func (s *casbinService) validateRole(ctx context.Context, role string, dom domain.CasbinDomain) error {
+	switch dom {
+	case domain.DomainCMS:
+		if _, err := s.cmsRepo.GetCMSRoleByName(ctx, role); err != nil {
+			return fmt.Errorf("CMS role %s not found: %w", role, err)
+		}
+	case domain.DomainUser, domain.DomainAPI:
+		if _, err := s.roleRepo.GetRoleByName(ctx, role); err != nil {
+			return fmt.Errorf("role %s not found: %w", role, err)
+		}
+	default:
+		return fmt.Errorf("invalid domain: %s", dom)
+	}
+	return nil
+}
+
+func (s *casbinService) AddPolicy(ctx context.Context, role string, dom domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error {
+	if err := validatePolicy(role, dom, resource, action); err != nil {
+		return err
+	}
+	if effect == "" {
+		effect = domain.PolicyEffectAllow
+	}
+	return s.enforcer.AddPolicyWithEffect(role, string(dom), resource, action, string(effect))
+}
+
+func (s *casbinService) RemovePolicy(ctx context.Context, role string, dom domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error {
+	if effect == "" {
+		effect = domain.PolicyEffectAllow
+	}
+	return s.enforcer.RemovePolicyWithEffect(role, string(dom), resource, action, string(effect))
+}
+
+func (s *casbinService) GetPoliciesForRole(ctx context.Context, role string, dom domain.CasbinDomain) ([][]string, error) {
+	return s.enforcer.GetPermissionsForUser(role, string(dom))
+}
+
+func (s *casbinService) ListPolicies(ctx context.Context, filter domain.PolicyFilter, page, pageSize int) ([]*domain.Policy, int, error) {
+	if filter.Domain != "" && !filter.Domain.IsValid() {
+		return nil, 0, fmt.Errorf("invalid domain: %s", filter.Domain)
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	rules := s.enforcer.GetFilteredPolicies(filter.Subject, string(filter.Domain), filter.Resource, filter.Action, string(filter.Effect))
+	total := len(rules)
+
+	start := (page - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	policies := make([]*domain.Policy, 0, end-start)
+	for _, rule := range rules[start:end] {
+		policies = append(policies, toDomainPolicy(rule))
+	}
+	return policies, total, nil
+}
+
+// validatePolicy checks a policy before it is stored: the matcher panics on malformed patterns
+func validatePolicy(subject string, dom domain.CasbinDomain, resource, action string) error {
+	if strings.TrimSpace(subject) == "" {
+		return fmt.Errorf("subject is required")
+	}
+	if !dom.IsValid() {
+		return fmt.Errorf("invalid domain: %s", dom)
+	}
+	if err := casbinPkg.ValidateObjectPattern(resource); err != nil {
+		return err
+	}
+	return casbinPkg.ValidateActionPattern(action)
+}
+
+// toDomainPolicy converts a policy rule (sub, dom, obj, act[, eft]) to domain.Policy
+func toDomainPolicy(rule []string) *domain.Policy {
+	policy := &domain.Policy{
+		Subject:  rule[0],
+		Domain:   domain.CasbinDomain(rule[1]),
+		Resource: rule[2],
+		Action:   rule[3],
+		Effect:   domain.PolicyEffectAllow,
+	}
+	if len(rule) > 4 && rule[4] != "" {
+		policy.Effect = domain.PolicyEffect(rule[4])
+	}
+	return policy
+}
+
+func (s *casbinService) ExportPolicies(ctx context.Context, format domain.PolicyBundleFormat) ([]byte, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+
+	bundle, err := s.livePolicyBundle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Sort()
+	return domain.EncodePolicyBundle(bundle, format)
+}
+
+// ImportPolicies replaces the live policies, role links and CMS tab APIs with the bundle's.
+// Sections missing from the bundle are left alone. Role links only link roles: users are assigned
+// through the assignment tables, so their links are neither exported nor replaced. With dryRun
+// the diff is returned without applying it; otherwise the whole diff is applied in one transaction.
+func (s *casbinService) ImportPolicies(ctx context.Context, data []byte, format domain.PolicyBundleFormat, dryRun bool) (*domain.PolicyBundleDiff, error) {
+	if !format.IsValid() {
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+
+	desired, err := domain.ParsePolicyBundle(data, format)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validatePolicyBundle(ctx, desired); err != nil {
+		return nil, err
+	}
+
+	current, err := s.livePolicyBundle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff := domain.DiffPolicyBundles(current, desired)
+	if err := s.checkBundleRoleLinks(ctx, diff); err != nil {
+		return nil, err
+	}
+	if dryRun || diff.IsEmpty() {
+		return diff, nil
+	}
+
+	changes := &casbinPkg.RuleChanges{
+		AddPolicies:    policyRules(diff.AddedPolicies),
+		RemovePolicies: policyRules(diff.RemovedPolicies),
+		AddLinks:       roleLinkRules(diff.AddedRoleLinks),
+		RemoveLinks:    roleLinkRules(diff.RemovedRoleLinks),
+	}
+	err = s.enforcer.ApplyRuleChanges(ctx, changes, func(tx *sql.Tx) error {
+		return s.cmsRepo.ApplyCMSTabAPIChangesTx(ctx, tx, diff.AddedCMSTabAPIs, diff.RemovedCMSTabAPIs)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply policy bundle: %w", err)
+	}
+	return diff, nil
+}
+
+// checkBundleRoleLinks checks the role links a bundle diff leaves in place the way single role
+// inheritance changes are checked: they must not form a cycle or let anyone hold roles a static
+// SoD constraint keeps apart
+func (s *casbinService) checkBundleRoleLinks(ctx context.Context, diff *domain.PolicyBundleDiff) error {
+	if len(diff.AddedRoleLinks) == 0 && len(diff.RemovedRoleLinks) == 0 {
+		return nil
+	}
+
+	_, live := s.enforcer.ExportRules()
+	removed := make(map[[3]string]bool, len(diff.RemovedRoleLinks))
+	for _, g := range diff.RemovedRoleLinks {
+		removed[[3]string{g.Member, g.Role, string(g.Domain)}] = true
+	}
+	links := make([][]string, 0, len(live)+len(diff.AddedRoleLinks))
+	for _, link := range live {
+		if !removed[[3]string{link[0], link[1], link[2]}] {
+			links = append(links, link)
+		}
+	}
+	links = append(links, roleLinkRules(diff.AddedRoleLinks)...)
+
+	// The live links have no cycle, so any cycle runs through an added link
+	graph := domain.NewRoleGraph(links)
+	for _, g := range diff.AddedRoleLinks {
+		if graph.Held(g.Role, g.Domain)[g.Member] {
+			return fmt.Errorf("invalid role link %s, %s, %s: role %s already inherits %s; the link would create a cycle",
+				g.Member, g.Role, g.Domain, g.Role, g.Member)
+		}
+	}
+	return s.sod.CheckRoleLinks(ctx, links)
+}
+
+// livePolicyBundle reads every policy, role inheritance link and CMS tab API currently in effect
+func (s *casbinService) livePolicyBundle(ctx context.Context) (*domain.PolicyBundle, error) {
+	rules, links := s.enforcer.ExportRules()
+	apis, err := s.cmsRepo.ListCMSTabAPIs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CMS tab APIs: %w", err)
+	}
+	users, err := s.linkedUsers(ctx, roleLinkMembers(links))
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &domain.PolicyBundle{
+		Policies:   make([]domain.Policy, 0, len(rules)),
+		RoleLinks:  make([]domain.RoleLink, 0, len(links)),
+		CMSTabAPIs: apis,
+	}
+	for _, rule := range rules {
+		bundle.Policies = append(bundle.Policies, *toDomainPolicy(rule))
+	}
+	for _, link := range links {
+		if users[link[0]] {
+			continue
+		}
+		bundle.RoleLinks = append(bundle.RoleLinks, domain.RoleLink{
+			Member: link[0],
+			Role:   link[1],
+			Domain: domain.CasbinDomain(link[2]),
+		})
+	}
+	if bundle.CMSTabAPIs == nil {
+		bundle.CMSTabAPIs = []domain.CMSTabAPI{}
+	}
+	return bundle, nil
+}
+
+// validatePolicyBundle checks every entry of an imported bundle before anything is diffed
+func (s *casbinService) validatePolicyBundle(ctx context.Context, bundle *domain.PolicyBundle) error {
+	if err := validatePolicyRules(bundle.Policies, bundle.RoleLinks); err != nil {
+		return err
+	}
+
+	// Users are assigned roles through the assignment tables, which a bundle does not write
+	users, err := s.linkedUsers(ctx, roleLinkMembers(roleLinkRules(bundle.RoleLinks)))
+	if err != nil {
+		return err
+	}
+	for _, g := range bundle.RoleLinks {
+		if users[g.Member] {
+			return fmt.Errorf("invalid role link %s, %s, %s: %s is a user; assign users roles through the role assignment APIs",
+				g.Member, g.Role, g.Domain, g.Member)
+		}
+	}
+
+	if len(bundle.CMSTabAPIs) == 0 {
+		return nil
+	}
+	tabs, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return err
+	}
+	registered := make(map[domain.CMSTab]bool, len(tabs))
+	for _, tab := range tabs {
+		registered[tab.Key] = true
+	}
+	for _, api := range bundle.CMSTabAPIs {
+		if !registered[api.Tab] {
+			return fmt.Errorf("invalid CMS tab API %s %s: CMS tab %s is not registered", api.Method, api.Path, api.Tab)
+		}
+		if !strings.HasPrefix(api.Path, "/") || strings.TrimSpace(api.Method) == "" {
+			return fmt.Errorf("invalid CMS tab API %s %s: path must start with / and method is required", api.Method, api.Path)
+		}
+	}
+	return nil
+}
+
+// validatePolicyRules checks policies, including their effect, and role links given in bulk
+func validatePolicyRules(policies []domain.Policy, links []domain.RoleLink) error {
+	for _, p := range policies {
+		if err := validatePolicy(p.Subject, p.Domain, p.Resource, p.Action); err != nil {
+			return fmt.Errorf("invalid policy %s, %s, %s, %s: %w", p.Subject, p.Domain, p.Resource, p.Action, err)
+		}
+		if p.Effect != domain.PolicyEffectAllow && p.Effect != domain.PolicyEffectDeny {
+			return fmt.Errorf("invalid policy %s, %s, %s, %s: invalid effect %q", p.Subject, p.Domain, p.Resource, p.Action, p.Effect)
+		}
+	}
+
+	for _, g := range links {
+		if strings.TrimSpace(g.Member) == "" || strings.TrimSpace(g.Role) == "" {
+			return fmt.Errorf("invalid role link %s, %s, %s: member and role are required", g.Member, g.Role, g.Domain)
+		}
+		if g.Member == g.Role {
+			return fmt.Errorf("invalid role link %s, %s, %s: a role cannot inherit itself", g.Member, g.Role, g.Domain)
+		}
+		if !g.Domain.IsValid() {
+			return fmt.Errorf("invalid role link %s, %s, %s: invalid domain", g.Member, g.Role, g.Domain)
+		}
+	}
+	return nil
+}
+
+// linkedUsers returns which of the role link members are users
+func (s *casbinService) linkedUsers(ctx context.Context, members []string) (map[string]bool, error) {
+	if len(members) == 0 {
+		return map[string]bool{}, nil
+	}
+	users, err := s.userRepo.GetExistingUserIDs(ctx, members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up role link members: %w", err)
+	}
+	return users, nil
+}
+
+// roleLinkMembers returns the members of (member, role, dom) links
+func roleLinkMembers(links [][]string) []string {
+	members := make([]string, 0, len(links))
+	for _, link := range links {
+		members = append(members, link[0])
+	}
+	return members
+}
+
+// policyRules converts policies to (sub, dom, obj, act, eft) rules
+func policyRules(policies []domain.Policy) [][]string {
+	rules := make([][]string, 0, len(policies))
+	for _, p := range policies {
+		rules = append(rules, []string{p.Subject, string(p.Domain), p.Resource, p.Action, string(p.Effect)})
+	}
+	return rules
+}
+
+// roleLinkRules converts role links to (member, role, dom) rules
+func roleLinkRules(links []domain.RoleLink) [][]string {
+	rules := make([][]string, 0, len(links))
+	for _, g := range links {
+		rules = append(rules, []string{g.Member, g.Role, string(g.Domain)})
+	}
+	return rules
+}
+
+// SimulatePolicyChanges evaluates a change set against an in-memory copy of the enforcer and
+// reports which users would gain or lose access to registered API resources and CMS tabs.
+// Nothing is stored. Conditional policies are evaluated without request attributes.
+func (s *casbinService) SimulatePolicyChanges(ctx context.Context, changes *domain.PolicyChangeSet) (*domain.PolicySimulation, error) {
+	for i := range changes.AddPolicies {
+		if changes.AddPolicies[i].Effect == "" {
+			changes.AddPolicies[i].Effect = domain.PolicyEffectAllow
+		}
+	}
+	for i := range changes.RemovePolicies {
+		if changes.RemovePolicies[i].Effect == "" {
+			changes.RemovePolicies[i].Effect = domain.PolicyEffectAllow
+		}
+	}
+	if err := validatePolicyRules(changes.AddPolicies, changes.AddRoleLinks); err != nil {
+		return nil, err
+	}
+
+	sandbox, err := s.enforcer.Simulate(&casbinPkg.RuleChanges{
+		AddPolicies:    policyRules(changes.AddPolicies),
+		RemovePolicies: policyRules(changes.RemovePolicies),
+		AddLinks:       roleLinkRules(changes.AddRoleLinks),
+		RemoveLinks:    roleLinkRules(changes.RemoveRoleLinks),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := s.simulationUsers(ctx, s.enforcer, sandbox)
+	resources, err := s.ListAPIResources(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API resources: %w", err)
+	}
+	tabs, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every request a user could make: API resources by method, CMS tabs by action
+	var requests []domain.AccessChange
+	for _, resource := range resources {
+		requests = append(requests, domain.AccessChange{Domain: domain.DomainAPI, Resource: resource.Path, Action: resource.Method})
+	}
+	for _, tab := range tabs {
+		for _, action := range domain.AllCMSActions() {
+			requests = append(requests, domain.AccessChange{Domain: domain.DomainCMS, Resource: string(tab.Key), Action: string(action)})
+		}
+	}
+
+	result := &domain.PolicySimulation{
+		Gained: []domain.AccessChange{},
+		Lost:   []domain.AccessChange{},
+	}
+	for _, userID := range users {
+		for _, req := range requests {
+			object := req.Resource
+			if req.Domain == domain.DomainCMS {
+				object = domain.CMSTabResource(domain.CMSTab(req.Resource))
+			}
+
+			before, err := s.enforcer.Enforce(userID, string(req.Domain), object, req.Action)
+			if err != nil {
+				return nil, fmt.Errorf("failed to enforce policy: %w", err)
+			}
+			after, err := sandbox.Enforce(userID, string(req.Domain), object, req.Action)
+			if err != nil {
+				return nil, fmt.Errorf("failed to enforce simulated policy: %w", err)
+			}
+
+			req.UserID = userID
+			switch {
+			case after && !before:
+				result.Gained = append(result.Gained, req)
+			case before && !after:
+				result.Lost = append(result.Lost, req)
+			}
+		}
+	}
+	return result, nil
+}
+
+// simulationUsers returns the users that hold a role or a policy before or after a simulated change.
+// Subjects that are roles themselves are left out.
+func (s *casbinService) simulationUsers(ctx context.Context, enforcers ...*casbinPkg.Enforcer) []string {
+	roles := make(map[string]bool)
+	var subjects []string
+	for _, e := range enforcers {
+		policies, links := e.ExportRules()
+		for _, link := range links {
+			roles[link[1]] = true
+			subjects = append(subjects, link[0])
+		}
+		for _, policy := range policies {
+			subjects = append(subjects, policy[0])
+		}
+	}
+
+	seen := make(map[string]bool)
+	var users []string
+	for _, subject := range subjects {
+		if roles[subject] || seen[subject] {
+			continue
+		}
+		seen[subject] = true
+		if _, err := s.userRepo.GetUserByID(ctx, subject); err != nil {
+			// Not a user: a role without members or a subject of another kind
+			continue
+		}
+		users = append(users, subject)
+	}
+	sort.Strings(users)
+	return users
+}
+
+func (s *casbinService) SetPolicyCondition(ctx context.Context, condition *domain.PolicyCondition) (*domain.PolicyCondition, error) {
+	if condition.Subject == "" || condition.Domain == "" || condition.Resource == "" || condition.Action == "" {
+		return nil, fmt.Errorf("subject, domain, resource and action are required")
+	}
+	if strings.TrimSpace(condition.Expression) == "" {
+		return nil, fmt.Errorf("expression is required")
+	}
+	effect := condition.Effect
+	if effect == "" {
+		effect = domain.PolicyEffectAllow
+	}
+
+	saved, err := s.enforcer.SetPolicyCondition(
+		condition.Subject,
+		string(condition.Domain),
+		condition.Resource,
+		condition.Action,
+		string(effect),
+		condition.Expression,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPolicyCondition(saved), nil
+}
+
+func (s *casbinService) RemovePolicyCondition(ctx context.Context, subject string, dom domain.CasbinDomain, resource, action string, effect domain.PolicyEffect) error {
+	if effect == "" {
+		effect = domain.PolicyEffectAllow
+	}
+	return s.enforcer.RemovePolicyCondition(subject, string(dom), resource, action, string(effect))
+}
+
+func (s *casbinService) ListPolicyConditions(ctx context.Context, dom domain.CasbinDomain) ([]*domain.PolicyCondition, error) {
+	conditions := s.enforcer.ListPolicyConditions(string(dom))
+	result := make([]*domain.PolicyCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, toDomainPolicyCondition(condition))
+	}
+	return result, nil
+}
+
+func toDomainPolicyCondition(condition *casbinPkg.PolicyCondition) *domain.PolicyCondition {
+	return &domain.PolicyCondition{
+		Subject:    condition.Subject,
+		Domain:     domain.CasbinDomain(condition.Domain),
+		Resource:   condition.Object,
+		Action:     condition.Action,
+		Effect:     domain.PolicyEffect(condition.Effect),
+		Expression: condition.Expression,
+		CreatedAt:  condition.CreatedAt,
+		UpdatedAt:  condition.UpdatedAt,
+	}
+}
+
+func (s *casbinService) CreateCMSRole(ctx context.Context, name, description string, permissions []domain.CMSTabPermission) (*domain.CMSRole, error) {
+	if name == "" {
+		return nil, fmt.Errorf("CMS role name is required")
+	}
+
+	permissions, err := normalizeCMSPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateCMSTabs(ctx, permissions); err != nil {
+		return nil, err
+	}
+
+	cmsRole := &domain.CMSRole{
+		ID:          uuid.New().String(),
+		Name:        name,
+		Description: description,
+		Tabs:        cmsPermissionTabs(permissions),
+		Permissions: permissions,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := s.cmsRepo.CreateCMSRole(ctx, cmsRole); err != nil {
+		return nil, fmt.Errorf("failed to create CMS role: %w", err)
+	}
+
+	// Add Casbin policies for each tab
+	if err := s.addCMSRolePolicies(ctx, cmsRole); err != nil {
+		return nil, err
+	}
+
+	return cmsRole, nil
+}
+
+// UpdateCMSRole updates the fields that are set: an empty name, a nil description and empty
+// permissions leave the stored values unchanged.
+func (s *casbinService) UpdateCMSRole(ctx context.Context, cmsRoleID, name string, description *string, permissions []domain.CMSTabPermission) (*domain.CMSRole, error) {
+	if cmsRoleID == "" {
+		return nil, fmt.Errorf("CMS role ID is required")
+	}
+
+	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+	if err != nil {
+		return nil, fmt.Errorf("CMS role not found: %w", err)
+	}
+	oldName := cmsRole.Name
+
+	// Update role fields
+	if name != "" {
+		cmsRole.Name = name
+	}
+	if description != nil {
+		cmsRole.Description = *description
+	}
+	if len(permissions) > 0 {
+		permissions, err = normalizeCMSPermissions(permissions)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateCMSTabs(ctx, permissions); err != nil {
+			return nil, err
+		}
+		cmsRole.Permissions = permissions
+		cmsRole.Tabs = cmsPermissionTabs(permissions)
+	}
+	cmsRole.UpdatedAt = time.Now()
+
+	// The role's tab grants follow the stored permissions in the same transaction as the row
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes := cmsRoleTabPolicyChanges(s.enforcer, oldName, cmsRole, registry)
+
+	if cmsRole.Name == oldName {
+		err := s.enforcer.ApplyRuleChanges(ctx, changes, func(tx *sql.Tx) error {
+			return s.cmsRepo.UpdateCMSRoleTx(ctx, tx, cmsRole)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update CMS role: %w", err)
+		}
+	} else {
+		if existing, err := s.cmsRepo.GetCMSRoleByName(ctx, cmsRole.Name); err == nil && existing.ID != cmsRole.ID {
+			return nil, fmt.Errorf("CMS role %s already exists", cmsRole.Name)
+		}
+
+		// Casbin references roles by name: rewrite the role's policies and links with the row
+		err := s.enforcer.RenameRole(ctx, oldName, cmsRole.Name, []string{string(domain.DomainCMS)}, func(tx *sql.Tx) error {
+			if err := s.enforcer.WriteRuleChanges(ctx, tx, changes); err != nil {
+				return err
+			}
+			return s.cmsRepo.UpdateCMSRoleTx(ctx, tx, cmsRole)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to rename CMS role: %w", err)
+		}
+	}
+
+	return cmsRole, nil
+}
+
+// DeleteCMSRole deletes a CMS role together with its Casbin policies and links. A role that users
+// still hold is only deleted with cascade; otherwise a RoleInUseError reports the impact. The
+// returned impact lists the rules that were removed.
+func (s *casbinService) DeleteCMSRole(ctx context.Context, cmsRoleID string, cascade bool) (*domain.RoleImpact, error) {
+	if cmsRoleID == "" {
+		return nil, fmt.Errorf("CMS role ID is required")
+	}
+
+	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+	if err != nil {
+		return nil, fmt.Errorf("CMS role not found: %w", err)
+	}
+
+	cmsDomains := []domain.CasbinDomain{domain.DomainCMS}
+	impact := newRoleImpact(s.enforcer, cmsRole.Name, cmsDomains)
+	if len(impact.Members) > 0 && !cascade {
+		return nil, &domain.RoleInUseError{Impact: impact}
+	}
+
+	// Database user assignments cascade with the row
+	err = s.enforcer.DeleteRole(ctx, cmsRole.Name, roleDomains(cmsDomains), func(tx *sql.Tx) error {
+		return s.cmsRepo.DeleteCMSRoleTx(ctx, tx, cmsRoleID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete CMS role: %w", err)
+	}
+	return impact, nil
+}
+
+func (s *casbinService) GetCMSRole(ctx context.Context, cmsRoleID string) (*domain.CMSRole, error) {
+	if cmsRoleID == "" {
+		return nil, fmt.Errorf("CMS role ID is required")
+	}
+
+	return s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+}
+
+// addCMSRolePolicies adds one Casbin policy per tab covering the tab's granted actions.
+// Permissions are expanded over the tab hierarchy so child tabs inherit their parent's grant.
+func (s *casbinService) addCMSRolePolicies(ctx context.Context, cmsRole *domain.CMSRole) error {
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range domain.ExpandCMSPermissions(cmsRole.Permissions, registry) {
+		// A tab narrowed to no actions only stops the inherited grant
+		if len(perm.Actions) == 0 {
+			continue
+		}
+		resource := domain.CMSTabResource(perm.Tab)
+		if err := s.enforcer.AddPolicy(cmsRole.Name, string(domain.DomainCMS), resource, perm.ActionPattern()); err != nil {
+			return fmt.Errorf("failed to add policy for tab %s: %w", perm.Tab, err)
+		}
+	}
+	return nil
+}
+
+// cmsRoleTabPolicyChanges diffs the tab grants a CMS role's permissions generate against those it
+// holds in Casbin under current, its name before a rename. Tab grants are the role's allow policies
+// on CMS tab resources; its deny policies and policies on other resources are left alone. The
+// changes use the role's new name.
+func cmsRoleTabPolicyChanges(enforcer *casbinPkg.Enforcer, current string, cmsRole *domain.CMSRole, registry []*domain.CMSTabDefinition) *casbinPkg.RuleChanges {
+	cms := string(domain.DomainCMS)
+
+	var grants [][2]string
+	desired := make(map[[2]string]bool)
+	for _, perm := range domain.ExpandCMSPermissions(cmsRole.Permissions, registry) {
+		// A tab narrowed to no actions only stops the inherited grant
+		if len(perm.Actions) == 0 {
+			continue
+		}
+		grant := [2]string{domain.CMSTabResource(perm.Tab), perm.ActionPattern()}
+		if !desired[grant] {
+			desired[grant] = true
+			grants = append(grants, grant)
+		}
+	}
+
+	changes := &casbinPkg.RuleChanges{}
+	held := make(map[[2]string]bool)
+	for _, rule := range enforcer.GetFilteredPolicies(current, cms, "", "", casbinPkg.EffectAllow) {
+		if _, ok := domain.ParseCMSTabResource(rule[2]); !ok {
+			continue
+		}
+		grant := [2]string{rule[2], rule[3]}
+		held[grant] = true
+		if !desired[grant] {
+			changes.RemovePolicies = append(changes.RemovePolicies,
+				[]string{cmsRole.Name, cms, grant[0], grant[1], casbinPkg.EffectAllow})
+		}
+	}
+	for _, grant := range grants {
+		if !held[grant] {
+			changes.AddPolicies = append(changes.AddPolicies,
+				[]string{cmsRole.Name, cms, grant[0], grant[1], casbinPkg.EffectAllow})
+		}
+	}
+	return changes
+}
+
+// resyncCMSRolePolicies rebuilds the Casbin policies of every CMS role.
+// It runs after the tab hierarchy changes so inherited grants follow the new tree.
+func (s *casbinService) resyncCMSRolePolicies(ctx context.Context) error {
+	var roles []*domain.CMSRole
+	for page := 1; ; page++ {
+		batch, total, err := s.cmsRepo.ListCMSRoles(ctx, page, cmsRoleResyncPageSize)
+		if err != nil {
+			return err
+		}
+		roles = append(roles, batch...)
+		if len(batch) < cmsRoleResyncPageSize || len(roles) >= total {
+			break
+		}
+	}
+
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only the roles' tab grants change, all in one transaction
+	changes := &casbinPkg.RuleChanges{}
+	for _, role := range roles {
+		roleChanges := cmsRoleTabPolicyChanges(s.enforcer, role.Name, role, registry)
+		changes.AddPolicies = append(changes.AddPolicies, roleChanges.AddPolicies...)
+		changes.RemovePolicies = append(changes.RemovePolicies, roleChanges.RemovePolicies...)
+	}
+	if len(changes.AddPolicies) == 0 && len(changes.RemovePolicies) == 0 {
+		return nil
+	}
+	if err := s.enforcer.ApplyRuleChanges(ctx, changes, nil); err != nil {
+		return fmt.Errorf("failed to resync CMS role policies: %w", err)
+	}
+	return nil
+}
+
+// validateCMSTabs ensures every tab in the permissions is registered
+func (s *casbinService) validateCMSTabs(ctx context.Context, permissions []domain.CMSTabPermission) error {
+	for _, perm := range permissions {
+		if _, err := s.cmsRepo.GetCMSTab(ctx, perm.Tab); err != nil {
+			return fmt.Errorf("invalid CMS tab %s: %w", perm.Tab, err)
+		}
+	}
+	return nil
+}
+
+// normalizeCMSPermissions validates tab permissions, merging duplicate tabs and actions.
+// A tab granted without actions is view-only; an explicit empty list grants no actions.
+func normalizeCMSPermissions(permissions []domain.CMSTabPermission) ([]domain.CMSTabPermission, error) {
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("at least one CMS tab is required")
+	}
+
+	index := make(map[domain.CMSTab]int)
+	result := make([]domain.CMSTabPermission, 0, len(permissions))
+	for _, perm := range permissions {
+		if perm.Tab == "" {
+			return nil, fmt.Errorf("CMS tab name is required")
+		}
+
+		actions := perm.Actions
+		if actions == nil {
+			actions = []domain.CMSAction{domain.CMSActionView}
+		}
+		for _, action := range actions {
+			if !action.IsValid() {
+				return nil, fmt.Errorf("invalid CMS action %q for tab %s", action, perm.Tab)
+			}
+		}
+
+		i, ok := index[perm.Tab]
+		if !ok {
+			index[perm.Tab] = len(result)
+			result = append(result, domain.CMSTabPermission{Tab: perm.Tab, Actions: []domain.CMSAction{}})
+			i = len(result) - 1
+		}
+		result[i].Actions = append(result[i].Actions, actions...)
+	}
+
+	// Keep actions unique and in canonical order
+	for i := range result {
+		granted := make(map[domain.CMSAction]bool)
+		for _, action := range result[i].Actions {
+			granted[action] = true
+		}
+		result[i].Actions = result[i].Actions[:0]
+		for _, action := range domain.AllCMSActions() {
+			if granted[action] {
+				result[i].Actions = append(result[i].Actions, action)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// cmsPermissionTabs returns the tab names covered by the given permissions
+func cmsPermissionTabs(permissions []domain.CMSTabPermission) []domain.CMSTab {
+	tabs := make([]domain.CMSTab, len(permissions))
+	for i, perm := range permissions {
+		tabs[i] = perm.Tab
+	}
+	return tabs
+}
+
+// AssignCMSRole assigns a CMS role to a user, limited to the window if it has bounds. Assigning a
+// role the user already holds replaces its window.
+func (s *casbinService) AssignCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope, window domain.AssignmentWindow) error {
+	if err := window.Validate(time.Now()); err != nil {
+		return fmt.Errorf("invalid assignment window: %w", err)
+	}
+	if err := scope.Validate(); err != nil {
+		return fmt.Errorf("invalid scope: %w", err)
+	}
+
+	// Get CMS role
+	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+	if err != nil {
+		return fmt.Errorf("CMS role not found: %w", err)
+	}
+
+	// Scoped assignments are looked up by scoped checks and never become Casbin links
+	if !scope.IsZero() {
+		if err := s.sod.CheckScopedRoleAssignment(ctx, userID, cmsRole.Name, scope); err != nil {
+			return err
+		}
+		if err := s.scopeRepo.AssignScopedCMSRole(ctx, userID, cmsRoleID, scope, window); err != nil {
+			return fmt.Errorf("failed to assign CMS role in scope %s: %w", scope, err)
+		}
+		return nil
+	}
+
+	if err := s.sod.CheckRoleLink(ctx, userID, cmsRole.Name, domain.DomainCMS); err != nil {
+		return err
+	}
+
+	// Assign in database
+	if err := s.cmsRepo.AssignCMSRoleToUser(ctx, userID, cmsRoleID, window); err != nil {
+		return fmt.Errorf("failed to assign CMS role: %w", err)
+	}
+
+	// Load the window before the link exists, so the link never grants outside it
+	if err := s.enforcer.ReloadLinkWindows(); err != nil {
+		return fmt.Errorf("failed to load CMS role assignment window: %w", err)
+	}
+
+	// Add role in Casbin
+	return s.enforcer.AddRoleForUser(userID, cmsRole.Name, string(domain.DomainCMS))
+}
+
+func (s *casbinService) RemoveCMSRole(ctx context.Context, userID, cmsRoleID string, scope domain.ResourceScope) error {
+	// Get CMS role
+	cmsRole, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID)
+	if err != nil {
+		return fmt.Errorf("CMS role not found: %w", err)
+	}
+
+	// Removing a scoped assignment leaves the global one and the other scopes alone
+	if !scope.IsZero() {
+		if err := s.scopeRepo.RemoveScopedCMSRole(ctx, userID, cmsRoleID, scope); err != nil {
+			return fmt.Errorf("failed to remove CMS role in scope %s: %w", scope, err)
+		}
+		return nil
+	}
+
+	// Remove from database
+	if err := s.cmsRepo.RemoveCMSRoleFromUser(ctx, userID, cmsRoleID); err != nil {
+		return fmt.Errorf("failed to remove CMS role: %w", err)
+	}
+
+	// Remove role in Casbin
+	return s.enforcer.DeleteRoleForUser(userID, cmsRole.Name, string(domain.DomainCMS))
+}
+
+// activeScopedCMSRoles returns the scoped CMS role assignments in effect now, of a user or, with
+// an empty userID, of everyone
+func (s *casbinService) activeScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error) {
+	assignments, err := s.scopeRepo.ListUserScopedCMSRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped CMS roles: %w", err)
+	}
+
+	now := time.Now()
+	active := assignments[:0]
+	for _, assignment := range assignments {
+		if assignment.ActiveAt(now) {
+			active = append(active, assignment)
+		}
+	}
+	return active, nil
+}
+
+// ListUserScopedCMSRoles returns the CMS roles assigned to a user within resource scopes
+func (s *casbinService) ListUserScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	return s.scopeRepo.ListUserScopedCMSRoles(ctx, userID)
+}
+
+// userCMSRoles returns the CMS roles assigned to a user together with the roles they inherit
+func (s *casbinService) userCMSRoles(ctx context.Context, userID string) ([]*domain.CMSRole, error) {
+	roles, err := s.cmsRepo.GetUserCMSRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user CMS roles: %w", err)
+	}
+	return s.withInheritedCMSRoles(ctx, roles)
+}
+
+// withInheritedCMSRoles adds the CMS roles the roles inherit
+func (s *casbinService) withInheritedCMSRoles(ctx context.Context, roles []*domain.CMSRole) ([]*domain.CMSRole, error) {
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		seen[role.Name] = true
+	}
+	for _, role := range roles {
+		ancestors, err := s.enforcer.GetRoleAncestors(role.Name, string(domain.DomainCMS))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range ancestors {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			inherited, err := s.cmsRepo.GetCMSRoleByName(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get inherited CMS role %s: %w", name, err)
+			}
+			roles = append(roles, inherited)
+		}
+	}
+	return roles, nil
+}
+
+func (s *casbinService) GetUserCMSTabs(ctx context.Context, userID string) ([]domain.CMSTab, error) {
+	tree, err := s.GetUserCMSTabTree(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.FlattenCMSTabTree(tree), nil
+}
+
+func (s *casbinService) GetUserCMSTabTree(ctx context.Context, userID string) ([]*domain.CMSTabNode, error) {
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.cmsTabActions(ctx, userID, registry, nil, domain.ResourceScope{})
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildCMSTabTree(registry, actions), nil
+}
+
+// cmsTabActions asks the enforcer for every action on every registered tab, so deny policies,
+// conditions and scoped roles apply exactly as they do in CheckCMSAccess. Tabs without actions
+// are left out.
+func (s *casbinService) cmsTabActions(ctx context.Context, userID string, registry []*domain.CMSTabDefinition, attributes map[string]string, scope domain.ResourceScope) (map[domain.CMSTab][]domain.CMSAction, error) {
+	roles, err := s.scopedRoles(ctx, userID, domain.DomainCMS, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[domain.CMSTab][]domain.CMSAction, len(registry))
+	for _, tab := range registry {
+		for _, action := range domain.AllCMSActions() {
+			ok, err := s.enforcer.EnforceWithRoles(userID, roles, string(domain.DomainCMS), domain.CMSTabResource(tab.Key), string(action), attributes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to enforce policy: %w", err)
+			}
+			if ok {
+				result[tab.Key] = append(result[tab.Key], action)
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetCMSCapabilities reports what the user may do on each tab for requests with the given
+// attributes and scope, since conditional and scoped grants depend on them.
+func (s *casbinService) GetCMSCapabilities(ctx context.Context, userID string, attributes map[string]string, scope domain.ResourceScope) (*domain.CMSCapabilityMap, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apis, err := s.cmsRepo.ListCMSTabAPIs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CMS tab APIs: %w", err)
+	}
+	apisByTab := make(map[domain.CMSTab][]domain.CMSTabAPI)
+	for _, api := range apis {
+		apisByTab[api.Tab] = append(apisByTab[api.Tab], api)
+	}
+
+	// Ask the enforcer so the map matches CheckCMSAccess exactly
+	tabActions, err := s.cmsTabActions(ctx, userID, registry, attributes, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := make([]domain.CMSCapability, 0, len(registry))
+	for _, tab := range registry {
+		actions := tabActions[tab.Key]
+		if len(actions) == 0 {
+			continue
+		}
+		allowed := make(map[domain.CMSAction]bool, len(actions))
+		for _, action := range actions {
+			allowed[action] = true
+		}
+
+		tabAPIs := []domain.CMSTabAPI{}
+		for _, api := range apisByTab[tab.Key] {
+			if action, ok := domain.CMSActionFromMethod(api.Method); ok && allowed[action] {
+				tabAPIs = append(tabAPIs, api)
+			}
+		}
+
+		capabilities = append(capabilities, domain.CMSCapability{
+			Tab:         tab.Key,
+			DisplayName: tab.DisplayName,
+			ParentKey:   tab.ParentKey,
+			Actions:     actions,
+			APIs:        tabAPIs,
+		})
+	}
+
+	version, err := cmsCapabilityVersion(capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CMSCapabilityMap{
+		UserID:  userID,
+		Version: version,
+		Tabs:    capabilities,
+	}, nil
+}
+
+// cmsCapabilityVersion hashes the capabilities; the input is already in a stable order
+func cmsCapabilityVersion(capabilities []domain.CMSCapability) (string, error) {
+	data, err := json.Marshal(capabilities)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode CMS capabilities: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func (s *casbinService) SetCMSRoleFieldPermission(ctx context.Context, cmsRoleID, resourceType string, readable, writable []string) (*domain.CMSFieldPermission, error) {
+	if resourceType == "" {
+		return nil, fmt.Errorf("resource type is required")
+	}
+	if _, err := s.cmsRepo.GetCMSRoleByID(ctx, cmsRoleID); err != nil {
+		return nil, fmt.Errorf("CMS role not found: %w", err)
+	}
+
+	readable, err := normalizeFieldNames(readable)
+	if err != nil {
+		return nil, err
+	}
+	writable, err = normalizeFieldNames(writable)
+	if err != nil {
+		return nil, err
+	}
+
+	perm := &domain.CMSFieldPermission{
+		ID:             uuid.New().String(),
+		CMSRoleID:      cmsRoleID,
+		ResourceType:   resourceType,
+		ReadableFields: readable,
+		WritableFields: writable,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	if err := s.cmsRepo.SetCMSFieldPermission(ctx, perm); err != nil {
+		return nil, err
+	}
+
+	return perm, nil
+}
+
+func (s *casbinService) ListCMSRoleFieldPermissions(ctx context.Context, cmsRoleID string) ([]*domain.CMSFieldPermission, error) {
+	return s.cmsRepo.ListCMSFieldPermissions(ctx, cmsRoleID)
+}
+
+func (s *casbinService) DeleteCMSRoleFieldPermission(ctx context.Context, cmsRoleID, resourceType string) error {
+	return s.cmsRepo.DeleteCMSFieldPermission(ctx, cmsRoleID, resourceType)
+}
+
+func (s *casbinService) GetUserFieldPermissions(ctx context.Context, userID, resourceType string) (*domain.FieldPermissionSet, error) {
+	if userID == "" || resourceType == "" {
+		return nil, fmt.Errorf("user ID and resource type are required")
+	}
+
+	roles, err := s.userCMSRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs := make([]string, len(roles))
+	for i, role := range roles {
+		roleIDs[i] = role.ID
+	}
+
+	rules, err := s.cmsRepo.GetCMSFieldPermissions(ctx, roleIDs, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	set := domain.ResolveFieldPermissions(resourceType, roles, rules, registry)
+
+	// CMS roles held within a resource scope add to the global ones there
+	assignments, err := s.activeScopedCMSRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var scopes []domain.ResourceScope
+	roleIDsByScope := make(map[domain.ResourceScope][]string)
+	for _, assignment := range assignments {
+		if _, ok := roleIDsByScope[assignment.Scope]; !ok {
+			scopes = append(scopes, assignment.Scope)
+		}
+		roleIDsByScope[assignment.Scope] = append(roleIDsByScope[assignment.Scope], assignment.CMSRoleID)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].String() < scopes[j].String() })
+
+	for _, scope := range scopes {
+		scopedRoles := append([]*domain.CMSRole(nil), roles...)
+		for _, id := range roleIDsByScope[scope] {
+			role, err := s.cmsRepo.GetCMSRoleByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get scoped CMS role %s: %w", id, err)
+			}
+			scopedRoles = append(scopedRoles, role)
+		}
+		if scopedRoles, err = s.withInheritedCMSRoles(ctx, scopedRoles); err != nil {
+			return nil, err
+		}
+
+		scopedRoleIDs := make([]string, len(scopedRoles))
+		for i, role := range scopedRoles {
+			scopedRoleIDs[i] = role.ID
+		}
+		scopedRules, err := s.cmsRepo.GetCMSFieldPermissions(ctx, scopedRoleIDs, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		fields := domain.ResolveFieldPermissions(resourceType, scopedRoles, scopedRules, registry)
+		set.Scoped = append(set.Scoped, &domain.ScopedFieldPermissionSet{
+			Scope:    scope,
+			Readable: fields.Readable,
+			Writable: fields.Writable,
+		})
+	}
+	return set, nil
+}
+
+// normalizeFieldNames trims field names and drops duplicates
+func normalizeFieldNames(fields []string) ([]string, error) {
+	seen := make(map[string]bool, len(fields))
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return nil, fmt.Errorf("field name cannot be empty")
+		}
+		if !seen[field] {
+			seen[field] = true
+			result = append(result, field)
+		}
+	}
+	return result, nil
+}
+
+func (s *casbinService) CreateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) (*domain.CMSTabDefinition, error) {
+	if !cmsTabKeyPattern.MatchString(string(tab.Key)) {
+		return nil, fmt.Errorf("invalid CMS tab key %q: use lowercase letters, digits, '-' or '_'", tab.Key)
+	}
+	if tab.DisplayName == "" {
+		return nil, fmt.Errorf("CMS tab display name is required")
+	}
+	if err := s.validateCMSTabParent(ctx, tab.Key, tab.ParentKey); err != nil {
+		return nil, err
+	}
+
+	tab.CreatedAt = time.Now()
+	tab.UpdatedAt = time.Now()
+
+	if err := s.cmsRepo.CreateCMSTab(ctx, tab); err != nil {
+		return nil, fmt.Errorf("failed to create CMS tab: %w", err)
+	}
+
+	// Roles granted an ancestor inherit the new tab
+	if tab.ParentKey != "" {
+		if err := s.resyncCMSRolePolicies(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return tab, nil
+}
+
+func (s *casbinService) GetCMSTab(ctx context.Context, key domain.CMSTab) (*domain.CMSTabDefinition, error) {
+	return s.cmsRepo.GetCMSTab(ctx, key)
+}
+
+func (s *casbinService) ListCMSTabs(ctx context.Context) ([]*domain.CMSTabDefinition, error) {
+	return s.cmsRepo.ListCMSTabs(ctx)
+}
+
+func (s *casbinService) UpdateCMSTab(ctx context.Context, tab *domain.CMSTabDefinition) (*domain.CMSTabDefinition, error) {
+	existing, err := s.cmsRepo.GetCMSTab(ctx, tab.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update tab fields
+	if tab.DisplayName != "" {
+		existing.DisplayName = tab.DisplayName
+	}
+	parentChanged := existing.ParentKey != tab.ParentKey
+	existing.Icon = tab.Icon
+	existing.ParentKey = tab.ParentKey
+	existing.SortOrder = tab.SortOrder
+	existing.UpdatedAt = time.Now()
+
+	if err := s.validateCMSTabParent(ctx, existing.Key, existing.ParentKey); err != nil {
+		return nil, err
+	}
+
+	if err := s.cmsRepo.UpdateCMSTab(ctx, existing); err != nil {
+		return nil, fmt.Errorf("failed to update CMS tab: %w", err)
+	}
+
+	// Moving a tab changes which grants it inherits
+	if parentChanged {
+		if err := s.resyncCMSRolePolicies(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return existing, nil
+}
+
+func (s *casbinService) DeleteCMSTab(ctx context.Context, key domain.CMSTab) error {
+	if _, err := s.cmsRepo.GetCMSTab(ctx, key); err != nil {
+		return err
+	}
+
+	// Refuse to orphan roles or child tabs
+	count, err := s.cmsRepo.CountRolesUsingCMSTab(ctx, key)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("CMS tab %s is granted to %d CMS role(s)", key, count)
+	}
+
+	tabs, err := s.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, tab := range tabs {
+		if tab.ParentKey == key {
+			return fmt.Errorf("CMS tab %s has child tab %s", key, tab.Key)
+		}
+	}
+
+	if err := s.cmsRepo.DeleteCMSTab(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete CMS tab: %w", err)
+	}
+	s.tabIndex.invalidate()
+
+	// Drop policies the tab inherited from its ancestors
+	return s.resyncCMSRolePolicies(ctx)
+}
+
+// CreateResourceScope registers a scope, below its parent if it has one. Parents must be
+// registered first and cannot be changed, so the hierarchy has no cycles.
+func (s *casbinService) CreateResourceScope(ctx context.Context, scope *domain.ResourceScopeDefinition) (*domain.ResourceScopeDefinition, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	if scope.Parent != nil {
+		if _, err := s.scopeRepo.GetResourceScope(ctx, *scope.Parent); err != nil {
+			return nil, fmt.Errorf("invalid parent scope: %w", err)
+		}
+	}
+
+	scope.CreatedAt = time.Now()
+	if err := s.scopeRepo.CreateResourceScope(ctx, scope); err != nil {
+		return nil, fmt.Errorf("failed to create resource scope: %w", err)
+	}
+	return scope, nil
+}
+
+func (s *casbinService) ListResourceScopes(ctx context.Context, scopeType string) ([]*domain.ResourceScopeDefinition, error) {
+	return s.scopeRepo.ListResourceScopes(ctx, scopeType)
+}
+
+// DeleteResourceScope unregisters a scope without child scopes. Assignments within the scope are
+// kept but no longer hold in the scopes that were below it.
+func (s *casbinService) DeleteResourceScope(ctx context.Context, scope domain.ResourceScope) error {
+	if _, err := s.scopeRepo.GetResourceScope(ctx, scope); err != nil {
+		return err
+	}
+
+	count, err := s.scopeRepo.CountResourceScopeChildren(ctx, scope)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("resource scope %s has %d child scope(s)", scope, count)
+	}
+
+	if err := s.scopeRepo.DeleteResourceScope(ctx, scope); err != nil {
+		return fmt.Errorf("failed to delete resource scope: %w", err)
+	}
+	return nil
+}
+
+// validateCMSTabParent checks that the parent tab exists and is not the tab itself or one of its descendants
+func (s *casbinService) validateCMSTabParent(ctx context.Context, key, parentKey domain.CMSTab) error {
+	for current := parentKey; current != ""; {
+		if current == key {
+			return fmt.Errorf("CMS tab %s cannot be its own ancestor", key)
+		}
+		parent, err := s.cmsRepo.GetCMSTab(ctx, current)
+		if err != nil {
+			return fmt.Errorf("invalid parent CMS tab %s: %w", current, err)
+		}
+		current = parent.ParentKey
+	}
+	return nil
 }
 
 func (s *casbinService) CreateAPIResource(ctx context.Context, path, method, service, description string) (*domain.APIResource, error) {
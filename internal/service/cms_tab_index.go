@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+)
+
+// cmsTabIndexTTL bounds how long a tab deleted on another instance is still treated as registered
+const cmsTabIndexTTL = time.Minute
+
+// cmsTabIndex keeps the registered CMS tab keys in memory so access checks do not query the
+// database. A key missing from the index reloads it, which picks up tabs registered on other
+// instances.
+type cmsTabIndex struct {
+	cmsRepo repository.CMSRepository
+
+	mu       sync.RWMutex
+	tabs     map[domain.CMSTab]bool
+	loadedAt time.Time
+}
+
+func newCMSTabIndex(cmsRepo repository.CMSRepository) *cmsTabIndex {
+	return &cmsTabIndex{cmsRepo: cmsRepo}
+}
+
+// registered reports whether the tab is in the registry
+func (i *cmsTabIndex) registered(ctx context.Context, tab domain.CMSTab) (bool, error) {
+	i.mu.RLock()
+	known, fresh := i.tabs[tab], time.Since(i.loadedAt) < cmsTabIndexTTL
+	i.mu.RUnlock()
+	if known && fresh {
+		return true, nil
+	}
+
+	registry, err := i.cmsRepo.ListCMSTabs(ctx)
+	if err != nil {
+		return false, err
+	}
+	tabs := make(map[domain.CMSTab]bool, len(registry))
+	for _, def := range registry {
+		tabs[def.Key] = true
+	}
+
+	i.mu.Lock()
+	i.tabs, i.loadedAt = tabs, time.Now()
+	i.mu.Unlock()
+	return tabs[tab], nil
+}
+
+// invalidate drops the index after the registry changed
+func (i *cmsTabIndex) invalidate() {
+	i.mu.Lock()
+	i.tabs = nil
+	i.mu.Unlock()
+}
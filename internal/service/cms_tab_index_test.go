@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+)
+
+// countingCMSTabRepository serves the tab registry and counts how often it is listed
+type countingCMSTabRepository struct {
+	repository.CMSRepository
+	tabs  []*domain.CMSTabDefinition
+	lists int
+}
+
+func (r *countingCMSTabRepository) ListCMSTabs(ctx context.Context) ([]*domain.CMSTabDefinition, error) {
+	r.lists++
+	return r.tabs, nil
+}
+
+func TestCMSTabIndex(t *testing.T) {
+	ctx := context.Background()
+	repo := &countingCMSTabRepository{tabs: []*domain.CMSTabDefinition{{Key: "order"}}}
+	index := newCMSTabIndex(repo)
+
+	for i := 0; i < 3; i++ {
+		registered, err := index.registered(ctx, "order")
+		require.NoError(t, err)
+		assert.True(t, registered)
+	}
+	assert.Equal(t, 1, repo.lists, "known tabs are answered from memory")
+
+	// A tab registered elsewhere is found by reloading on the miss
+	repo.tabs = append(repo.tabs, &domain.CMSTabDefinition{Key: "product"})
+	registered, err := index.registered(ctx, "product")
+	require.NoError(t, err)
+	assert.True(t, registered)
+	assert.Equal(t, 2, repo.lists)
+
+	registered, err = index.registered(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, registered)
+
+	// A deleted tab is gone once the index is invalidated
+	repo.tabs = repo.tabs[:1]
+	index.invalidate()
+	registered, err = index.registered(ctx, "product")
+	require.NoError(t, err)
+	assert.False(t, registered)
+}
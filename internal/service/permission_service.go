@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/tvttt/iam-services/internal/domain"
 	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
 )
 
 // PermissionService handles permission management business logic
@@ -19,12 +20,15 @@ type PermissionService interface {
 
 type permissionService struct {
 	permissionRepo repository.PermissionRepository
+	projection     *rbacProjection
 }
 
-// NewPermissionService creates a new instance of PermissionService
-func NewPermissionService(permissionRepo repository.PermissionRepository) PermissionService {
+// NewPermissionService creates a new instance of PermissionService.
+// Deleted permissions are removed from the roles' Casbin policies through the enforcer.
+func NewPermissionService(permissionRepo repository.PermissionRepository, enforcer *casbinPkg.Enforcer) PermissionService {
 	return &permissionService{
 		permissionRepo: permissionRepo,
+		projection:     newRBACProjection(enforcer, nil),
 	}
 }
 
@@ -32,6 +36,9 @@ func (s *permissionService) CreatePermission(ctx context.Context, name, resource
 	if name == "" || resource == "" || action == "" {
 		return nil, fmt.Errorf("name, resource, and action are required")
 	}
+	if err := validatePermissionTerms(resource, action); err != nil {
+		return nil, err
+	}
 
 	permission := &domain.Permission{
 		ID:          uuid.New().String(),
@@ -55,7 +62,18 @@ func (s *permissionService) DeletePermission(ctx context.Context, permissionID s
 		return fmt.Errorf("permission ID is required")
 	}
 
-	return s.permissionRepo.DeletePermission(ctx, permissionID)
+	permission, err := s.permissionRepo.GetPermissionByID(ctx, permissionID)
+	if err != nil {
+		return fmt.Errorf("failed to get permission: %w", err)
+	}
+
+	if err := s.permissionRepo.DeletePermission(ctx, permissionID); err != nil {
+		return err
+	}
+	if err := s.projection.removePermission(permission); err != nil {
+		return fmt.Errorf("failed to remove permission from Casbin: %w", err)
+	}
+	return nil
 }
 
 func (s *permissionService) ListPermissions(ctx context.Context, page, pageSize int) ([]*domain.Permission, int, error) {
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// permissionTermPattern restricts permission resources and actions to literals that are safe in
+// Casbin objects and action patterns; migration 011 projects the same values
+var permissionTermPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// roleAssignmentDomains are the Casbin domains a relational role assignment is linked in: the user
+// domain holds the projected permissions, the api domain the role's API policies
+var roleAssignmentDomains = []domain.CasbinDomain{domain.DomainUser, domain.DomainAPI}
+
+// rbacProjection projects the relational roles, role_permissions and user_roles, which are the
+// source of truth, into Casbin rules so CheckPermission and Casbin checks agree:
+//   - a role permission becomes p, <role>, user, /permissions/<resource>, ^<action>$
+//   - a role assignment becomes g, <user>, <role>, <dom> in the user and api domains
+type rbacProjection struct {
+	enforcer  *casbinPkg.Enforcer
+	authzRepo repository.AuthorizationRepository
+}
+
+func newRBACProjection(enforcer *casbinPkg.Enforcer, authzRepo repository.AuthorizationRepository) *rbacProjection {
+	return &rbacProjection{
+		enforcer:  enforcer,
+		authzRepo: authzRepo,
+	}
+}
+
+// validatePermissionTerms checks a permission's resource and action before it is stored
+func validatePermissionTerms(resource, action string) error {
+	if !permissionTermPattern.MatchString(resource) {
+		return fmt.Errorf("invalid resource %q: may only use letters, digits, _ and -", resource)
+	}
+	if !permissionTermPattern.MatchString(action) {
+		return fmt.Errorf("invalid action %q: may only use letters, digits, _ and -", action)
+	}
+	return nil
+}
+
+// syncRolePermissions makes the role's projected policies match its permissions
+func (p *rbacProjection) syncRolePermissions(ctx context.Context, role *domain.Role) error {
+	permissions, err := p.authzRepo.GetRolePermissions(ctx, role.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	desired := make(map[[2]string]bool, len(permissions))
+	for _, perm := range permissions {
+		if perm == nil {
+			continue
+		}
+		desired[[2]string{domain.PermissionResource(perm.Resource), domain.PermissionActionPattern(perm.Action)}] = true
+	}
+
+	current := make(map[[2]string]bool)
+	for _, rule := range p.enforcer.GetFilteredPolicies(role.Name, string(domain.DomainUser), "", "", casbinPkg.EffectAllow) {
+		if !strings.HasPrefix(rule[2], domain.PermissionResourcePrefix) {
+			continue
+		}
+		key := [2]string{rule[2], rule[3]}
+		current[key] = true
+		if !desired[key] {
+			if err := p.enforcer.RemovePolicy(role.Name, string(domain.DomainUser), rule[2], rule[3]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for key := range desired {
+		if current[key] {
+			continue
+		}
+		if err := p.enforcer.AddPolicy(role.Name, string(domain.DomainUser), key[0], key[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfill projects a role's permissions and the users assigned to it that have no Casbin rules
+// yet, e.g. the seed data of a fresh database, where casbin_rule only exists once the adapter has
+// created it after the migrations ran
+func (p *rbacProjection) backfill(ctx context.Context, role *domain.Role) error {
+	if err := p.syncRolePermissions(ctx, role); err != nil {
+		return err
+	}
+
+	users, err := p.authzRepo.GetRoleUsers(ctx, role.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get role users: %w", err)
+	}
+	for _, dom := range roleAssignmentDomains {
+		members, err := p.enforcer.GetUsersForRole(role.Name, string(dom))
+		if err != nil {
+			return err
+		}
+		linked := make(map[string]bool, len(members))
+		for _, member := range members {
+			linked[member] = true
+		}
+		for _, user := range users {
+			if linked[user.ID] {
+				continue
+			}
+			if err := p.enforcer.AddRoleForUser(user.ID, role.Name, string(dom)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removePermission drops the projected policies of a deleted permission from every role
+func (p *rbacProjection) removePermission(perm *domain.Permission) error {
+	object := domain.PermissionResource(perm.Resource)
+	action := domain.PermissionActionPattern(perm.Action)
+	for _, rule := range p.enforcer.GetFilteredPolicies("", string(domain.DomainUser), object, action, casbinPkg.EffectAllow) {
+		if err := p.enforcer.RemovePolicy(rule[0], rule[1], rule[2], rule[3]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignRole links a user to a role in every assignment domain
+func (p *rbacProjection) assignRole(userID, roleName string) error {
+	for _, dom := range roleAssignmentDomains {
+		if err := p.enforcer.AddRoleForUser(userID, roleName, string(dom)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// roleLinks returns the role links, as (member, role, dom), of a user's role assignment
+func roleLinks(userID, roleName string) [][]string {
+	links := make([][]string, 0, len(roleAssignmentDomains))
+	for _, dom := range roleAssignmentDomains {
+		links = append(links, []string{userID, roleName, string(dom)})
+	}
+	return links
+}
+
+// removeRole unlinks a user from a role in every assignment domain
+func (p *rbacProjection) removeRole(userID, roleName string) error {
+	for _, dom := range roleAssignmentDomains {
+		if err := p.enforcer.DeleteRoleForUser(userID, roleName, string(dom)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// roleDomains converts Casbin domains to the names the enforcer takes
+func roleDomains(domains []domain.CasbinDomain) []string {
+	names := make([]string, 0, len(domains))
+	for _, dom := range domains {
+		names = append(names, string(dom))
+	}
+	return names
+}
+
+// newRoleImpact reports the Casbin rules that reference a role within the domains
+func newRoleImpact(enforcer *casbinPkg.Enforcer, role string, domains []domain.CasbinDomain) *domain.RoleImpact {
+	policies, links := enforcer.RoleReferences(role, roleDomains(domains)...)
+
+	impact := &domain.RoleImpact{
+		Role:     role,
+		Policies: make([]domain.Policy, 0, len(policies)),
+		Members:  make([]domain.RoleLink, 0),
+		Parents:  make([]domain.RoleLink, 0),
+	}
+	for _, rule := range policies {
+		impact.Policies = append(impact.Policies, domain.Policy{
+			Subject:  rule[0],
+			Domain:   domain.CasbinDomain(rule[1]),
+			Resource: rule[2],
+			Action:   rule[3],
+			Effect:   domain.PolicyEffect(rule[4]),
+		})
+	}
+	for _, rule := range links {
+		link := domain.RoleLink{Member: rule[0], Role: rule[1], Domain: domain.CasbinDomain(rule[2])}
+		if link.Role == role {
+			impact.Members = append(impact.Members, link)
+		} else {
+			impact.Parents = append(impact.Parents, link)
+		}
+	}
+	return impact
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// rolePermissionsRepository serves fixed role permissions
+type rolePermissionsRepository struct {
+	repository.AuthorizationRepository
+	permissions []*domain.Permission
+}
+
+func (r *rolePermissionsRepository) GetRolePermissions(ctx context.Context, roleID string) ([]*domain.Permission, error) {
+	return r.permissions, nil
+}
+
+func TestProjectedPermissionActionsAreAnchored(t *testing.T) {
+	enforcer, err := casbinPkg.NewMemoryEnforcer("../../configs/rbac_model.conf", zap.NewNop())
+	require.NoError(t, err)
+	repo := &rolePermissionsRepository{permissions: []*domain.Permission{{Resource: "message", Action: "read"}}}
+	projection := newRBACProjection(enforcer, repo)
+
+	role := &domain.Role{ID: "r-1", Name: "reader"}
+	require.NoError(t, projection.syncRolePermissions(context.Background(), role))
+	require.NoError(t, projection.assignRole("u-1", role.Name))
+
+	object := domain.PermissionResource("message")
+	allowed, err := enforcer.Enforce("u-1", string(domain.DomainUser), object, "read")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// An unanchored read would also grant unread and reread
+	for _, action := range []string{"unread", "reread"} {
+		allowed, err = enforcer.Enforce("u-1", string(domain.DomainUser), object, action)
+		require.NoError(t, err)
+		assert.False(t, allowed, action)
+	}
+
+	// Removing the permission drops its anchored policy
+	require.NoError(t, projection.removePermission(repo.permissions[0]))
+	allowed, err = enforcer.Enforce("u-1", string(domain.DomainUser), object, "read")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
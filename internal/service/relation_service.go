@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+)
+
+// RelationChecker answers whether a user holds a relation on an object
+type RelationChecker interface {
+	// CheckRelation follows the tuples and userset rewrites of the object's namespace
+	CheckRelation(ctx context.Context, namespace, objectID, relation, userID string) (bool, error)
+}
+
+// RelationService manages relation tuples, object#relation@subject, and evaluates them
+// Zanzibar-style, alongside the Casbin policies
+type RelationService interface {
+	RelationChecker
+
+	// Namespace configs
+	PutRelationNamespace(ctx context.Context, namespace *domain.RelationNamespace) (*domain.RelationNamespace, error)
+	ListRelationNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error)
+	DeleteRelationNamespace(ctx context.Context, name string) error
+
+	// WriteRelationTuples inserts and deletes tuples in one transaction
+	WriteRelationTuples(ctx context.Context, writes, deletes []*domain.RelationTuple) error
+	ReadRelationTuples(ctx context.Context, filter domain.RelationTupleFilter, page, pageSize int) ([]*domain.RelationTuple, int, error)
+
+	// ExpandRelation returns the userset tree of a relation on an object
+	ExpandRelation(ctx context.Context, namespace, objectID, relation string) (*domain.RelationTree, error)
+	// ListRelationObjects returns the objects of a namespace on which a user holds relation
+	ListRelationObjects(ctx context.Context, namespace, relation, userID string) ([]string, error)
+}
+
+type relationService struct {
+	relationRepo repository.RelationTupleRepository
+}
+
+// NewRelationService creates a new instance of RelationService
+func NewRelationService(relationRepo repository.RelationTupleRepository) RelationService {
+	return &relationService{
+		relationRepo: relationRepo,
+	}
+}
+
+// PutRelationNamespace creates a namespace or replaces its relations. Relations still used by
+// tuples cannot be removed.
+func (s *relationService) PutRelationNamespace(ctx context.Context, namespace *domain.RelationNamespace) (*domain.RelationNamespace, error) {
+	if err := namespace.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid relation namespace: %w", err)
+	}
+
+	inUse, err := s.relationRepo.ListRelationsInUse(ctx, namespace.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relations in use: %w", err)
+	}
+	for _, relation := range inUse {
+		if namespace.Relation(relation) == nil {
+			return nil, fmt.Errorf("relation %s of namespace %s is still used by tuples", relation, namespace.Name)
+		}
+	}
+
+	now := time.Now().UTC()
+	namespace.CreatedAt = now
+	namespace.UpdatedAt = now
+	if err := s.relationRepo.SaveRelationNamespace(ctx, namespace); err != nil {
+		return nil, fmt.Errorf("failed to save relation namespace: %w", err)
+	}
+	return namespace, nil
+}
+
+func (s *relationService) ListRelationNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error) {
+	return s.relationRepo.ListRelationNamespaces(ctx)
+}
+
+func (s *relationService) DeleteRelationNamespace(ctx context.Context, name string) error {
+	if _, err := s.relationRepo.GetRelationNamespace(ctx, name); err != nil {
+		return err
+	}
+	inUse, err := s.relationRepo.ListRelationsInUse(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to list relations in use: %w", err)
+	}
+	if len(inUse) > 0 {
+		return fmt.Errorf("namespace %s is still used by tuples of relations %s", name, strings.Join(inUse, ", "))
+	}
+	return s.relationRepo.DeleteRelationNamespace(ctx, name)
+}
+
+// WriteRelationTuples checks written tuples against the namespace configs. Deleted tuples only
+// need to be well formed.
+func (s *relationService) WriteRelationTuples(ctx context.Context, writes, deletes []*domain.RelationTuple) error {
+	if len(writes) == 0 && len(deletes) == 0 {
+		return fmt.Errorf("no relation tuple to write or delete")
+	}
+
+	namespaces, err := s.namespaces(ctx)
+	if err != nil {
+		return err
+	}
+	for _, tuple := range writes {
+		if err := tuple.Validate(); err != nil {
+			return err
+		}
+		if _, err := definedRelation(namespaces, tuple.Namespace, tuple.Relation); err != nil {
+			return fmt.Errorf("invalid tuple %s: %w", tuple, err)
+		}
+		switch {
+		case tuple.Subject.IsUserset():
+			if _, err := definedRelation(namespaces, tuple.Subject.Namespace, tuple.Subject.Relation); err != nil {
+				return fmt.Errorf("invalid tuple %s: %w", tuple, err)
+			}
+		case !tuple.Subject.IsUser():
+			if namespaces[tuple.Subject.Namespace] == nil {
+				return fmt.Errorf("invalid tuple %s: unknown namespace %s", tuple, tuple.Subject.Namespace)
+			}
+		}
+	}
+	for _, tuple := range deletes {
+		if err := tuple.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.relationRepo.WriteRelationTuples(ctx, writes, deletes); err != nil {
+		return fmt.Errorf("failed to write relation tuples: %w", err)
+	}
+	return nil
+}
+
+func (s *relationService) ReadRelationTuples(ctx context.Context, filter domain.RelationTupleFilter, page, pageSize int) ([]*domain.RelationTuple, int, error) {
+	return s.relationRepo.ListRelationTuples(ctx, filter, page, pageSize)
+}
+
+func (s *relationService) CheckRelation(ctx context.Context, namespace, objectID, relation, userID string) (bool, error) {
+	if objectID == "" || userID == "" {
+		return false, fmt.Errorf("object ID and user ID are required")
+	}
+	evaluator, err := s.evaluator(ctx, namespace, relation)
+	if err != nil {
+		return false, err
+	}
+	return evaluator.checkObject(namespace, objectID, relation, userID)
+}
+
+func (s *relationService) ExpandRelation(ctx context.Context, namespace, objectID, relation string) (*domain.RelationTree, error) {
+	if objectID == "" {
+		return nil, fmt.Errorf("object ID is required")
+	}
+	evaluator, err := s.evaluator(ctx, namespace, relation)
+	if err != nil {
+		return nil, err
+	}
+	return evaluator.expand(namespace, objectID, relation, 0)
+}
+
+// ListRelationObjects checks the relation on every object of the namespace with a tuple; objects
+// without tuples cannot grant anything. The tuples reachable from those objects are read in one
+// query per namespace and hop, and objects too deep to decide are left out rather than failing
+// the listing.
+func (s *relationService) ListRelationObjects(ctx context.Context, namespace, relation, userID string) ([]string, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	evaluator, err := s.evaluator(ctx, namespace, relation)
+	if err != nil {
+		return nil, err
+	}
+	objectIDs, err := s.relationRepo.ListRelationObjectIDs(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relation objects: %w", err)
+	}
+
+	if err := evaluator.prefetch(namespace, objectIDs); err != nil {
+		return nil, err
+	}
+
+	allowed := make([]string, 0, len(objectIDs))
+	for _, objectID := range objectIDs {
+		ok, err := evaluator.checkObject(namespace, objectID, relation, userID)
+		if errors.Is(err, domain.ErrRelationDepthExceeded) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, objectID)
+		}
+	}
+	return allowed, nil
+}
+
+// namespaces returns the namespace configs by name
+func (s *relationService) namespaces(ctx context.Context) (map[string]*domain.RelationNamespace, error) {
+	list, err := s.relationRepo.ListRelationNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relation namespaces: %w", err)
+	}
+	namespaces := make(map[string]*domain.RelationNamespace, len(list))
+	for _, namespace := range list {
+		namespaces[namespace.Name] = namespace
+	}
+	return namespaces, nil
+}
+
+// evaluator returns an evaluator for a relation, checking the namespace defines it
+func (s *relationService) evaluator(ctx context.Context, namespace, relation string) (*relationEvaluator, error) {
+	namespaces, err := s.namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := definedRelation(namespaces, namespace, relation); err != nil {
+		return nil, err
+	}
+	return &relationEvaluator{
+		ctx:          ctx,
+		relationRepo: s.relationRepo,
+		namespaces:   namespaces,
+		visited:      make(map[string]bool),
+		tuples:       make(map[string][]*domain.RelationTuple),
+	}, nil
+}
+
+func definedRelation(namespaces map[string]*domain.RelationNamespace, namespace, relation string) (*domain.RelationDefinition, error) {
+	config := namespaces[namespace]
+	if config == nil {
+		return nil, fmt.Errorf("unknown namespace %s", namespace)
+	}
+	definition := config.Relation(relation)
+	if definition == nil {
+		return nil, fmt.Errorf("namespace %s has no relation %s", namespace, relation)
+	}
+	return definition, nil
+}
+
+// relationEvaluator walks tuples and userset rewrites. Rewrites are unions, so a userset already
+// visited can be treated as not granting: either it is being evaluated further up, or it was
+// found not to grant. Likewise a branch cut at MaxRelationDepth does not grant, and the check
+// only fails with ErrRelationDepthExceeded when no other branch grants.
+type relationEvaluator struct {
+	ctx          context.Context
+	relationRepo repository.RelationTupleRepository
+	namespaces   map[string]*domain.RelationNamespace
+	visited      map[string]bool
+	// tuples holds the tuples of every loaded object by namespace:object_id
+	tuples        map[string][]*domain.RelationTuple
+	depthExceeded bool
+}
+
+// checkObject checks a relation on one object, starting over from any earlier check: a userset
+// found false may hold for another object
+func (e *relationEvaluator) checkObject(namespace, objectID, relation, userID string) (bool, error) {
+	e.visited = make(map[string]bool)
+	e.depthExceeded = false
+	ok, err := e.check(namespace, objectID, relation, userID, 0)
+	if err != nil {
+		return false, err
+	}
+	if !ok && e.depthExceeded {
+		return false, domain.ErrRelationDepthExceeded
+	}
+	return ok, nil
+}
+
+func (e *relationEvaluator) check(namespace, objectID, relation, userID string, depth int) (bool, error) {
+	if depth > domain.MaxRelationDepth {
+		e.depthExceeded = true
+		return false, nil
+	}
+	userset := domain.Userset(namespace, objectID, relation)
+	if e.visited[userset] {
+		return false, nil
+	}
+	e.visited[userset] = true
+
+	// Relations the namespace does not define, reached through rewrites or usersets, grant nothing
+	definition, err := definedRelation(e.namespaces, namespace, relation)
+	if err != nil {
+		return false, nil
+	}
+
+	tuples, err := e.find(namespace, objectID, relation)
+	if err != nil {
+		return false, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.IsUser() && tuple.Subject.ObjectID == userID {
+			return true, nil
+		}
+	}
+	for _, tuple := range tuples {
+		if !tuple.Subject.IsUserset() {
+			continue
+		}
+		ok, err := e.check(tuple.Subject.Namespace, tuple.Subject.ObjectID, tuple.Subject.Relation, userID, depth+1)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+
+	for _, computed := range definition.ComputedUsersets {
+		ok, err := e.check(namespace, objectID, computed, userID, depth+1)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+
+	for _, ttu := range definition.TupleToUsersets {
+		related, err := e.relatedObjects(namespace, objectID, ttu.Tupleset)
+		if err != nil {
+			return false, err
+		}
+		for _, object := range related {
+			ok, err := e.check(object.Namespace, object.ObjectID, ttu.ComputedUserset, userID, depth+1)
+			if ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+	return false, nil
+}
+
+func (e *relationEvaluator) expand(namespace, objectID, relation string, depth int) (*domain.RelationTree, error) {
+	if depth > domain.MaxRelationDepth {
+		return nil, domain.ErrRelationDepthExceeded
+	}
+	userset := domain.Userset(namespace, objectID, relation)
+	tree := &domain.RelationTree{Userset: userset}
+	if e.visited[userset] {
+		return tree, nil
+	}
+	e.visited[userset] = true
+
+	definition, err := definedRelation(e.namespaces, namespace, relation)
+	if err != nil {
+		return tree, nil
+	}
+
+	tuples, err := e.find(namespace, objectID, relation)
+	if err != nil {
+		return nil, err
+	}
+	for _, tuple := range tuples {
+		if tuple.Subject.IsUser() || tuple.Subject.IsUserset() {
+			tree.Subjects = append(tree.Subjects, tuple.Subject)
+		}
+	}
+
+	for _, computed := range definition.ComputedUsersets {
+		child, err := e.expand(namespace, objectID, computed, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		tree.Children = append(tree.Children, child)
+	}
+
+	for _, ttu := range definition.TupleToUsersets {
+		related, err := e.relatedObjects(namespace, objectID, ttu.Tupleset)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range related {
+			child, err := e.expand(object.Namespace, object.ObjectID, ttu.ComputedUserset, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			tree.Children = append(tree.Children, child)
+		}
+	}
+	return tree, nil
+}
+
+// relatedObjects returns the objects a tupleset relation points to
+func (e *relationEvaluator) relatedObjects(namespace, objectID, tupleset string) ([]domain.RelationSubject, error) {
+	tuples, err := e.find(namespace, objectID, tupleset)
+	if err != nil {
+		return nil, err
+	}
+	var objects []domain.RelationSubject
+	for _, tuple := range tuples {
+		if !tuple.Subject.IsUser() {
+			objects = append(objects, domain.RelationSubject{Namespace: tuple.Subject.Namespace, ObjectID: tuple.Subject.ObjectID})
+		}
+	}
+	return objects, nil
+}
+
+// find returns the tuples granting relation on an object, loading the object's tuples if needed
+func (e *relationEvaluator) find(namespace, objectID, relation string) ([]*domain.RelationTuple, error) {
+	key := namespace + ":" + objectID
+	if _, loaded := e.tuples[key]; !loaded {
+		if _, err := e.load(namespace, []string{objectID}); err != nil {
+			return nil, err
+		}
+	}
+	var tuples []*domain.RelationTuple
+	for _, tuple := range e.tuples[key] {
+		if tuple.Relation == relation {
+			tuples = append(tuples, tuple)
+		}
+	}
+	return tuples, nil
+}
+
+// load reads the tuples of the objects not loaded yet in one query and returns them
+func (e *relationEvaluator) load(namespace string, objectIDs []string) ([]*domain.RelationTuple, error) {
+	var missing []string
+	for _, objectID := range objectIDs {
+		key := namespace + ":" + objectID
+		if _, loaded := e.tuples[key]; !loaded {
+			e.tuples[key] = []*domain.RelationTuple{}
+			missing = append(missing, objectID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	tuples, err := e.relationRepo.FindRelationTuplesByObjects(e.ctx, namespace, missing)
+	if err != nil {
+		for _, objectID := range missing {
+			delete(e.tuples, namespace+":"+objectID)
+		}
+		return nil, fmt.Errorf("failed to read relation tuples: %w", err)
+	}
+	for _, tuple := range tuples {
+		key := tuple.Namespace + ":" + tuple.ObjectID
+		e.tuples[key] = append(e.tuples[key], tuple)
+	}
+	return tuples, nil
+}
+
+// prefetch loads the objects and every object their tuples lead to, one query per namespace and
+// hop, so that checking them reads nothing more
+func (e *relationEvaluator) prefetch(namespace string, objectIDs []string) error {
+	frontier := map[string][]string{namespace: objectIDs}
+	for depth := 0; depth <= domain.MaxRelationDepth && len(frontier) > 0; depth++ {
+		next := make(map[string][]string)
+		for ns, ids := range frontier {
+			tuples, err := e.load(ns, ids)
+			if err != nil {
+				return err
+			}
+			for _, tuple := range tuples {
+				subject := tuple.Subject
+				if subject.IsUser() {
+					continue
+				}
+				if _, loaded := e.tuples[subject.Namespace+":"+subject.ObjectID]; !loaded {
+					next[subject.Namespace] = append(next[subject.Namespace], subject.ObjectID)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
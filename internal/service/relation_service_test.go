@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tvttt/iam-services/internal/domain"
+)
+
+// memoryRelationTupleRepository keeps namespaces and tuples in memory and counts tuple reads
+type memoryRelationTupleRepository struct {
+	namespaces []*domain.RelationNamespace
+	tuples     []*domain.RelationTuple
+	reads      int
+}
+
+func (r *memoryRelationTupleRepository) SaveRelationNamespace(ctx context.Context, namespace *domain.RelationNamespace) error {
+	r.namespaces = append(r.namespaces, namespace)
+	return nil
+}
+
+func (r *memoryRelationTupleRepository) GetRelationNamespace(ctx context.Context, name string) (*domain.RelationNamespace, error) {
+	for _, namespace := range r.namespaces {
+		if namespace.Name == name {
+			return namespace, nil
+		}
+	}
+	return nil, assert.AnError
+}
+
+func (r *memoryRelationTupleRepository) ListRelationNamespaces(ctx context.Context) ([]*domain.RelationNamespace, error) {
+	return r.namespaces, nil
+}
+
+func (r *memoryRelationTupleRepository) DeleteRelationNamespace(ctx context.Context, name string) error {
+	return nil
+}
+
+func (r *memoryRelationTupleRepository) ListRelationsInUse(ctx context.Context, namespace string) ([]string, error) {
+	var relations []string
+	for _, tuple := range r.tuples {
+		if tuple.Namespace == namespace {
+			relations = append(relations, tuple.Relation)
+		}
+	}
+	return relations, nil
+}
+
+func (r *memoryRelationTupleRepository) WriteRelationTuples(ctx context.Context, writes, deletes []*domain.RelationTuple) error {
+	r.tuples = append(r.tuples, writes...)
+	return nil
+}
+
+func (r *memoryRelationTupleRepository) FindRelationTuplesByObjects(ctx context.Context, namespace string, objectIDs []string) ([]*domain.RelationTuple, error) {
+	r.reads++
+	var tuples []*domain.RelationTuple
+	for _, tuple := range r.tuples {
+		if tuple.Namespace == namespace && slices.Contains(objectIDs, tuple.ObjectID) {
+			tuples = append(tuples, tuple)
+		}
+	}
+	return tuples, nil
+}
+
+func (r *memoryRelationTupleRepository) ListRelationTuples(ctx context.Context, filter domain.RelationTupleFilter, page, pageSize int) ([]*domain.RelationTuple, int, error) {
+	return r.tuples, len(r.tuples), nil
+}
+
+func (r *memoryRelationTupleRepository) ListRelationObjectIDs(ctx context.Context, namespace string) ([]string, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, tuple := range r.tuples {
+		if tuple.Namespace == namespace && !seen[tuple.ObjectID] {
+			seen[tuple.ObjectID] = true
+			ids = append(ids, tuple.ObjectID)
+		}
+	}
+	return ids, nil
+}
+
+// newStorefrontRelations sets up orders owned by customers and viewable by the managers of their
+// store, where store managers include the members of a team
+func newStorefrontRelations(t *testing.T) RelationService {
+	svc, _ := newStorefrontRelationsWithRepo(t)
+	return svc
+}
+
+func newStorefrontRelationsWithRepo(t *testing.T) (RelationService, *memoryRelationTupleRepository) {
+	repo := &memoryRelationTupleRepository{
+		namespaces: []*domain.RelationNamespace{
+			{Name: "team", Relations: []domain.RelationDefinition{{Name: "member"}}},
+			{Name: "store", Relations: []domain.RelationDefinition{{Name: "manager"}}},
+			{Name: "order", Relations: []domain.RelationDefinition{
+				{Name: "owner"},
+				{Name: "parent"},
+				{
+					Name:             "viewer",
+					ComputedUsersets: []string{"owner"},
+					TupleToUsersets:  []domain.TupleToUserset{{Tupleset: "parent", ComputedUserset: "manager"}},
+				},
+			}},
+		},
+	}
+	svc := NewRelationService(repo)
+
+	var writes []*domain.RelationTuple
+	for _, s := range []string{
+		"order:o-1#owner@alice",
+		"order:o-1#parent@store:s-1",
+		"order:o-2#owner@bob",
+		"store:s-1#manager@carol",
+		"store:s-1#manager@team:t-1#member",
+		"team:t-1#member@dave",
+	} {
+		tuple, err := domain.ParseRelationTuple(s)
+		require.NoError(t, err)
+		writes = append(writes, tuple)
+	}
+	require.NoError(t, svc.WriteRelationTuples(context.Background(), writes, nil))
+	return svc, repo
+}
+
+func TestCheckRelation(t *testing.T) {
+	svc := newStorefrontRelations(t)
+	ctx := context.Background()
+
+	cases := []struct {
+		objectID, relation, userID string
+		allowed                    bool
+	}{
+		{"o-1", "owner", "alice", true},
+		{"o-1", "viewer", "alice", true}, // owner implies viewer
+		{"o-1", "viewer", "carol", true}, // manager of the order's store
+		{"o-1", "viewer", "dave", true},  // member of a team managing the store
+		{"o-1", "owner", "carol", false},
+		{"o-1", "viewer", "bob", false},
+		{"o-2", "viewer", "carol", false}, // o-2 has no store
+	}
+	for _, c := range cases {
+		allowed, err := svc.CheckRelation(ctx, "order", c.objectID, c.relation, c.userID)
+		require.NoError(t, err)
+		assert.Equal(t, c.allowed, allowed, "%s#%s@%s", c.objectID, c.relation, c.userID)
+	}
+
+	_, err := svc.CheckRelation(ctx, "order", "o-1", "editor", "alice")
+	assert.Error(t, err, "undefined relation")
+}
+
+func TestListRelationObjects(t *testing.T) {
+	svc, repo := newStorefrontRelationsWithRepo(t)
+
+	repo.reads = 0
+	objects, err := svc.ListRelationObjects(context.Background(), "order", "viewer", "dave")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"o-1"}, objects)
+	assert.Equal(t, 3, repo.reads, "one read per hop: the orders, their store, the store's team")
+
+	objects, err = svc.ListRelationObjects(context.Background(), "order", "viewer", "bob")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"o-2"}, objects)
+}
+
+func TestExpandRelation(t *testing.T) {
+	svc := newStorefrontRelations(t)
+
+	tree, err := svc.ExpandRelation(context.Background(), "order", "o-1", "viewer")
+	require.NoError(t, err)
+	assert.Equal(t, "order:o-1#viewer", tree.Userset)
+	require.Len(t, tree.Children, 2)
+	assert.Equal(t, "order:o-1#owner", tree.Children[0].Userset)
+	assert.Equal(t, []domain.RelationSubject{{ObjectID: "alice"}}, tree.Children[0].Subjects)
+	assert.Equal(t, "store:s-1#manager", tree.Children[1].Userset)
+	assert.Len(t, tree.Children[1].Subjects, 2, "carol and the team's members, unexpanded")
+}
+
+func TestWriteRelationTuplesValidatesNamespaces(t *testing.T) {
+	svc := newStorefrontRelations(t)
+
+	tuple, err := domain.ParseRelationTuple("order:o-3#approver@alice")
+	require.NoError(t, err)
+	assert.Error(t, svc.WriteRelationTuples(context.Background(), []*domain.RelationTuple{tuple}, nil))
+
+	tuple, err = domain.ParseRelationTuple("order:o-3#viewer@team:t-1#lead")
+	require.NoError(t, err)
+	assert.Error(t, svc.WriteRelationTuples(context.Background(), []*domain.RelationTuple{tuple}, nil))
+}
+
+func TestCheckRelationCycle(t *testing.T) {
+	repo := &memoryRelationTupleRepository{
+		namespaces: []*domain.RelationNamespace{
+			{Name: "group", Relations: []domain.RelationDefinition{{Name: "member"}}},
+		},
+	}
+	svc := NewRelationService(repo)
+	var writes []*domain.RelationTuple
+	for _, s := range []string{"group:a#member@group:b#member", "group:b#member@group:a#member", "group:b#member@erin"} {
+		tuple, err := domain.ParseRelationTuple(s)
+		require.NoError(t, err)
+		writes = append(writes, tuple)
+	}
+	require.NoError(t, svc.WriteRelationTuples(context.Background(), writes, nil))
+
+	allowed, err := svc.CheckRelation(context.Background(), "group", "a", "member", "erin")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = svc.CheckRelation(context.Background(), "group", "a", "member", "frank")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+// newDeepGroupRelations sets up group a reaching erin through b, and through a chain of groups
+// longer than MaxRelationDepth that is evaluated first
+func newDeepGroupRelations(t *testing.T) RelationService {
+	repo := &memoryRelationTupleRepository{
+		namespaces: []*domain.RelationNamespace{
+			{Name: "group", Relations: []domain.RelationDefinition{{Name: "member"}}},
+		},
+	}
+	svc := NewRelationService(repo)
+
+	tuples := []string{"group:a#member@group:c0#member"}
+	for i := 0; i < domain.MaxRelationDepth+1; i++ {
+		tuples = append(tuples, fmt.Sprintf("group:c%d#member@group:c%d#member", i, i+1))
+	}
+	tuples = append(tuples, "group:a#member@group:b#member", "group:b#member@erin")
+
+	var writes []*domain.RelationTuple
+	for _, s := range tuples {
+		tuple, err := domain.ParseRelationTuple(s)
+		require.NoError(t, err)
+		writes = append(writes, tuple)
+	}
+	require.NoError(t, svc.WriteRelationTuples(context.Background(), writes, nil))
+	return svc
+}
+
+func TestCheckRelationDepthExceeded(t *testing.T) {
+	svc := newDeepGroupRelations(t)
+	ctx := context.Background()
+
+	allowed, err := svc.CheckRelation(ctx, "group", "a", "member", "erin")
+	require.NoError(t, err, "a branch too deep does not stop another from granting")
+	assert.True(t, allowed)
+
+	_, err = svc.CheckRelation(ctx, "group", "a", "member", "frank")
+	assert.ErrorIs(t, err, domain.ErrRelationDepthExceeded)
+
+	objects, err := svc.ListRelationObjects(ctx, "group", "member", "erin")
+	require.NoError(t, err, "objects too deep to decide are left out")
+	assert.Equal(t, []string{"a", "b"}, objects)
+}
@@ -2,33 +2,40 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tvttt/iam-services/internal/domain"
 	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
 )
 
 // RoleService handles role management business logic
 type RoleService interface {
 	CreateRole(ctx context.Context, name, description string, permissionIDs []string) (*domain.Role, error)
 	UpdateRole(ctx context.Context, roleID, name, description string, permissionIDs []string) error
-	DeleteRole(ctx context.Context, roleID string) error
+	DeleteRole(ctx context.Context, roleID string, cascade bool) (*domain.RoleImpact, error)
 	GetRole(ctx context.Context, roleID string) (*domain.Role, error)
 	ListRoles(ctx context.Context, page, pageSize int) ([]*domain.Role, int, error)
 }
 
 type roleService struct {
-	roleRepo  repository.RoleRepository
-	authzRepo repository.AuthorizationRepository
+	roleRepo   repository.RoleRepository
+	authzRepo  repository.AuthorizationRepository
+	enforcer   *casbinPkg.Enforcer
+	projection *rbacProjection
 }
 
-// NewRoleService creates a new instance of RoleService
-func NewRoleService(roleRepo repository.RoleRepository, authzRepo repository.AuthorizationRepository) RoleService {
+// NewRoleService creates a new instance of RoleService.
+// Role permissions are projected into Casbin through the enforcer.
+func NewRoleService(roleRepo repository.RoleRepository, authzRepo repository.AuthorizationRepository, enforcer *casbinPkg.Enforcer) RoleService {
 	return &roleService{
-		roleRepo:  roleRepo,
-		authzRepo: authzRepo,
+		roleRepo:   roleRepo,
+		authzRepo:  authzRepo,
+		enforcer:   enforcer,
+		projection: newRBACProjection(enforcer, authzRepo),
 	}
 }
 
@@ -54,6 +61,9 @@ func (s *roleService) CreateRole(ctx context.Context, name, description string,
 		if err := s.authzRepo.UpdateRolePermissions(ctx, role.ID, permissionIDs); err != nil {
 			return nil, fmt.Errorf("failed to assign permissions to role: %w", err)
 		}
+		if err := s.projection.syncRolePermissions(ctx, role); err != nil {
+			return nil, fmt.Errorf("failed to project role permissions into Casbin: %w", err)
+		}
 	}
 
 	return role, nil
@@ -71,14 +81,29 @@ func (s *roleService) UpdateRole(ctx context.Context, roleID, name, description
 	}
 
 	// Update role fields
+	oldName := role.Name
 	if name != "" {
 		role.Name = name
 	}
 	role.Description = description
 	role.UpdatedAt = time.Now()
 
-	if err := s.roleRepo.UpdateRole(ctx, role); err != nil {
-		return fmt.Errorf("failed to update role: %w", err)
+	if role.Name == oldName {
+		if err := s.roleRepo.UpdateRole(ctx, role); err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+	} else {
+		if existing, err := s.roleRepo.GetRoleByName(ctx, role.Name); err == nil && existing.ID != role.ID {
+			return fmt.Errorf("role %s already exists", role.Name)
+		}
+
+		// Casbin references roles by name: rewrite the role's policies and links with the row
+		err := s.enforcer.RenameRole(ctx, oldName, role.Name, roleDomains(roleAssignmentDomains), func(tx *sql.Tx) error {
+			return s.roleRepo.UpdateRoleTx(ctx, tx, role)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rename role: %w", err)
+		}
 	}
 
 	// Update permissions
@@ -86,17 +111,39 @@ func (s *roleService) UpdateRole(ctx context.Context, roleID, name, description
 		if err := s.authzRepo.UpdateRolePermissions(ctx, roleID, permissionIDs); err != nil {
 			return fmt.Errorf("failed to update role permissions: %w", err)
 		}
+		if err := s.projection.syncRolePermissions(ctx, role); err != nil {
+			return fmt.Errorf("failed to project role permissions into Casbin: %w", err)
+		}
 	}
 
 	return nil
 }
 
-func (s *roleService) DeleteRole(ctx context.Context, roleID string) error {
+// DeleteRole deletes a role together with its Casbin policies and links. A role that users or
+// other roles still hold is only deleted with cascade; otherwise a RoleInUseError reports the
+// impact. The returned impact lists the rules that were removed.
+func (s *roleService) DeleteRole(ctx context.Context, roleID string, cascade bool) (*domain.RoleImpact, error) {
 	if roleID == "" {
-		return fmt.Errorf("role ID is required")
+		return nil, fmt.Errorf("role ID is required")
 	}
 
-	return s.roleRepo.DeleteRole(ctx, roleID)
+	role, err := s.roleRepo.GetRoleByID(ctx, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	impact := newRoleImpact(s.enforcer, role.Name, roleAssignmentDomains)
+	if len(impact.Members) > 0 && !cascade {
+		return nil, &domain.RoleInUseError{Impact: impact}
+	}
+
+	err = s.enforcer.DeleteRole(ctx, role.Name, roleDomains(roleAssignmentDomains), func(tx *sql.Tx) error {
+		return s.roleRepo.DeleteRoleTx(ctx, tx, roleID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+	return impact, nil
 }
 
 func (s *roleService) GetRole(ctx context.Context, roleID string) (*domain.Role, error) {
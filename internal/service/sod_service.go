@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// SessionRoleActivator picks the roles active in a session
+type SessionRoleActivator interface {
+	// SessionRoles returns the roles to activate for a user holding assigned. Without requested
+	// roles every assigned role is activated except those a dynamic constraint keeps apart.
+	// Requested roles must be assigned and must not break a dynamic constraint.
+	SessionRoles(ctx context.Context, userID string, assigned, requested []string) ([]string, error)
+}
+
+// SoDService manages separation-of-duties constraints and checks role changes against them
+type SoDService interface {
+	SessionRoleActivator
+
+	CreateSoDConstraint(ctx context.Context, name, description string, mode domain.SoDMode, roles []domain.SoDRole) (*domain.SoDConstraint, error)
+	GetSoDConstraint(ctx context.Context, id string) (*domain.SoDConstraint, error)
+	ListSoDConstraints(ctx context.Context) ([]*domain.SoDConstraint, error)
+	DeleteSoDConstraint(ctx context.Context, id string) error
+
+	// ListSoDViolations reports the users and roles already holding roles a static constraint
+	// keeps apart, such as assignments made before the constraint existed
+	ListSoDViolations(ctx context.Context) ([]*domain.SoDViolation, error)
+
+	// CheckRoleLink returns a *domain.SoDViolationError when linking member, a user or a role, to
+	// role in dom would let anyone hold roles a static constraint keeps apart, globally or within
+	// the scopes of their scoped CMS roles
+	CheckRoleLink(ctx context.Context, member, role string, dom domain.CasbinDomain) error
+	// CheckRoleLinks returns a *domain.SoDViolationError when replacing every role link with
+	// links, as (member, role, dom), would let anyone hold roles a static constraint keeps apart
+	// that they do not hold together already
+	CheckRoleLinks(ctx context.Context, links [][]string) error
+	// CheckScopedRoleAssignment returns a *domain.SoDViolationError when assigning the CMS role to
+	// the user in scope would let the user hold roles a static constraint keeps apart there or in
+	// a scope below it
+	CheckScopedRoleAssignment(ctx context.Context, userID, role string, scope domain.ResourceScope) error
+}
+
+type sodService struct {
+	sodRepo   repository.SoDConstraintRepository
+	roleRepo  repository.RoleRepository
+	cmsRepo   repository.CMSRepository
+	scopeRepo repository.ResourceScopeRepository
+	enforcer  *casbinPkg.Enforcer
+}
+
+// NewSoDService creates a new instance of SoDService.
+// Role holdings, including inheritance, are read from the enforcer's role links and from the
+// scoped CMS role assignments.
+func NewSoDService(
+	sodRepo repository.SoDConstraintRepository,
+	roleRepo repository.RoleRepository,
+	cmsRepo repository.CMSRepository,
+	scopeRepo repository.ResourceScopeRepository,
+	enforcer *casbinPkg.Enforcer,
+) SoDService {
+	return &sodService{
+		sodRepo:   sodRepo,
+		roleRepo:  roleRepo,
+		cmsRepo:   cmsRepo,
+		scopeRepo: scopeRepo,
+		enforcer:  enforcer,
+	}
+}
+
+func (s *sodService) CreateSoDConstraint(ctx context.Context, name, description string, mode domain.SoDMode, roles []domain.SoDRole) (*domain.SoDConstraint, error) {
+	now := time.Now().UTC()
+	constraint := &domain.SoDConstraint{
+		ID:          uuid.New().String(),
+		Name:        strings.TrimSpace(name),
+		Description: description,
+		Mode:        mode,
+		Roles:       make([]domain.SoDRole, 0, len(roles)),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, role := range roles {
+		constraint.Roles = append(constraint.Roles, domain.SoDRole{Kind: role.Kind, RoleID: role.RoleID})
+	}
+	if err := constraint.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SoD constraint: %w", err)
+	}
+
+	for i, role := range constraint.Roles {
+		switch role.Kind {
+		case domain.RoleKindRole:
+			r, err := s.roleRepo.GetRoleByID(ctx, role.RoleID)
+			if err != nil {
+				return nil, fmt.Errorf("role not found: %w", err)
+			}
+			constraint.Roles[i].RoleName = r.Name
+		case domain.RoleKindCMSRole:
+			r, err := s.cmsRepo.GetCMSRoleByID(ctx, role.RoleID)
+			if err != nil {
+				return nil, fmt.Errorf("CMS role not found: %w", err)
+			}
+			constraint.Roles[i].RoleName = r.Name
+		}
+	}
+
+	if err := s.sodRepo.CreateSoDConstraint(ctx, constraint); err != nil {
+		return nil, fmt.Errorf("failed to create SoD constraint: %w", err)
+	}
+	return constraint, nil
+}
+
+func (s *sodService) GetSoDConstraint(ctx context.Context, id string) (*domain.SoDConstraint, error) {
+	return s.sodRepo.GetSoDConstraintByID(ctx, id)
+}
+
+func (s *sodService) ListSoDConstraints(ctx context.Context) ([]*domain.SoDConstraint, error) {
+	return s.sodRepo.ListSoDConstraints(ctx)
+}
+
+func (s *sodService) DeleteSoDConstraint(ctx context.Context, id string) error {
+	if _, err := s.sodRepo.GetSoDConstraintByID(ctx, id); err != nil {
+		return err
+	}
+	return s.sodRepo.DeleteSoDConstraint(ctx, id)
+}
+
+func (s *sodService) ListSoDViolations(ctx context.Context) ([]*domain.SoDViolation, error) {
+	constraints, err := s.constraints(ctx, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return nil, err
+	}
+
+	_, links := s.enforcer.ExportRules()
+	graph := domain.NewRoleGraph(links)
+	scoped, err := s.scopedRoles(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []*domain.SoDViolation
+	for _, subject := range holdingSubjects(graph, scoped) {
+		violations = append(violations, holdingViolations(constraints, subject, nil, graph, nil, scoped[subject])...)
+	}
+	return violations, nil
+}
+
+func (s *sodService) CheckRoleLinks(ctx context.Context, links [][]string) error {
+	constraints, err := s.constraints(ctx, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+
+	_, live := s.enforcer.ExportRules()
+	before := domain.NewRoleGraph(live)
+	after := domain.NewRoleGraph(links)
+	scoped, err := s.scopedRoles(ctx, "", nil)
+	if err != nil {
+		return err
+	}
+
+	var violations []*domain.SoDViolation
+	for _, subject := range holdingSubjects(after, scoped) {
+		violations = append(violations, holdingViolations(constraints, subject, before, after, scoped[subject], scoped[subject])...)
+	}
+	if len(violations) > 0 {
+		return &domain.SoDViolationError{Violations: violations}
+	}
+	return nil
+}
+
+// holdingSubjects returns, sorted, every user and role holding a role in the graph's user and
+// cms domains or a scoped CMS role
+func holdingSubjects(graph *domain.RoleGraph, scoped map[string]map[domain.ResourceScope][]string) []string {
+	// Users holding CMS roles only within scopes have no role links
+	subjects := graph.Subjects(domain.DomainUser, domain.DomainCMS)
+	linked := make(map[string]bool, len(subjects))
+	for _, subject := range subjects {
+		linked[subject] = true
+	}
+	for userID := range scoped {
+		if !linked[userID] {
+			subjects = append(subjects, userID)
+		}
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+func (s *sodService) CheckRoleLink(ctx context.Context, member, role string, dom domain.CasbinDomain) error {
+	if dom != domain.DomainUser && dom != domain.DomainCMS {
+		return nil
+	}
+	constraints, err := s.constraints(ctx, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+
+	_, links := s.enforcer.ExportRules()
+	before := domain.NewRoleGraph(links)
+	after := domain.NewRoleGraph(links)
+	after.AddLink(member, role, dom)
+
+	scoped, err := s.scopedRoles(ctx, "", nil)
+	if err != nil {
+		return err
+	}
+
+	// Only the member and whoever holds it gain roles through the new link, including users
+	// holding it, or a role below it, within a scope
+	affected := after.Members(member, dom)
+	if dom == domain.DomainCMS {
+		for userID, scopes := range scoped {
+			for _, roles := range scopes {
+				for _, role := range roles {
+					if affected[role] {
+						affected[userID] = true
+					}
+				}
+			}
+		}
+	}
+	subjects := make([]string, 0, len(affected))
+	for subject := range affected {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+
+	var violations []*domain.SoDViolation
+	for _, subject := range subjects {
+		violations = append(violations, holdingViolations(constraints, subject, before, after, scoped[subject], scoped[subject])...)
+	}
+	if len(violations) > 0 {
+		return &domain.SoDViolationError{Violations: violations}
+	}
+	return nil
+}
+
+func (s *sodService) CheckScopedRoleAssignment(ctx context.Context, userID, role string, scope domain.ResourceScope) error {
+	constraints, err := s.constraints(ctx, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+
+	_, links := s.enforcer.ExportRules()
+	graph := domain.NewRoleGraph(links)
+	before, err := s.scopedRoles(ctx, userID, nil)
+	if err != nil {
+		return err
+	}
+	after, err := s.scopedRoles(ctx, userID, &domain.ScopedCMSRoleAssignment{UserID: userID, CMSRoleName: role, Scope: scope})
+	if err != nil {
+		return err
+	}
+
+	violations := holdingViolations(constraints, userID, graph, graph, before[userID], after[userID])
+	if len(violations) > 0 {
+		return &domain.SoDViolationError{Violations: violations}
+	}
+	return nil
+}
+
+func (s *sodService) SessionRoles(ctx context.Context, userID string, assigned, requested []string) ([]string, error) {
+	isAssigned := make(map[string]bool, len(assigned))
+	for _, role := range assigned {
+		isAssigned[role] = true
+	}
+	for _, role := range requested {
+		if !isAssigned[role] {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSessionRoleNotAssigned, role)
+		}
+	}
+
+	constraints, err := s.constraints(ctx, domain.SoDDynamic)
+	if err != nil {
+		return nil, err
+	}
+	if len(constraints) == 0 {
+		if len(requested) > 0 {
+			return requested, nil
+		}
+		return assigned, nil
+	}
+
+	_, links := s.enforcer.ExportRules()
+	graph := domain.NewRoleGraph(links)
+
+	if len(requested) > 0 {
+		held := sessionHeldRoles(graph, requested...)
+		var violations []*domain.SoDViolation
+		for _, constraint := range constraints {
+			if roles := constraint.HeldRoles(held); len(roles) >= 2 {
+				violations = append(violations, newSoDViolation(constraint, userID, roles))
+			}
+		}
+		if len(violations) > 0 {
+			return nil, &domain.SoDViolationError{Violations: violations}
+		}
+		return requested, nil
+	}
+
+	// Leave every role involved in a conflict inactive until the user picks one
+	held := sessionHeldRoles(graph, assigned...)
+	contested := make(map[string]bool)
+	for _, constraint := range constraints {
+		if len(constraint.HeldRoles(held)) < 2 {
+			continue
+		}
+		for _, role := range assigned {
+			if len(constraint.HeldRoles(sessionHeldRoles(graph, role))) > 0 {
+				contested[role] = true
+			}
+		}
+	}
+	active := make([]string, 0, len(assigned))
+	for _, role := range assigned {
+		if !contested[role] {
+			active = append(active, role)
+		}
+	}
+	return active, nil
+}
+
+// constraints returns the constraints enforced in mode
+func (s *sodService) constraints(ctx context.Context, mode domain.SoDMode) ([]*domain.SoDConstraint, error) {
+	all, err := s.sodRepo.ListSoDConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var constraints []*domain.SoDConstraint
+	for _, constraint := range all {
+		if constraint.Mode == mode {
+			constraints = append(constraints, constraint)
+		}
+	}
+	return constraints, nil
+}
+
+// scopedRoles returns, by user and scope, the CMS roles users hold within scopes; for one user, or
+// everyone with an empty userID. A pending assignment is counted as if it were already made.
+func (s *sodService) scopedRoles(ctx context.Context, userID string, pending *domain.ScopedCMSRoleAssignment) (map[string]map[domain.ResourceScope][]string, error) {
+	assignments, err := s.scopeRepo.ListUserScopedCMSRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped CMS roles: %w", err)
+	}
+	if pending != nil {
+		assignments = append(assignments, pending)
+	}
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+	scopes, err := s.scopeRepo.ListResourceScopes(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource scopes: %w", err)
+	}
+	return domain.ScopedRoleNames(assignments, domain.ResourceScopeParents(scopes)), nil
+}
+
+// holdingViolations returns the constraints a subject breaks after a change, globally and within
+// each scope it holds scoped CMS roles in. A nil before graph reports every violation; otherwise
+// violations that predate the change are left to the report.
+func holdingViolations(constraints []*domain.SoDConstraint, subject string, before, after *domain.RoleGraph, scopedBefore, scopedAfter map[domain.ResourceScope][]string) []*domain.SoDViolation {
+	var violations []*domain.SoDViolation
+	reported := make(map[string]bool)
+	check := func(scope *domain.ResourceScope, heldBefore, heldAfter map[domain.CasbinDomain]map[string]bool) {
+		for _, constraint := range constraints {
+			if reported[constraint.ID] {
+				continue
+			}
+			roles := constraint.HeldRoles(heldAfter)
+			if len(roles) < 2 || (heldBefore != nil && len(roles) <= len(constraint.HeldRoles(heldBefore))) {
+				continue
+			}
+			reported[constraint.ID] = true
+			violation := newSoDViolation(constraint, subject, roles)
+			violation.Scope = scope
+			violations = append(violations, violation)
+		}
+	}
+
+	var heldBefore map[domain.CasbinDomain]map[string]bool
+	if before != nil {
+		heldBefore = heldRoles(before, subject)
+	}
+	check(nil, heldBefore, heldRoles(after, subject))
+
+	scopes := make([]domain.ResourceScope, 0, len(scopedAfter))
+	for scope := range scopedAfter {
+		scopes = append(scopes, scope)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].String() < scopes[j].String() })
+	for _, scope := range scopes {
+		if before != nil {
+			heldBefore = heldInScope(before, subject, scopedBefore[scope])
+		}
+		check(&scope, heldBefore, heldInScope(after, subject, scopedAfter[scope]))
+	}
+	return violations
+}
+
+// heldInScope returns what a user holds within a scope: the roles held globally together with
+// the scope's CMS roles and the roles they inherit
+func heldInScope(graph *domain.RoleGraph, userID string, scopedRoles []string) map[domain.CasbinDomain]map[string]bool {
+	held := heldRoles(graph, userID)
+	for _, role := range scopedRoles {
+		for name := range graph.Held(role, domain.DomainCMS) {
+			held[domain.DomainCMS][name] = true
+		}
+	}
+	return held
+}
+
+// heldRoles returns what a subject holds in the user and CMS domains
+func heldRoles(graph *domain.RoleGraph, subject string) map[domain.CasbinDomain]map[string]bool {
+	return map[domain.CasbinDomain]map[string]bool{
+		domain.DomainUser: graph.Held(subject, domain.DomainUser),
+		domain.DomainCMS:  graph.Held(subject, domain.DomainCMS),
+	}
+}
+
+// sessionHeldRoles returns the user roles, with those they inherit, held by activating roles
+func sessionHeldRoles(graph *domain.RoleGraph, roles ...string) map[domain.CasbinDomain]map[string]bool {
+	held := make(map[string]bool)
+	for _, role := range roles {
+		for name := range graph.Held(role, domain.DomainUser) {
+			held[name] = true
+		}
+	}
+	return map[domain.CasbinDomain]map[string]bool{domain.DomainUser: held}
+}
+
+func newSoDViolation(constraint *domain.SoDConstraint, subject string, roles []domain.SoDRole) *domain.SoDViolation {
+	return &domain.SoDViolation{
+		ConstraintID:   constraint.ID,
+		ConstraintName: constraint.Name,
+		Mode:           constraint.Mode,
+		Subject:        subject,
+		Roles:          roles,
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/tvttt/iam-services/internal/domain"
+	"github.com/tvttt/iam-services/internal/repository"
+	casbinPkg "github.com/tvttt/iam-services/pkg/casbin"
+)
+
+// fixedSoDConstraintRepository serves fixed constraints
+type fixedSoDConstraintRepository struct {
+	repository.SoDConstraintRepository
+	constraints []*domain.SoDConstraint
+}
+
+func (r *fixedSoDConstraintRepository) ListSoDConstraints(ctx context.Context) ([]*domain.SoDConstraint, error) {
+	return r.constraints, nil
+}
+
+// unscopedResourceScopeRepository has no scoped CMS role assignments
+type unscopedResourceScopeRepository struct {
+	repository.ResourceScopeRepository
+}
+
+func (r *unscopedResourceScopeRepository) ListUserScopedCMSRoles(ctx context.Context, userID string) ([]*domain.ScopedCMSRoleAssignment, error) {
+	return nil, nil
+}
+
+func TestHoldingViolationsWithinScopes(t *testing.T) {
+	constraint := &domain.SoDConstraint{
+		ID:   "sod-1",
+		Name: "stock",
+		Mode: domain.SoDStatic,
+		Roles: []domain.SoDRole{
+			{Kind: domain.RoleKindCMSRole, RoleID: "c-1", RoleName: "picker"},
+			{Kind: domain.RoleKindCMSRole, RoleID: "c-2", RoleName: "auditor"},
+		},
+	}
+	constraints := []*domain.SoDConstraint{constraint}
+	graph := domain.NewRoleGraph([][]string{{"alice", "auditor", "cms"}})
+	warehouse := domain.ResourceScope{Type: "warehouse", ID: "W1"}
+
+	// Picking in W1 while auditing everywhere
+	violations := holdingViolations(constraints, "alice", graph, graph,
+		nil, map[domain.ResourceScope][]string{warehouse: {"picker"}})
+	require.Len(t, violations, 1)
+	assert.Equal(t, &warehouse, violations[0].Scope)
+	assert.Len(t, violations[0].Roles, 2)
+
+	// The report lists it too, while an assignment that adds nothing is not a new violation
+	scoped := map[domain.ResourceScope][]string{warehouse: {"picker"}}
+	assert.Len(t, holdingViolations(constraints, "alice", nil, graph, nil, scoped), 1)
+	assert.Empty(t, holdingViolations(constraints, "alice", graph, graph, scoped, scoped))
+
+	// Without the global role the scoped one alone breaks nothing
+	assert.Empty(t, holdingViolations(constraints, "bob", graph, graph, nil, scoped))
+}
+
+func TestCheckRoleLinks(t *testing.T) {
+	enforcer, err := casbinPkg.NewMemoryEnforcer("../../configs/rbac_model.conf", zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, enforcer.AddRoleForUser("alice", "picker", string(domain.DomainCMS)))
+
+	sodRepo := &fixedSoDConstraintRepository{constraints: []*domain.SoDConstraint{{
+		ID:   "sod-1",
+		Name: "stock",
+		Mode: domain.SoDStatic,
+		Roles: []domain.SoDRole{
+			{Kind: domain.RoleKindCMSRole, RoleID: "c-1", RoleName: "picker"},
+			{Kind: domain.RoleKindCMSRole, RoleID: "c-2", RoleName: "auditor"},
+		},
+	}}}
+	s := NewSoDService(sodRepo, nil, nil, &unscopedResourceScopeRepository{}, enforcer)
+	ctx := context.Background()
+
+	// Making picker inherit auditor in one bulk change gives alice both roles
+	var violation *domain.SoDViolationError
+	err = s.CheckRoleLinks(ctx, [][]string{{"alice", "picker", "cms"}, {"picker", "auditor", "cms"}})
+	require.ErrorAs(t, err, &violation)
+	assert.Equal(t, "alice", violation.Violations[0].Subject)
+
+	// Links that keep the roles apart pass
+	assert.NoError(t, s.CheckRoleLinks(ctx, [][]string{{"alice", "picker", "cms"}, {"senior_picker", "picker", "cms"}}))
+}
@@ -0,0 +1,64 @@
+-- Per-tab CMS actions
+-- CMS roles grant a set of actions (view, create, edit, delete, export) per tab
+-- instead of tab-level access only.
+
+-- ============================================
+-- 1. Store actions per tab on cms_roles
+-- ============================================
+ALTER TABLE cms_roles ADD COLUMN IF NOT EXISTS tab_actions JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- ============================================
+-- 2. Convert CMS policies from HTTP methods to actions
+-- ============================================
+DO $$
+BEGIN
+    IF to_regclass('casbin_rule') IS NOT NULL THEN
+        UPDATE casbin_rule
+        SET v3 = REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(v3,
+                    'GET', 'view'),
+                    'POST', 'create'),
+                    'PUT', 'edit'),
+                    'PATCH', 'edit'),
+                    'DELETE', 'delete')
+        WHERE ptype = 'p' AND v1 = 'cms';
+
+        -- Anchor action patterns so view does not also match preview
+        UPDATE casbin_rule
+        SET v3 = CASE
+                WHEN v3 LIKE '%|%' THEN '^(' || BTRIM(v3, '()') || ')$'
+                ELSE '^' || BTRIM(v3, '()') || '$'
+            END
+        WHERE ptype = 'p' AND v1 = 'cms' AND v3 NOT LIKE '^%';
+
+        -- Existing tabs keep the actions their role's tab policies grant, e.g. cms_admin keeps
+        -- create/edit/delete, so the next policy resync does not take write access away
+        UPDATE cms_roles r
+        SET tab_actions = (
+            SELECT COALESCE(jsonb_object_agg(t.tab, COALESCE(granted.actions, '["view"]'::jsonb)), '{}'::jsonb)
+            FROM unnest(r.tabs) AS t(tab)
+            LEFT JOIN LATERAL (
+                SELECT jsonb_agg(a.action ORDER BY array_position(ARRAY['view', 'create', 'edit', 'delete', 'export'], a.action)) AS actions
+                FROM (
+                    SELECT DISTINCT action
+                    FROM casbin_rule c,
+                         regexp_split_to_table(BTRIM(c.v3, '^()$'), '\|') AS action
+                    WHERE c.ptype = 'p'
+                      AND c.v0 = r.name
+                      AND c.v1 = 'cms'
+                      AND c.v2 = '/cms/' || t.tab || '/*'
+                      AND COALESCE(c.v4, '') <> 'deny'
+                ) a
+                WHERE a.action IN ('view', 'create', 'edit', 'delete', 'export')
+            ) granted ON TRUE
+        )
+        WHERE tab_actions = '{}'::jsonb;
+    END IF;
+END $$;
+
+-- Tabs of roles without tab policies keep read access
+UPDATE cms_roles
+SET tab_actions = (
+    SELECT COALESCE(jsonb_object_agg(tab, '["view"]'::jsonb), '{}'::jsonb)
+    FROM unnest(tabs) AS tab
+)
+WHERE tab_actions = '{}'::jsonb;
@@ -0,0 +1,41 @@
+-- CMS Tab Registry
+-- CMS tabs are registered here instead of being hard-coded, so new sections
+-- (promotions, vouchers, shipping, ...) can be added without a redeploy.
+
+-- ============================================
+-- 1. Create cms_tabs table
+-- ============================================
+CREATE TABLE IF NOT EXISTS cms_tabs (
+    key VARCHAR(100) PRIMARY KEY,
+    display_name VARCHAR(255) NOT NULL,
+    icon VARCHAR(100) NOT NULL DEFAULT '',
+    parent_key VARCHAR(100) REFERENCES cms_tabs(key) ON DELETE RESTRICT,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cms_tabs_parent_key ON cms_tabs(parent_key);
+
+-- ============================================
+-- 2. Seed built-in tabs
+-- ============================================
+INSERT INTO cms_tabs (key, display_name, icon, sort_order) VALUES
+('product', 'Products', 'box', 10),
+('inventory', 'Inventory', 'warehouse', 20),
+('order', 'Orders', 'shopping-cart', 30),
+('user', 'Users', 'users', 40),
+('report', 'Reports', 'bar-chart', 50),
+('setting', 'Settings', 'settings', 60)
+ON CONFLICT (key) DO NOTHING;
+
+-- Register any tab already granted to a CMS role or mapped to an API
+INSERT INTO cms_tabs (key, display_name)
+SELECT DISTINCT tab, INITCAP(tab)
+FROM (
+    SELECT unnest(tabs) AS tab FROM cms_roles
+    UNION
+    SELECT tab_name FROM cms_tab_apis
+) AS existing
+WHERE tab <> ''
+ON CONFLICT (key) DO NOTHING;
@@ -0,0 +1,30 @@
+-- Field-level permissions for CMS roles
+-- Each rule lists the fields a CMS role may read and write on a resource type.
+-- '*' grants every field. A role without a rule for a resource type is not restricted
+-- when it grants the CMS tab of that name, and grants no fields otherwise.
+
+-- ============================================
+-- 1. Create cms_role_field_permissions table
+-- ============================================
+CREATE TABLE IF NOT EXISTS cms_role_field_permissions (
+    id VARCHAR(36) PRIMARY KEY,
+    cms_role_id VARCHAR(36) NOT NULL REFERENCES cms_roles(id) ON DELETE CASCADE,
+    resource_type VARCHAR(100) NOT NULL,
+    readable_fields TEXT[] NOT NULL DEFAULT '{}',
+    writable_fields TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(cms_role_id, resource_type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cms_role_field_permissions_resource_type ON cms_role_field_permissions(resource_type);
+
+-- ============================================
+-- 2. Seed example rules
+-- ============================================
+-- Viewers see orders without customer contact or payment details
+INSERT INTO cms_role_field_permissions (id, cms_role_id, resource_type, readable_fields, writable_fields) VALUES
+('field-perm-001', 'cms-role-005', 'order', ARRAY['id', 'status', 'items', 'total', 'created_at'], ARRAY[]::TEXT[]),
+-- Content editors edit product content but not pricing
+('field-perm-002', 'cms-role-004', 'product', ARRAY['*'], ARRAY['name', 'description', 'images', 'category'])
+ON CONFLICT (cms_role_id, resource_type) DO NOTHING;
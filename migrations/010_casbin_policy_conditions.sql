@@ -0,0 +1,23 @@
+-- Attribute-based conditions on Casbin policies
+-- A condition is an expression over the request attributes (client_ip, time, resource_owner_id,
+-- user attributes). The policy only applies while its condition holds.
+-- Conditions are keyed by the policy's sub, dom, obj, act and effect and survive the policy being
+-- re-added. A deny condition that cannot be evaluated (e.g. a missing attribute) holds, so the
+-- deny still applies.
+
+-- ============================================
+-- 1. Create casbin_policy_conditions table
+-- ============================================
+CREATE TABLE IF NOT EXISTS casbin_policy_conditions (
+    subject VARCHAR(100) NOT NULL,
+    domain VARCHAR(100) NOT NULL,
+    object VARCHAR(100) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    effect VARCHAR(10) NOT NULL DEFAULT 'allow',
+    expression TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (subject, domain, object, action, effect)
+);
+
+CREATE INDEX IF NOT EXISTS idx_casbin_policy_conditions_domain ON casbin_policy_conditions(domain);
@@ -0,0 +1,43 @@
+-- Project the relational roles into Casbin
+-- roles, role_permissions and user_roles stay the source of truth; the service keeps the Casbin
+-- rules in step on every change. This one-shot backfill projects the data that already exists:
+--   - a role permission becomes p, <role>, user, /permissions/<resource>, ^<action>$, anchored
+--     so read does not also match unread
+--   - a role assignment becomes g, <user>, <role>, <dom> in the user and api domains
+-- Running it again adds nothing. On a fresh database casbin_rule is only created by the Casbin
+-- adapter once the service starts, and the service projects existing roles at startup instead.
+
+DO $$
+BEGIN
+    IF to_regclass('casbin_rule') IS NOT NULL THEN
+        -- ============================================
+        -- 1. Role permissions
+        -- ============================================
+        -- Only literal resources and actions are projected; other values would break Casbin's matchers
+        INSERT INTO casbin_rule (ptype, v0, v1, v2, v3)
+        SELECT DISTINCT 'p', r.name, 'user', '/permissions/' || p.resource, '^' || p.action || '$'
+        FROM role_permissions rp
+        JOIN roles r ON r.id = rp.role_id
+        JOIN permissions p ON p.id = rp.permission_id
+        WHERE p.resource ~ '^[A-Za-z0-9_-]+$'
+          AND p.action ~ '^[A-Za-z0-9_-]+$'
+          AND NOT EXISTS (
+            SELECT 1 FROM casbin_rule c
+            WHERE c.ptype = 'p' AND c.v0 = r.name AND c.v1 = 'user'
+              AND c.v2 = '/permissions/' || p.resource AND c.v3 = '^' || p.action || '$'
+          );
+
+        -- ============================================
+        -- 2. Role assignments
+        -- ============================================
+        INSERT INTO casbin_rule (ptype, v0, v1, v2)
+        SELECT DISTINCT 'g', ur.user_id, r.name, d.domain
+        FROM user_roles ur
+        JOIN roles r ON r.id = ur.role_id
+        CROSS JOIN (VALUES ('user'), ('api')) AS d(domain)
+        WHERE NOT EXISTS (
+            SELECT 1 FROM casbin_rule c
+            WHERE c.ptype = 'g' AND c.v0 = ur.user_id AND c.v1 = r.name AND c.v2 = d.domain
+        );
+    END IF;
+END $$;
@@ -0,0 +1,40 @@
+-- Time-bound role assignments
+-- A role assignment may start later and expire. Outside its window the Casbin link is ignored
+-- by enforcement; once it expires the assignment reaper deletes the assignment and its link.
+-- NULL leaves that side of the window open, so existing assignments keep working.
+
+-- ============================================
+-- 1. Assignment windows
+-- ============================================
+ALTER TABLE user_roles ADD COLUMN IF NOT EXISTS starts_at TIMESTAMP NULL;
+ALTER TABLE user_roles ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP NULL;
+ALTER TABLE user_roles DROP CONSTRAINT IF EXISTS chk_user_roles_window;
+ALTER TABLE user_roles ADD CONSTRAINT chk_user_roles_window
+    CHECK (starts_at IS NULL OR expires_at IS NULL OR expires_at > starts_at);
+
+ALTER TABLE user_cms_roles ADD COLUMN IF NOT EXISTS starts_at TIMESTAMP NULL;
+ALTER TABLE user_cms_roles ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP NULL;
+ALTER TABLE user_cms_roles DROP CONSTRAINT IF EXISTS chk_user_cms_roles_window;
+ALTER TABLE user_cms_roles ADD CONSTRAINT chk_user_cms_roles_window
+    CHECK (starts_at IS NULL OR expires_at IS NULL OR expires_at > starts_at);
+
+-- The reaper looks up expired assignments
+CREATE INDEX IF NOT EXISTS idx_user_roles_expires_at ON user_roles(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_user_cms_roles_expires_at ON user_cms_roles(expires_at) WHERE expires_at IS NOT NULL;
+
+-- ============================================
+-- 2. Casbin link windows
+-- ============================================
+-- The windows of the g, <user>, <role>, <dom> links the assignments are projected to: relational
+-- roles in the user and api domains, CMS roles in the cms domain
+CREATE OR REPLACE VIEW casbin_link_windows AS
+SELECT ur.user_id AS member, r.name AS role, d.domain, ur.starts_at, ur.expires_at
+FROM user_roles ur
+JOIN roles r ON r.id = ur.role_id
+CROSS JOIN (VALUES ('user'), ('api')) AS d(domain)
+WHERE ur.starts_at IS NOT NULL OR ur.expires_at IS NOT NULL
+UNION ALL
+SELECT ucr.user_id AS member, cr.name AS role, 'cms' AS domain, ucr.starts_at, ucr.expires_at
+FROM user_cms_roles ucr
+JOIN cms_roles cr ON cr.id = ucr.cms_role_id
+WHERE ucr.starts_at IS NOT NULL OR ucr.expires_at IS NOT NULL;
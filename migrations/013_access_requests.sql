@@ -0,0 +1,67 @@
+-- Access requests
+-- Staff request a role or CMS role with a justification and an optional duration. An approver
+-- of the role approves or denies the request; approval assigns the role, time-bound when a
+-- duration was requested. Pending requests expire when nobody decides in time.
+
+-- ============================================
+-- 1. Create access_requests table
+-- ============================================
+CREATE TABLE IF NOT EXISTS access_requests (
+    id VARCHAR(36) PRIMARY KEY,
+    requester_id VARCHAR(36) NOT NULL,
+    -- role: roles.id, cms_role: cms_roles.id
+    role_kind VARCHAR(20) NOT NULL CHECK (role_kind IN ('role', 'cms_role')),
+    role_id VARCHAR(36) NOT NULL,
+    justification TEXT NOT NULL,
+    -- How long the role is granted once approved; NULL for a permanent assignment
+    duration_seconds BIGINT NULL CHECK (duration_seconds IS NULL OR duration_seconds > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'denied', 'expired')),
+    decided_by VARCHAR(36) NULL,
+    decided_at TIMESTAMP NULL,
+    decision_comment TEXT NOT NULL DEFAULT '',
+    -- When the request expires if still pending
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (requester_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_requests_requester_id ON access_requests(requester_id);
+CREATE INDEX IF NOT EXISTS idx_access_requests_role ON access_requests(role_kind, role_id);
+CREATE INDEX IF NOT EXISTS idx_access_requests_pending ON access_requests(expires_at) WHERE status = 'pending';
+
+-- A user has at most one pending request per role
+CREATE UNIQUE INDEX IF NOT EXISTS idx_access_requests_one_pending
+    ON access_requests(requester_id, role_kind, role_id) WHERE status = 'pending';
+
+-- ============================================
+-- 2. Create access_request_approvers table
+-- ============================================
+-- Users allowed to decide requests for a role. Roles without approvers are decided by the users
+-- holding the default approver role.
+CREATE TABLE IF NOT EXISTS access_request_approvers (
+    role_kind VARCHAR(20) NOT NULL CHECK (role_kind IN ('role', 'cms_role')),
+    role_id VARCHAR(36) NOT NULL,
+    approver_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (role_kind, role_id, approver_id),
+    FOREIGN KEY (approver_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- ============================================
+-- 3. Create access_request_decisions table
+-- ============================================
+-- Audit trail: every state a request entered, who moved it there and why. Expiries are
+-- recorded with an empty actor.
+CREATE TABLE IF NOT EXISTS access_request_decisions (
+    id VARCHAR(36) PRIMARY KEY,
+    request_id VARCHAR(36) NOT NULL,
+    actor_id VARCHAR(36) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'approved', 'denied', 'expired')),
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (request_id) REFERENCES access_requests(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_request_decisions_request_id ON access_request_decisions(request_id);
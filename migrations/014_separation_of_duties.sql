@@ -0,0 +1,29 @@
+-- Separation of duties
+-- A constraint is a set of mutually exclusive roles. Static constraints stop anyone from holding
+-- two of the roles; dynamic constraints let a user hold them but activate only one per session.
+
+-- ============================================
+-- 1. Create sod_constraints table
+-- ============================================
+CREATE TABLE IF NOT EXISTS sod_constraints (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('static', 'dynamic')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================
+-- 2. Create sod_constraint_roles table
+-- ============================================
+CREATE TABLE IF NOT EXISTS sod_constraint_roles (
+    constraint_id VARCHAR(36) NOT NULL,
+    -- role: roles.id, cms_role: cms_roles.id
+    role_kind VARCHAR(20) NOT NULL CHECK (role_kind IN ('role', 'cms_role')),
+    role_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (constraint_id, role_kind, role_id),
+    FOREIGN KEY (constraint_id) REFERENCES sod_constraints(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sod_constraint_roles_role ON sod_constraint_roles(role_kind, role_id);
@@ -0,0 +1,51 @@
+-- Access review campaigns
+-- A campaign snapshots the role and CMS role assignments in its scope. Each snapshotted
+-- assignment is reviewed by one reviewer, who keeps or revokes it; revocations are applied when
+-- the campaign closes. Items stay after close as the evidence of the review.
+
+-- ============================================
+-- 1. Create access_review_campaigns table
+-- ============================================
+CREATE TABLE IF NOT EXISTS access_review_campaigns (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- {"role_kinds": [...], "role_ids": [...], "user_ids": [...]}; missing keys match everything
+    scope JSONB NOT NULL DEFAULT '{}'::jsonb,
+    default_reviewer_id VARCHAR(36) NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'closed')),
+    due_at TIMESTAMP NULL,
+    created_by VARCHAR(36) NOT NULL,
+    closed_by VARCHAR(36) NULL,
+    closed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_review_campaigns_status ON access_review_campaigns(status);
+
+-- ============================================
+-- 2. Create access_review_items table
+-- ============================================
+-- No foreign keys to users or roles: the evidence must survive their deletion
+CREATE TABLE IF NOT EXISTS access_review_items (
+    id VARCHAR(36) PRIMARY KEY,
+    campaign_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    -- role: roles.id, cms_role: cms_roles.id
+    role_kind VARCHAR(20) NOT NULL CHECK (role_kind IN ('role', 'cms_role')),
+    role_id VARCHAR(36) NOT NULL,
+    role_name VARCHAR(100) NOT NULL,
+    starts_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    reviewer_id VARCHAR(36) NOT NULL,
+    decision VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (decision IN ('pending', 'keep', 'revoke')),
+    comment TEXT NOT NULL DEFAULT '',
+    decided_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    revoke_error TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (campaign_id) REFERENCES access_review_campaigns(id) ON DELETE CASCADE,
+    UNIQUE (campaign_id, user_id, role_kind, role_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_review_items_reviewer ON access_review_items(campaign_id, reviewer_id);
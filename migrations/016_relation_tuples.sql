@@ -0,0 +1,35 @@
+-- Relationship-based authorization
+-- Relation tuples, object#relation@subject, record relations such as "u-1 owns order o-1" or
+-- "team t-1 members manage store s-1". Each namespace (order, store, ...) configures its relations
+-- and the userset rewrites between them, e.g. owner implies viewer. They are evaluated alongside
+-- the Casbin policies.
+
+-- ============================================
+-- 1. Create relation_namespaces table
+-- ============================================
+CREATE TABLE IF NOT EXISTS relation_namespaces (
+    name VARCHAR(100) PRIMARY KEY,
+    -- [{"name": ..., "computed_usersets": [...], "tuple_to_usersets": [{"tupleset": ..., "computed_userset": ...}]}]
+    relations JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================
+-- 2. Create relation_tuples table
+-- ============================================
+-- The subject is a user (subject_namespace and subject_relation empty), an object
+-- (subject_relation empty) or a userset
+CREATE TABLE IF NOT EXISTS relation_tuples (
+    namespace VARCHAR(100) NOT NULL,
+    object_id VARCHAR(255) NOT NULL,
+    relation VARCHAR(100) NOT NULL,
+    subject_namespace VARCHAR(100) NOT NULL DEFAULT '',
+    subject_id VARCHAR(255) NOT NULL,
+    subject_relation VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (namespace, object_id, relation, subject_namespace, subject_id, subject_relation),
+    FOREIGN KEY (namespace) REFERENCES relation_namespaces(name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_relation_tuples_subject ON relation_tuples(subject_namespace, subject_id, subject_relation);
@@ -0,0 +1,53 @@
+-- Resource-scoped role assignments
+-- A CMS role can be assigned within a scope, such as warehouse W1, instead of globally. Scoped
+-- assignments are not Casbin links: access checks naming a scope add the roles assigned on it,
+-- or on the scopes above it, to the user's global roles.
+
+-- ============================================
+-- 1. Create resource_scopes table
+-- ============================================
+-- The scope hierarchy, e.g. region > warehouse. Scopes need not be registered to be used; an
+-- unregistered scope simply has no parent.
+CREATE TABLE IF NOT EXISTS resource_scopes (
+    scope_type VARCHAR(100) NOT NULL,
+    scope_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    parent_type VARCHAR(100) NULL,
+    parent_id VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (scope_type, scope_id),
+    FOREIGN KEY (parent_type, parent_id) REFERENCES resource_scopes(scope_type, scope_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_resource_scopes_parent ON resource_scopes(parent_type, parent_id);
+
+-- ============================================
+-- 2. Create user_cms_role_scopes table
+-- ============================================
+CREATE TABLE IF NOT EXISTS user_cms_role_scopes (
+    user_id VARCHAR(36) NOT NULL,
+    cms_role_id VARCHAR(36) NOT NULL,
+    scope_type VARCHAR(100) NOT NULL,
+    scope_id VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, cms_role_id, scope_type, scope_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (cms_role_id) REFERENCES cms_roles(id) ON DELETE CASCADE,
+    CONSTRAINT chk_user_cms_role_scopes_window
+        CHECK (starts_at IS NULL OR expires_at IS NULL OR expires_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_cms_role_scopes_scope ON user_cms_role_scopes(user_id, scope_type, scope_id);
+CREATE INDEX IF NOT EXISTS idx_user_cms_role_scopes_expires_at ON user_cms_role_scopes(expires_at) WHERE expires_at IS NOT NULL;
+
+-- ============================================
+-- 3. Scope access review items
+-- ============================================
+-- Access reviews snapshot scoped assignments too; global assignments keep empty scope columns
+ALTER TABLE access_review_items ADD COLUMN IF NOT EXISTS scope_type VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE access_review_items ADD COLUMN IF NOT EXISTS scope_id VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE access_review_items DROP CONSTRAINT IF EXISTS access_review_items_campaign_id_user_id_role_kind_role_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_access_review_items_assignment
+    ON access_review_items(campaign_id, user_id, role_kind, role_id, scope_type, scope_id);
@@ -0,0 +1,388 @@
+package casbin
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/casbin/govaluate"
+	"go.uber.org/zap"
+)
+
+// Attributes with a built-in meaning in policy conditions.
+// Any other attribute (e.g. user attributes such as "department") is available by its name.
+const (
+	AttributeClientIP        = "client_ip"
+	AttributeTime            = "time" // RFC 3339; defaults to the time of the check
+	AttributeResourceOwnerID = "resource_owner_id"
+)
+
+// FieldCondition is reported by Explain when a policy's condition does not hold
+const FieldCondition = "condition"
+
+// PolicyCondition restricts a policy to requests whose attributes satisfy Expression, e.g.
+// `hour >= 8 && hour < 17`, `ipInRange(client_ip, "10.0.0.0/8")` or `resource_owner_id == user_id`.
+// Besides the request attributes, expressions can use user_id (the checked subject) and
+// hour, minute and weekday (0 = Sunday) of the request time.
+// A condition belongs to the policy with the same effect; a deny condition that cannot be
+// evaluated, e.g. for a missing attribute, holds so that the deny still applies.
+type PolicyCondition struct {
+	Subject    string
+	Domain     string
+	Object     string
+	Action     string
+	Effect     string
+	Expression string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type conditionKey struct {
+	subject string
+	domain  string
+	object  string
+	action  string
+	effect  string
+}
+
+type compiledCondition struct {
+	condition *PolicyCondition
+	// expression is nil when a stored expression no longer compiles
+	expression *govaluate.EvaluableExpression
+}
+
+// conditionFunctions are the functions available in condition expressions
+var conditionFunctions = map[string]govaluate.ExpressionFunction{
+	"ipInRange": ipInRange,
+}
+
+func compileCondition(expression string) (*govaluate.EvaluableExpression, error) {
+	compiled, err := govaluate.NewEvaluableExpressionWithFunctions(expression, conditionFunctions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition expression: %w", err)
+	}
+	return compiled, nil
+}
+
+// ipInRange reports whether the IP in the first argument is in any of the CIDR ranges that follow
+func ipInRange(args ...interface{}) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("ipInRange expects an IP and at least one CIDR range")
+	}
+
+	value, _ := args[0].(string)
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return false, nil
+	}
+	for _, arg := range args[1:] {
+		cidr, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("ipInRange expects CIDR ranges as strings")
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if network.Contains(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// requestContext carries the attributes of one enforcement into the matcher
+type requestContext struct {
+	subject    string
+	attributes map[string]string
+	parameters map[string]interface{}
+
+	// conditional is set when a policy condition took part in the decision,
+	// which then depends on the attributes and must not be cached
+	conditional bool
+}
+
+func newRequestContext(subject string, attributes map[string]string) *requestContext {
+	return &requestContext{subject: subject, attributes: attributes}
+}
+
+// evaluate reports whether the condition holds. A condition that cannot be evaluated, e.g. for a
+// missing attribute, never widens access: it fails on an allow policy and holds on a deny policy.
+func (rc *requestContext) evaluate(condition *compiledCondition) bool {
+	failClosed := condition.condition.Effect == EffectDeny
+	if condition.expression == nil {
+		return failClosed
+	}
+	if rc.parameters == nil {
+		rc.parameters = rc.buildParameters()
+	}
+
+	result, err := condition.expression.Evaluate(rc.parameters)
+	if err != nil {
+		return failClosed
+	}
+	holds, ok := result.(bool)
+	if !ok {
+		return failClosed
+	}
+	return holds
+}
+
+func (rc *requestContext) buildParameters() map[string]interface{} {
+	parameters := make(map[string]interface{}, len(rc.attributes)+4)
+	for name, value := range rc.attributes {
+		parameters[name] = value
+	}
+
+	now := time.Now()
+	if value, ok := rc.attributes[AttributeTime]; ok {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			now = t
+		}
+	}
+	parameters["user_id"] = rc.subject
+	parameters["hour"] = float64(now.Hour())
+	parameters["minute"] = float64(now.Minute())
+	parameters["weekday"] = float64(now.Weekday())
+	return parameters
+}
+
+// policyCondition is the matcher function checking the condition attached to a policy.
+// Models with p.eft pass the policy's effect before the request context; policies of models
+// without it are allow policies. Policies without a condition always match.
+func (e *Enforcer) policyCondition(args ...interface{}) (interface{}, error) {
+	effect := EffectAllow
+	switch len(args) {
+	case 5:
+	case 6:
+		if eft := fmt.Sprint(args[4]); eft != "" {
+			effect = eft
+		}
+	default:
+		return nil, fmt.Errorf("policyCondition expects sub, dom, obj, act, optionally eft, and the request context")
+	}
+
+	condition := e.lookupCondition(fmt.Sprint(args[0]), fmt.Sprint(args[1]), fmt.Sprint(args[2]), fmt.Sprint(args[3]), effect)
+	if condition == nil {
+		return true, nil
+	}
+
+	rc, ok := args[len(args)-1].(*requestContext)
+	if !ok {
+		// Without attributes a deny condition cannot be ruled out
+		return effect == EffectDeny, nil
+	}
+	rc.conditional = true
+	return rc.evaluate(condition), nil
+}
+
+func (e *Enforcer) lookupCondition(subject, domain, object, action, effect string) *compiledCondition {
+	e.conditionsMu.RLock()
+	defer e.conditionsMu.RUnlock()
+	return e.conditions[conditionKey{subject: subject, domain: domain, object: object, action: action, effect: effect}]
+}
+
+// ruleCondition returns the condition attached to a stored policy rule, if any
+func (e *Enforcer) ruleCondition(rule []string) *compiledCondition {
+	return e.lookupCondition(rule[0], rule[1], rule[2], rule[3], e.ruleEffect(rule))
+}
+
+// requestValues builds the Casbin request, adding the request context when the model has r.ctx
+func (e *Enforcer) requestValues(subject, domain, object, action string, rc *requestContext) []interface{} {
+	if e.hasContext {
+		return []interface{}{subject, domain, object, action, rc}
+	}
+	return []interface{}{subject, domain, object, action}
+}
+
+// SupportsConditions reports whether the loaded model evaluates policy conditions
+func (e *Enforcer) SupportsConditions() bool {
+	return e.hasContext
+}
+
+// SetPolicyCondition attaches a condition to an existing policy with the given effect,
+// replacing any previous one
+func (e *Enforcer) SetPolicyCondition(subject, domain, object, action, effect, expression string) (*PolicyCondition, error) {
+	if !e.hasContext {
+		return nil, fmt.Errorf("policy conditions require a model with r.ctx and policyCondition in the matcher")
+	}
+	rule, err := e.policyRule(subject, domain, object, action, effect)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := compileCondition(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.enforcer.GetFilteredPolicy(0, rule...)) == 0 {
+		return nil, fmt.Errorf("policy not found")
+	}
+
+	now := time.Now()
+	condition := &PolicyCondition{
+		Subject:    subject,
+		Domain:     domain,
+		Object:     object,
+		Action:     action,
+		Effect:     effect,
+		Expression: expression,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if e.db != nil {
+		query := `
+			INSERT INTO casbin_policy_conditions (subject, domain, object, action, effect, expression, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (subject, domain, object, action, effect)
+			DO UPDATE SET expression = EXCLUDED.expression, updated_at = EXCLUDED.updated_at
+			RETURNING created_at
+		`
+		err := e.db.QueryRow(query, subject, domain, object, action, effect, expression, now, now).Scan(&condition.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save policy condition: %w", err)
+		}
+	}
+
+	key := conditionKey{subject: subject, domain: domain, object: object, action: action, effect: effect}
+	e.conditionsMu.Lock()
+	e.conditions[key] = &compiledCondition{condition: condition, expression: compiled}
+	e.conditionsMu.Unlock()
+
+	e.conditionsChanged(subject, domain)
+	return condition, nil
+}
+
+// RemovePolicyCondition detaches the condition from the policy with the given effect
+func (e *Enforcer) RemovePolicyCondition(subject, domain, object, action, effect string) error {
+	key := conditionKey{subject: subject, domain: domain, object: object, action: action, effect: effect}
+	if e.lookupCondition(subject, domain, object, action, effect) == nil {
+		return fmt.Errorf("policy condition not found")
+	}
+
+	if e.db != nil {
+		query := `
+			DELETE FROM casbin_policy_conditions
+			WHERE subject = $1 AND domain = $2 AND object = $3 AND action = $4 AND effect = $5
+		`
+		if _, err := e.db.Exec(query, subject, domain, object, action, effect); err != nil {
+			return fmt.Errorf("failed to delete policy condition: %w", err)
+		}
+	}
+
+	e.conditionsMu.Lock()
+	delete(e.conditions, key)
+	e.conditionsMu.Unlock()
+
+	e.conditionsChanged(subject, domain)
+	return nil
+}
+
+// ListPolicyConditions returns the policy conditions of a domain, or of all domains when empty
+func (e *Enforcer) ListPolicyConditions(domain string) []*PolicyCondition {
+	e.conditionsMu.RLock()
+	conditions := make([]*PolicyCondition, 0, len(e.conditions))
+	for _, compiled := range e.conditions {
+		if domain == "" || compiled.condition.Domain == domain {
+			conditions = append(conditions, compiled.condition)
+		}
+	}
+	e.conditionsMu.RUnlock()
+
+	sort.Slice(conditions, func(i, j int) bool {
+		a, b := conditions[i], conditions[j]
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+		if a.Object != b.Object {
+			return a.Object < b.Object
+		}
+		if a.Action != b.Action {
+			return a.Action < b.Action
+		}
+		return a.Effect < b.Effect
+	})
+	return conditions
+}
+
+// conditionsChanged drops affected decisions and asks other instances to reload their conditions
+func (e *Enforcer) conditionsChanged(subject, domain string) {
+	e.invalidate(subject, domain)
+
+	if e.watcher != nil {
+		if err := e.watcher.publish(&policyUpdate{Op: opReloadConditions}); err != nil {
+			e.logger.Warn("Failed to publish policy condition change", zap.Error(err))
+		}
+	}
+}
+
+// loadConditions replaces the in-memory conditions with the stored ones
+func (e *Enforcer) loadConditions() error {
+	if e.db == nil {
+		return nil
+	}
+
+	rows, err := e.db.Query(`
+		SELECT subject, domain, object, action, effect, expression, created_at, updated_at
+		FROM casbin_policy_conditions
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to load policy conditions: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e.logger.Warn("Failed to close policy condition rows", zap.Error(err))
+		}
+	}()
+
+	conditions := make(map[conditionKey]*compiledCondition)
+	for rows.Next() {
+		condition := &PolicyCondition{}
+		err := rows.Scan(
+			&condition.Subject,
+			&condition.Domain,
+			&condition.Object,
+			&condition.Action,
+			&condition.Effect,
+			&condition.Expression,
+			&condition.CreatedAt,
+			&condition.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to load policy conditions: %w", err)
+		}
+
+		compiled, err := compileCondition(condition.Expression)
+		if err != nil {
+			// A broken condition must not silently widen access: evaluate fails it on an allow
+			// policy and holds it on a deny policy
+			e.logger.Error("Stored policy condition is invalid; the policy will not widen access",
+				zap.String("subject", condition.Subject),
+				zap.String("domain", condition.Domain),
+				zap.String("object", condition.Object),
+				zap.String("action", condition.Action),
+				zap.String("effect", condition.Effect),
+				zap.Error(err))
+		}
+
+		key := conditionKey{
+			subject: condition.Subject,
+			domain:  condition.Domain,
+			object:  condition.Object,
+			action:  condition.Action,
+			effect:  condition.Effect,
+		}
+		conditions[key] = &compiledCondition{condition: condition, expression: compiled}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to load policy conditions: %w", err)
+	}
+
+	e.conditionsMu.Lock()
+	e.conditions = conditions
+	e.conditionsMu.Unlock()
+	return nil
+}
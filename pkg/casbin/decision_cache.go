@@ -0,0 +1,116 @@
+package casbin
+
+import "sync"
+
+// DefaultDecisionCacheSize is the number of enforcement decisions kept in memory
+const DefaultDecisionCacheSize = 100000
+
+// decisionKey identifies a cached decision within a subject and domain
+type decisionKey struct {
+	object string
+	action string
+}
+
+// decisionCache caches enforcement results keyed by request tuple.
+// Entries are grouped by domain and subject so a policy or role change only drops the
+// decisions of the subjects it can affect.
+type decisionCache struct {
+	mu         sync.RWMutex
+	entries    map[string]map[string]map[decisionKey]bool // domain -> subject -> decision
+	size       int
+	maxEntries int
+
+	// generation changes on every invalidation; a decision computed before an
+	// invalidation must not be stored after it
+	generation uint64
+}
+
+func newDecisionCache(maxEntries int) *decisionCache {
+	return &decisionCache{
+		entries:    make(map[string]map[string]map[decisionKey]bool),
+		maxEntries: maxEntries,
+	}
+}
+
+// get returns the cached decision for the request, if any
+func (c *decisionCache) get(subject, domain, object, action string) (allowed bool, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	allowed, ok = c.entries[domain][subject][decisionKey{object: object, action: action}]
+	return allowed, ok
+}
+
+// currentGeneration returns the generation to pass to put once the decision is computed
+func (c *decisionCache) currentGeneration() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// put stores a decision unless the cache was invalidated since generation was read
+func (c *decisionCache) put(generation uint64, subject, domain, object, action string, allowed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	if c.size >= c.maxEntries {
+		// Start over rather than tracking usage on the hot path
+		c.entries = make(map[string]map[string]map[decisionKey]bool)
+		c.size = 0
+	}
+
+	subjects, ok := c.entries[domain]
+	if !ok {
+		subjects = make(map[string]map[decisionKey]bool)
+		c.entries[domain] = subjects
+	}
+	decisions, ok := subjects[subject]
+	if !ok {
+		decisions = make(map[decisionKey]bool)
+		subjects[subject] = decisions
+	}
+
+	key := decisionKey{object: object, action: action}
+	if _, exists := decisions[key]; !exists {
+		c.size++
+	}
+	decisions[key] = allowed
+}
+
+// invalidateSubjects drops the decisions of the given subjects in a domain
+func (c *decisionCache) invalidateSubjects(domain string, subjects []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	cached, ok := c.entries[domain]
+	if !ok {
+		return
+	}
+	for _, subject := range subjects {
+		c.size -= len(cached[subject])
+		delete(cached, subject)
+	}
+	if len(cached) == 0 {
+		delete(c.entries, domain)
+	}
+}
+
+// clear drops every cached decision
+func (c *decisionCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = make(map[string]map[string]map[decisionKey]bool)
+	c.size = 0
+}
+
+// len returns the number of cached decisions
+func (c *decisionCache) len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.size
+}
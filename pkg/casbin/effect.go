@@ -0,0 +1,79 @@
+package casbin
+
+import (
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Policy effects supported by models with a p.eft field
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// SupportsDeny reports whether the loaded model accepts deny policies
+func (e *Enforcer) SupportsDeny() bool {
+	return e.hasEffect
+}
+
+// policyRule builds a policy rule in the shape the model expects.
+// Allow-only models store sub, dom, obj, act; models with p.eft append the effect.
+func (e *Enforcer) policyRule(subject, domain, object, action, effect string) ([]string, error) {
+	switch effect {
+	case EffectAllow:
+	case EffectDeny:
+		if !e.hasEffect {
+			return nil, fmt.Errorf("deny policies require a model with p.eft, e.g. configs/rbac_deny_model.conf")
+		}
+	default:
+		return nil, fmt.Errorf("invalid policy effect %q: must be %s or %s", effect, EffectAllow, EffectDeny)
+	}
+
+	rule := []string{subject, domain, object, action}
+	if e.hasEffect {
+		rule = append(rule, effect)
+	}
+	return rule, nil
+}
+
+// ruleEffect returns the effect of a stored policy rule; rules of allow-only models allow
+func (e *Enforcer) ruleEffect(rule []string) string {
+	if e.hasEffect && len(rule) > 4 && rule[4] != "" {
+		return rule[4]
+	}
+	return EffectAllow
+}
+
+// normalizePolicyEffects rewrites stored policies after a model switch so they keep working:
+// switching to a model with p.eft marks existing policies as allow, and switching back strips
+// the allow effect again. Deny policies cannot be expressed without p.eft and are rejected.
+func normalizePolicyEffects(db *sql.DB, hasEffect bool, logger *zap.Logger) error {
+	if !hasEffect {
+		var denies int
+		err := db.QueryRow("SELECT COUNT(*) FROM casbin_rule WHERE ptype = 'p' AND v4 = $1", EffectDeny).Scan(&denies)
+		if err != nil {
+			return fmt.Errorf("failed to count deny policies: %w", err)
+		}
+		if denies > 0 {
+			return fmt.Errorf("found %d deny policies but the model has no p.eft; use a model supporting deny policies", denies)
+		}
+	}
+
+	query := "UPDATE casbin_rule SET v4 = '' WHERE ptype = 'p' AND v4 = $1"
+	if hasEffect {
+		query = "UPDATE casbin_rule SET v4 = $1 WHERE ptype = 'p' AND COALESCE(v4, '') = ''"
+	}
+	result, err := db.Exec(query, EffectAllow)
+	if err != nil {
+		return fmt.Errorf("failed to convert policies to the model's policy size: %w", err)
+	}
+
+	if converted, err := result.RowsAffected(); err == nil && converted > 0 {
+		logger.Info("Converted stored policies to the model's policy size",
+			zap.Int64("policies", converted),
+			zap.Bool("with_effect", hasEffect))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package casbin
+
+import (
+	"sort"
+	"strings"
+)
+
+// Grant is a policy a subject holds, directly or through a role it inherits
+type Grant struct {
+	// Policy is (sub, dom, obj, act, eft); sub is the subject itself or the role holding the policy
+	Policy []string
+	// RoleChain leads from the subject to the subject of Policy, e.g. [alice editor cms_admin]
+	RoleChain []string
+	// Condition is the expression the policy is restricted by, if any
+	Condition string
+}
+
+// Grants returns every policy a subject holds in a domain, directly or through the roles it
+// inherits, with the role chain granting it. Grants through shorter chains come first.
+func (e *Enforcer) Grants(subject, domain string) []Grant {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	var grants []Grant
+	for holder, chain := range e.roleChains(subject, domain) {
+		for _, rule := range e.enforcer.Enforcer.GetFilteredPolicy(0, holder, domain) {
+			if len(rule) < 4 {
+				continue
+			}
+			effect := e.ruleEffect(rule)
+			grant := Grant{
+				Policy:    []string{rule[0], rule[1], rule[2], rule[3], effect},
+				RoleChain: chain,
+			}
+			if condition := e.ruleCondition(rule); condition != nil {
+				grant.Condition = condition.condition.Expression
+			}
+			grants = append(grants, grant)
+		}
+	}
+
+	sort.Slice(grants, func(i, j int) bool {
+		if len(grants[i].RoleChain) != len(grants[j].RoleChain) {
+			return len(grants[i].RoleChain) < len(grants[j].RoleChain)
+		}
+		return strings.Join(grants[i].Policy, ",") < strings.Join(grants[j].Policy, ",")
+	})
+	return grants
+}
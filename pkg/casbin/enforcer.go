@@ -2,7 +2,13 @@ package casbin
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
@@ -12,10 +18,31 @@ import (
 	"gorm.io/gorm"
 )
 
-// Enforcer wraps Casbin enforcer with helper methods
+// Enforcer wraps a synchronized Casbin enforcer with helper methods.
+// It is safe for concurrent use and caches enforcement decisions until the
+// policies or role links they depend on change.
 type Enforcer struct {
-	enforcer *casbin.Enforcer
+	enforcer *casbin.SyncedEnforcer
+	cache    *decisionCache
 	logger   *zap.Logger
+	metrics  *SyncMetrics
+
+	// hasEffect is set when the model's policies carry an allow/deny effect (p.eft)
+	hasEffect bool
+	// hasContext is set when the model's requests carry attributes (r.ctx) for policy conditions
+	hasContext bool
+
+	db           *sql.DB
+	watcher      *Watcher
+	conditionsMu sync.RWMutex
+	conditions   map[conditionKey]*compiledCondition
+
+	// linkWindows limits time-bound role links; windowedMembers are the members having any and
+	// windowsBoundary is when the next window opens or closes
+	windowsMu       sync.RWMutex
+	linkWindows     map[linkKey]LinkWindow
+	windowedMembers map[string]bool
+	windowsBoundary time.Time
 }
 
 // NewEnforcer creates a new Casbin enforcer instance
@@ -47,8 +74,14 @@ func NewEnforcer(db *sql.DB, modelPath string, logger *zap.Logger) (*Enforcer, e
 		return nil, fmt.Errorf("failed to load Casbin model: %w", err)
 	}
 
+	// Stored policies must match the model's policy size before they are loaded
+	_, err = m.GetFieldIndex("p", "eft")
+	if err := normalizePolicyEffects(db, err == nil, logger); err != nil {
+		return nil, err
+	}
+
 	// Create enforcer
-	enforcer, err := casbin.NewEnforcer(m, adapter)
+	enforcer, err := casbin.NewSyncedEnforcer(m, adapter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Casbin enforcer: %w", err)
 	}
@@ -58,17 +91,92 @@ func NewEnforcer(db *sql.DB, modelPath string, logger *zap.Logger) (*Enforcer, e
 		return nil, fmt.Errorf("failed to load policies: %w", err)
 	}
 
-	logger.Info("Casbin enforcer initialized successfully")
+	e := newEnforcer(enforcer, DefaultDecisionCacheSize, logger)
+	e.db = db
+	if err := e.loadConditions(); err != nil {
+		return nil, err
+	}
+	if err := e.loadLinkWindows(); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Casbin enforcer initialized successfully",
+		zap.String("model", modelPath),
+		zap.Bool("deny_policies", e.hasEffect))
+
+	return e, nil
+}
+
+// NewMemoryEnforcer creates an enforcer whose rules live in memory only, e.g. for tests.
+// Changes that are stored through the database, such as ApplyRuleChanges, fail.
+func NewMemoryEnforcer(modelPath string, logger *zap.Logger) (*Enforcer, error) {
+	m, err := model.NewModelFromFile(modelPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Casbin model: %w", err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Casbin enforcer: %w", err)
+	}
+	return newEnforcer(enforcer, DefaultDecisionCacheSize, logger), nil
+}
+
+// newEnforcer wraps an enforcer with a decision cache of the given size; zero disables caching
+func newEnforcer(enforcer *casbin.SyncedEnforcer, cacheSize int, logger *zap.Logger) *Enforcer {
+	_, err := enforcer.GetModel().GetFieldIndex("p", "eft")
+	e := &Enforcer{
+		enforcer:   enforcer,
+		logger:     logger,
+		hasEffect:  err == nil,
+		conditions: make(map[conditionKey]*compiledCondition),
+	}
+	if cacheSize > 0 {
+		e.cache = newDecisionCache(cacheSize)
+	}
 
-	return &Enforcer{
-		enforcer: enforcer,
-		logger:   logger,
-	}, nil
+	// Role links outside their assignment window are ignored by every check
+	if rm := enforcer.GetRoleManager(); rm != nil {
+		enforcer.SetRoleManager(&windowedRoleManager{RoleManager: rm, enforcer: e})
+		if err := enforcer.BuildRoleLinks(); err != nil {
+			logger.Error("Failed to rebuild role links", zap.Error(err))
+		}
+	}
+
+	if requestDef, ok := enforcer.GetModel()["r"]["r"]; ok {
+		for _, token := range requestDef.Tokens {
+			if token == "r_ctx" {
+				e.hasContext = true
+			}
+		}
+	}
+	if e.hasContext {
+		enforcer.AddFunction("policyCondition", e.policyCondition)
+	}
+	return e
 }
 
 // Enforce checks if a subject can perform an action on an object in a domain
 func (e *Enforcer) Enforce(subject, domain, object, action string) (bool, error) {
-	allowed, err := e.enforcer.Enforce(subject, domain, object, action)
+	return e.EnforceWithAttributes(subject, domain, object, action, nil)
+}
+
+// EnforceWithAttributes checks a request against policies and the conditions attached to them.
+// Decisions that depended on a condition or on a time-bound role link are not cached.
+func (e *Enforcer) EnforceWithAttributes(subject, domain, object, action string, attributes map[string]string) (bool, error) {
+	e.checkLinkWindows()
+
+	// A cached decision never involved a condition, so it holds for any attributes
+	cacheable := e.cache != nil && !e.hasLinkWindows(subject)
+	var generation uint64
+	if cacheable {
+		if allowed, ok := e.cache.get(subject, domain, object, action); ok {
+			return allowed, nil
+		}
+		generation = e.cache.currentGeneration()
+	}
+
+	rc := newRequestContext(subject, attributes)
+	allowed, err := e.enforcer.Enforce(e.requestValues(subject, domain, object, action, rc)...)
 	if err != nil {
 		e.logger.Error("Failed to enforce policy",
 			zap.String("subject", subject),
@@ -86,12 +194,97 @@ func (e *Enforcer) Enforce(subject, domain, object, action string) (bool, error)
 		zap.String("action", action),
 		zap.Bool("allowed", allowed))
 
+	if cacheable && !rc.conditional {
+		e.cache.put(generation, subject, domain, object, action, allowed)
+	}
 	return allowed, nil
 }
 
-// AddPolicy adds a policy rule
+// BatchEnforce checks several (subject, domain, object, action) requests under a single read lock.
+// Results and errors are returned in request order; a failing request does not stop the others.
+func (e *Enforcer) BatchEnforce(requests [][]string) ([]bool, []error) {
+	return e.BatchEnforceWithAttributes(requests, nil)
+}
+
+// BatchEnforceWithAttributes is BatchEnforce with the attributes of each request for policy
+// conditions. attributes is either nil or has one entry per request.
+func (e *Enforcer) BatchEnforceWithAttributes(requests [][]string, attributes []map[string]string) ([]bool, []error) {
+	results := make([]bool, len(requests))
+	errs := make([]error, len(requests))
+	e.checkLinkWindows()
+
+	var generation uint64
+	if e.cache != nil {
+		generation = e.cache.currentGeneration()
+	}
+
+	// Only evaluate what is not cached
+	pending := make([]int, 0, len(requests))
+	for i, request := range requests {
+		if len(request) != 4 {
+			errs[i] = fmt.Errorf("invalid request: expected 4 values, got %d", len(request))
+			continue
+		}
+		if e.cache != nil && !e.hasLinkWindows(request[0]) {
+			if allowed, ok := e.cache.get(request[0], request[1], request[2], request[3]); ok {
+				results[i] = allowed
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+
+	for len(pending) > 0 {
+		rvals := make([][]interface{}, len(pending))
+		contexts := make([]*requestContext, len(pending))
+		for j, i := range pending {
+			var attrs map[string]string
+			if attributes != nil {
+				attrs = attributes[i]
+			}
+			contexts[j] = newRequestContext(requests[i][0], attrs)
+			rvals[j] = e.requestValues(requests[i][0], requests[i][1], requests[i][2], requests[i][3], contexts[j])
+		}
+
+		// Casbin stops at the first failing request and returns the results before it
+		allowed, err := e.enforcer.BatchEnforce(rvals)
+		for j, result := range allowed {
+			i := pending[j]
+			results[i] = result
+			if e.cache != nil && !contexts[j].conditional && !e.hasLinkWindows(requests[i][0]) {
+				e.cache.put(generation, requests[i][0], requests[i][1], requests[i][2], requests[i][3], result)
+			}
+		}
+		if err == nil {
+			break
+		}
+
+		failed := pending[len(allowed)]
+		e.logger.Error("Failed to enforce policy",
+			zap.Strings("request", requests[failed]),
+			zap.Error(err))
+		// Recovered matcher panics carry a stack trace; keep only the message for callers
+		message, _, _ := strings.Cut(err.Error(), "\n")
+		errs[failed] = errors.New(message)
+		pending = pending[len(allowed)+1:]
+	}
+
+	return results, errs
+}
+
+// AddPolicy adds an allow policy rule
 func (e *Enforcer) AddPolicy(subject, domain, object, action string) error {
-	added, err := e.enforcer.AddPolicy(subject, domain, object, action)
+	return e.AddPolicyWithEffect(subject, domain, object, action, EffectAllow)
+}
+
+// AddPolicyWithEffect adds a policy rule with an allow or deny effect
+func (e *Enforcer) AddPolicyWithEffect(subject, domain, object, action, effect string) error {
+	rule, err := e.policyRule(subject, domain, object, action, effect)
+	if err != nil {
+		return err
+	}
+
+	added, err := e.enforcer.AddPolicy(rule)
 	if err != nil {
 		return fmt.Errorf("failed to add policy: %w", err)
 	}
@@ -100,14 +293,27 @@ func (e *Enforcer) AddPolicy(subject, domain, object, action string) error {
 			zap.String("subject", subject),
 			zap.String("domain", domain),
 			zap.String("object", object),
-			zap.String("action", action))
+			zap.String("action", action),
+			zap.String("effect", effect))
+		return nil
 	}
+	e.invalidate(subject, domain)
 	return nil
 }
 
-// RemovePolicy removes a policy rule
+// RemovePolicy removes an allow policy rule
 func (e *Enforcer) RemovePolicy(subject, domain, object, action string) error {
-	removed, err := e.enforcer.RemovePolicy(subject, domain, object, action)
+	return e.RemovePolicyWithEffect(subject, domain, object, action, EffectAllow)
+}
+
+// RemovePolicyWithEffect removes a policy rule with an allow or deny effect
+func (e *Enforcer) RemovePolicyWithEffect(subject, domain, object, action, effect string) error {
+	rule, err := e.policyRule(subject, domain, object, action, effect)
+	if err != nil {
+		return err
+	}
+
+	removed, err := e.enforcer.RemovePolicy(rule)
 	if err != nil {
 		return fmt.Errorf("failed to remove policy: %w", err)
 	}
@@ -116,11 +322,69 @@ func (e *Enforcer) RemovePolicy(subject, domain, object, action string) error {
 			zap.String("subject", subject),
 			zap.String("domain", domain),
 			zap.String("object", object),
-			zap.String("action", action))
+			zap.String("action", action),
+			zap.String("effect", effect))
+		return nil
 	}
+	e.invalidate(subject, domain)
 	return nil
 }
 
+// GetFilteredPolicies returns the policy rules matching the given values, sorted.
+// Empty values match anything; an effect filter only applies to models with p.eft,
+// where allow-only models have no deny policies.
+func (e *Enforcer) GetFilteredPolicies(subject, domain, object, action, effect string) [][]string {
+	values := []string{subject, domain, object, action}
+	if e.hasEffect {
+		values = append(values, effect)
+	} else if effect != "" && effect != EffectAllow {
+		return nil
+	}
+
+	rules := e.enforcer.GetFilteredPolicy(0, values...)
+	sort.Slice(rules, func(i, j int) bool {
+		return strings.Join(rules[i], ",") < strings.Join(rules[j], ",")
+	})
+	return rules
+}
+
+// RemoveFilteredPolicy removes all policy rules matching the given field filter
+func (e *Enforcer) RemoveFilteredPolicy(fieldIndex int, fieldValues ...string) error {
+	removed, err := e.removeFiltered("p", fieldIndex, fieldValues...)
+	if err != nil {
+		return fmt.Errorf("failed to remove filtered policy: %w", err)
+	}
+	e.invalidateRules("p", removed)
+	return nil
+}
+
+// RemoveFilteredGroupingPolicy removes all role links matching the given field filter
+func (e *Enforcer) RemoveFilteredGroupingPolicy(fieldIndex int, fieldValues ...string) error {
+	removed, err := e.removeFiltered("g", fieldIndex, fieldValues...)
+	if err != nil {
+		return fmt.Errorf("failed to remove filtered grouping policy: %w", err)
+	}
+	e.invalidateRules("g", removed)
+	return nil
+}
+
+// removeFiltered removes the matching rules of a section and returns them.
+// The lock is held across lookup and removal so the returned rules are exactly the removed ones.
+func (e *Enforcer) removeFiltered(sec string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	lock := e.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if sec == "g" {
+		rules := e.enforcer.Enforcer.GetFilteredGroupingPolicy(fieldIndex, fieldValues...)
+		_, err := e.enforcer.Enforcer.RemoveFilteredGroupingPolicy(fieldIndex, fieldValues...)
+		return rules, err
+	}
+	rules := e.enforcer.Enforcer.GetFilteredPolicy(fieldIndex, fieldValues...)
+	_, err := e.enforcer.Enforcer.RemoveFilteredPolicy(fieldIndex, fieldValues...)
+	return rules, err
+}
+
 // AddRoleForUser adds a role for a user in a domain
 func (e *Enforcer) AddRoleForUser(user, role, domain string) error {
 	added, err := e.enforcer.AddRoleForUser(user, role, domain)
@@ -132,7 +396,9 @@ func (e *Enforcer) AddRoleForUser(user, role, domain string) error {
 			zap.String("user", user),
 			zap.String("role", role),
 			zap.String("domain", domain))
+		return nil
 	}
+	e.invalidate(user, domain)
 	return nil
 }
 
@@ -147,7 +413,9 @@ func (e *Enforcer) DeleteRoleForUser(user, role, domain string) error {
 			zap.String("user", user),
 			zap.String("role", role),
 			zap.String("domain", domain))
+		return nil
 	}
+	e.invalidate(user, domain)
 	return nil
 }
 
@@ -178,9 +446,195 @@ func (e *Enforcer) GetPermissionsForUser(user, domain string) ([][]string, error
 	return permissions, nil
 }
 
+// invalidate drops the cached decisions of a subject and of every user inheriting it in the domain
+func (e *Enforcer) invalidate(subject, domain string) {
+	if e.cache == nil {
+		return
+	}
+
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	subjects := e.affectedSubjects(subject, domain)
+	lock.RUnlock()
+
+	e.cache.invalidateSubjects(domain, subjects)
+}
+
+// invalidateRules drops the cached decisions depending on the given policies or role links of
+// a section
+func (e *Enforcer) invalidateRules(sec string, rules [][]string) {
+	for _, rule := range rules {
+		subject, domain, ok := ruleSubjectDomain(sec, rule)
+		if !ok {
+			e.invalidateAll()
+			return
+		}
+		e.invalidate(subject, domain)
+	}
+}
+
+// ruleSubjectDomain returns the subject whose decisions a rule affects and the rule's domain.
+// Policies are (subject, domain, ...) and role links (member, role, domain).
+func ruleSubjectDomain(sec string, rule []string) (string, string, bool) {
+	domainIndex := 1
+	if sec == "g" {
+		domainIndex = 2
+	}
+	if len(rule) <= domainIndex {
+		return "", "", false
+	}
+	return rule[0], rule[domainIndex], true
+}
+
+// invalidateAll drops every cached decision
+func (e *Enforcer) invalidateAll() {
+	if e.cache != nil {
+		e.cache.clear()
+	}
+}
+
+// affectedSubjects returns the subject and all users inheriting it in the domain.
+// The caller must hold the enforcer lock.
+func (e *Enforcer) affectedSubjects(subject, domain string) []string {
+	users, err := e.enforcer.Enforcer.GetImplicitUsersForRole(subject, domain)
+	if err != nil {
+		e.logger.Warn("Failed to resolve users inheriting subject",
+			zap.String("subject", subject),
+			zap.String("domain", domain),
+			zap.Error(err))
+	}
+	return append(users, subject)
+}
+
+// EnableWatcher publishes every policy change through the watcher and applies changes made by other instances
+func (e *Enforcer) EnableWatcher(w *Watcher) error {
+	if err := e.enforcer.SetWatcher(w); err != nil {
+		return fmt.Errorf("failed to set watcher: %w", err)
+	}
+	e.metrics = w.Metrics()
+	e.watcher = w
+	return w.SetUpdateCallback(e.applyPolicyUpdate)
+}
+
+// SyncMetrics returns the policy sync metrics, or nil when no watcher is enabled
+func (e *Enforcer) SyncMetrics() *SyncMetrics {
+	return e.metrics
+}
+
+// applyPolicyUpdate applies a change published by another instance.
+// The change is already stored in the database, so only the in-memory model is updated;
+// anything that cannot be applied incrementally falls back to a full reload.
+func (e *Enforcer) applyPolicyUpdate(payload string) {
+	var update policyUpdate
+	if err := json.Unmarshal([]byte(payload), &update); err != nil {
+		e.metrics.RecordError()
+		e.logger.Error("Failed to decode policy update", zap.Error(err))
+		return
+	}
+
+	if update.Op == opReloadConditions {
+		if err := e.loadConditions(); err != nil {
+			e.metrics.RecordError()
+			e.logger.Error("Failed to reload policy conditions", zap.Error(err))
+			return
+		}
+		e.invalidateAll()
+		e.metrics.RecordApplied()
+		return
+	}
+	if update.Op == opReloadLinkWindows {
+		if err := e.loadLinkWindows(); err != nil {
+			e.metrics.RecordError()
+			e.logger.Error("Failed to reload link windows", zap.Error(err))
+			return
+		}
+		e.invalidateAll()
+		e.metrics.RecordApplied()
+		return
+	}
+
+	if err := e.applyIncrementalUpdate(&update); err != nil {
+		e.logger.Warn("Incremental policy update failed, reloading policies",
+			zap.String("op", update.Op),
+			zap.Error(err))
+		update.Op = opReload
+	}
+
+	if update.Op != opReload {
+		e.metrics.RecordApplied()
+		return
+	}
+
+	if err := e.LoadPolicy(); err != nil {
+		e.metrics.RecordError()
+		e.logger.Error("Failed to reload policies", zap.Error(err))
+		return
+	}
+	e.metrics.RecordReload()
+	e.logger.Info("Policies reloaded after update from another instance")
+}
+
+func (e *Enforcer) applyIncrementalUpdate(update *policyUpdate) error {
+	if update.Op == opReload {
+		return nil
+	}
+
+	lock := e.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	m := e.enforcer.GetModel()
+
+	var changed [][]string
+	var op model.PolicyOp
+	switch update.Op {
+	case opAddPolicies:
+		changed = m.AddPoliciesWithAffected(update.Sec, update.PType, update.Rules)
+		op = model.PolicyAdd
+	case opRemovePolicies:
+		changed = m.RemovePoliciesWithAffected(update.Sec, update.PType, update.Rules)
+		op = model.PolicyRemove
+	case opRemoveFiltered:
+		_, changed = m.RemoveFilteredPolicy(update.Sec, update.PType, update.FieldIndex, update.FieldValues...)
+		op = model.PolicyRemove
+	default:
+		return fmt.Errorf("unknown policy update operation %q", update.Op)
+	}
+
+	// Role links are indexed separately and must follow grouping policy changes
+	if update.Sec == "g" && len(changed) > 0 {
+		if err := e.enforcer.BuildIncrementalRoleLinks(op, update.PType, changed); err != nil {
+			return err
+		}
+	}
+
+	// The lock is already held, so resolve affected subjects directly
+	if e.cache != nil {
+		for _, rule := range changed {
+			subject, domain, ok := ruleSubjectDomain(update.Sec, rule)
+			if !ok {
+				e.cache.clear()
+				break
+			}
+			e.cache.invalidateSubjects(domain, e.affectedSubjects(subject, domain))
+		}
+	}
+	return nil
+}
+
 // LoadPolicy reloads the policy from database
 func (e *Enforcer) LoadPolicy() error {
-	return e.enforcer.LoadPolicy()
+	if err := e.enforcer.LoadPolicy(); err != nil {
+		return err
+	}
+	if err := e.loadConditions(); err != nil {
+		return err
+	}
+	if err := e.loadLinkWindows(); err != nil {
+		return err
+	}
+	e.invalidateAll()
+	return nil
 }
 
 // SavePolicy saves all policy rules to database
@@ -0,0 +1,869 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testModelPath     = "../../configs/rbac_model.conf"
+	testDenyModelPath = "../../configs/rbac_deny_model.conf"
+)
+
+// newTestEnforcer creates an enforcer without a database adapter
+func newTestEnforcer(tb testing.TB, cacheSize int) *Enforcer {
+	tb.Helper()
+	return newTestEnforcerWithModel(tb, testModelPath, cacheSize)
+}
+
+func newTestEnforcerWithModel(tb testing.TB, modelPath string, cacheSize int) *Enforcer {
+	tb.Helper()
+
+	m, err := model.NewModelFromFile(modelPath)
+	require.NoError(tb, err)
+
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	require.NoError(tb, err)
+
+	return newEnforcer(enforcer, cacheSize, zap.NewNop())
+}
+
+func TestEnforcerDecisionCache(t *testing.T) {
+	t.Run("Role assignment invalidates cached denial", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "(GET|POST)"))
+
+		allowed, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, 1, e.cache.len())
+
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+
+		allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Policy change only drops decisions of affected subjects", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+		require.NoError(t, e.AddPolicy("viewer", "api", "/api/v1/reports/*", "GET"))
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+		require.NoError(t, e.AddRoleForUser("bob", "viewer", "api"))
+
+		_, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		_, err = e.Enforce("bob", "api", "/api/v1/reports/1", "GET")
+		require.NoError(t, err)
+		_, err = e.Enforce("bob", "cms", "/cms/order/*", "view")
+		require.NoError(t, err)
+
+		require.NoError(t, e.RemovePolicy("admin", "api", "/api/v1/users/*", "GET"))
+
+		_, ok := e.cache.get("alice", "api", "/api/v1/users/1", "GET")
+		assert.False(t, ok)
+		_, ok = e.cache.get("bob", "api", "/api/v1/reports/1", "GET")
+		assert.True(t, ok)
+		_, ok = e.cache.get("bob", "cms", "/cms/order/*", "view")
+		assert.True(t, ok)
+
+		allowed, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Filtered removal invalidates inherited roles", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("editor", "cms", "/cms/order/*", "(view|edit)"))
+		require.NoError(t, e.AddRoleForUser("senior-editor", "editor", "cms"))
+		require.NoError(t, e.AddRoleForUser("carol", "senior-editor", "cms"))
+
+		allowed, err := e.Enforce("carol", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		require.NoError(t, e.RemoveFilteredPolicy(0, "editor", "cms"))
+
+		allowed, err = e.Enforce("carol", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Filtered role link removal invalidates the member", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+
+		allowed, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		require.NoError(t, e.RemoveFilteredGroupingPolicy(0, "alice", "admin", "api"))
+
+		allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Role link from another instance invalidates the member", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		e.metrics = NewSyncMetrics()
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+
+		allowed, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		payload, err := json.Marshal(&policyUpdate{
+			Instance: "other",
+			Op:       opAddPolicies,
+			Sec:      "g",
+			PType:    "g",
+			Rules:    [][]string{{"alice", "admin", "api"}},
+		})
+		require.NoError(t, err)
+		e.applyPolicyUpdate(string(payload))
+
+		allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Decision computed before an invalidation is not stored", func(t *testing.T) {
+		c := newDecisionCache(10)
+		generation := c.currentGeneration()
+		c.invalidateSubjects("api", []string{"alice"})
+
+		c.put(generation, "alice", "api", "/api/v1/users/1", "GET", true)
+
+		_, ok := c.get("alice", "api", "/api/v1/users/1", "GET")
+		assert.False(t, ok)
+	})
+}
+
+func TestEnforcerBatchEnforce(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "(GET|POST)"))
+	require.NoError(t, e.AddPolicy("broken", "api", "/api/v1/orders/*", "(GET"))
+	require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+	require.NoError(t, e.AddRoleForUser("bob", "broken", "api"))
+
+	// A cached decision is reused inside the batch
+	_, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+
+	results, errs := e.BatchEnforce([][]string{
+		{"alice", "api", "/api/v1/users/1", "GET"},
+		{"bob", "api", "/api/v1/orders/1", "GET"},
+		{"alice", "api", "/api/v1/users/1", "DELETE"},
+		{"alice", "api"},
+		{"alice", "api", "/api/v1/users/2", "POST"},
+	})
+
+	assert.Equal(t, []bool{true, false, false, false, true}, results)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	assert.NotContains(t, errs[1].Error(), "\n")
+	assert.NoError(t, errs[2])
+	assert.Error(t, errs[3])
+	assert.NoError(t, errs[4])
+}
+
+func TestEnforcerExplain(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("cms_admin", "cms", "/cms/order/*", "(view|edit|delete)"))
+	require.NoError(t, e.AddPolicy("order_viewer", "cms", "/cms/order/*", "view"))
+	require.NoError(t, e.AddPolicy("product_editor", "cms", "/cms/product/*", "(view|edit)"))
+	require.NoError(t, e.AddRoleForUser("senior-editor", "cms_admin", "cms"))
+	require.NoError(t, e.AddRoleForUser("alice", "senior-editor", "cms"))
+	require.NoError(t, e.AddRoleForUser("bob", "order_viewer", "cms"))
+
+	t.Run("Allowed request reports matched policy and role chain", func(t *testing.T) {
+		explanation, err := e.Explain("alice", "cms", "/cms/order/*", "delete", nil)
+		require.NoError(t, err)
+
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, []string{"cms_admin", "cms", "/cms/order/*", "(view|edit|delete)"}, explanation.MatchedPolicy)
+		assert.Equal(t, []string{"alice", "senior-editor", "cms_admin"}, explanation.RoleChain)
+		assert.Empty(t, explanation.Candidates)
+	})
+
+	t.Run("Denied request reports closest candidates", func(t *testing.T) {
+		explanation, err := e.Explain("bob", "cms", "/cms/order/*", "edit", nil)
+		require.NoError(t, err)
+
+		assert.False(t, explanation.Allowed)
+		require.Len(t, explanation.Candidates, 3)
+
+		// bob holds order_viewer, which only lacks the action
+		assert.Equal(t, "order_viewer", explanation.Candidates[0].Policy[0])
+		assert.Equal(t, []string{"bob", "order_viewer"}, explanation.Candidates[0].RoleChain)
+		assert.Equal(t, []string{FieldAction}, explanation.Candidates[0].FailedFields)
+
+		assert.Equal(t, "cms_admin", explanation.Candidates[1].Policy[0])
+		assert.Equal(t, []string{FieldSubject}, explanation.Candidates[1].FailedFields)
+
+		assert.Equal(t, []string{FieldSubject, FieldObject}, explanation.Candidates[2].FailedFields)
+	})
+}
+
+func TestEnforcerDenyPolicies(t *testing.T) {
+	t.Run("Deny overrides allow", func(t *testing.T) {
+		e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+		require.True(t, e.SupportsDeny())
+		require.NoError(t, e.AddPolicy("cms_support", "cms", "/cms/*", "(view|edit)"))
+		require.NoError(t, e.AddRoleForUser("dave", "cms_support", "cms"))
+
+		allowed, err := e.Enforce("dave", "cms", "/cms/refunds/*", "edit")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		require.NoError(t, e.AddPolicyWithEffect("cms_support", "cms", "/cms/refunds/*", "(view|edit)", EffectDeny))
+
+		allowed, err = e.Enforce("dave", "cms", "/cms/refunds/*", "edit")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		allowed, err = e.Enforce("dave", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		explanation, err := e.Explain("dave", "cms", "/cms/refunds/*", "view", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cms_support", "cms", "/cms/refunds/*", "(view|edit)", EffectDeny}, explanation.MatchedPolicy)
+		assert.Equal(t, []string{"dave", "cms_support"}, explanation.RoleChain)
+	})
+
+	t.Run("Allow-only model rejects deny policies", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		assert.False(t, e.SupportsDeny())
+		assert.Error(t, e.AddPolicyWithEffect("cms_support", "cms", "/cms/refunds/*", "view", EffectDeny))
+		assert.Error(t, e.AddPolicyWithEffect("cms_support", "cms", "/cms/refunds/*", "view", "maybe"))
+	})
+}
+
+func TestEnforcerPolicyConditions(t *testing.T) {
+	t.Run("Business hours", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.True(t, e.SupportsConditions())
+		require.NoError(t, e.AddPolicy("cms_support", "cms", "/cms/order/*", "edit"))
+		require.NoError(t, e.AddRoleForUser("dave", "cms_support", "cms"))
+		_, err := e.SetPolicyCondition("cms_support", "cms", "/cms/order/*", "edit", EffectAllow, "hour >= 8 && hour < 17")
+		require.NoError(t, err)
+
+		allowed, err := e.EnforceWithAttributes("dave", "cms", "/cms/order/*", "edit",
+			map[string]string{AttributeTime: "2026-03-02T10:00:00Z"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = e.EnforceWithAttributes("dave", "cms", "/cms/order/*", "edit",
+			map[string]string{AttributeTime: "2026-03-02T22:00:00Z"})
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		// Conditional decisions depend on the attributes and are never cached
+		assert.Equal(t, 0, e.cache.len())
+	})
+
+	t.Run("Client IP range", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "DELETE"))
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+		_, err := e.SetPolicyCondition("admin", "api", "/api/v1/users/*", "DELETE", EffectAllow, `ipInRange(client_ip, "10.0.0.0/8")`)
+		require.NoError(t, err)
+
+		allowed, err := e.EnforceWithAttributes("alice", "api", "/api/v1/users/1", "DELETE",
+			map[string]string{AttributeClientIP: "10.1.2.3"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = e.EnforceWithAttributes("alice", "api", "/api/v1/users/1", "DELETE",
+			map[string]string{AttributeClientIP: "203.0.113.7"})
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		// A missing attribute fails the condition
+		allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "DELETE")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		explanation, err := e.Explain("alice", "api", "/api/v1/users/1", "DELETE", nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, explanation.Candidates)
+		assert.Equal(t, []string{FieldCondition}, explanation.Candidates[0].FailedFields)
+	})
+
+	t.Run("Resource ownership", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("customer", "user", "/orders/*", "cancel"))
+		require.NoError(t, e.AddRoleForUser("bob", "customer", "user"))
+		_, err := e.SetPolicyCondition("customer", "user", "/orders/*", "cancel", EffectAllow, "resource_owner_id == user_id")
+		require.NoError(t, err)
+
+		allowed, err := e.EnforceWithAttributes("bob", "user", "/orders/42", "cancel",
+			map[string]string{AttributeResourceOwnerID: "bob"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = e.EnforceWithAttributes("bob", "user", "/orders/42", "cancel",
+			map[string]string{AttributeResourceOwnerID: "carol"})
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		require.NoError(t, e.RemovePolicyCondition("customer", "user", "/orders/*", "cancel", EffectAllow))
+		allowed, err = e.EnforceWithAttributes("bob", "user", "/orders/42", "cancel",
+			map[string]string{AttributeResourceOwnerID: "carol"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Rejects invalid conditions", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+
+		_, err := e.SetPolicyCondition("admin", "api", "/api/v1/users/*", "GET", EffectAllow, "hour >=")
+		assert.Error(t, err)
+		_, err = e.SetPolicyCondition("admin", "api", "/api/v1/reports/*", "GET", EffectAllow, "hour >= 8")
+		assert.Error(t, err)
+		assert.Error(t, e.RemovePolicyCondition("admin", "api", "/api/v1/users/*", "GET", EffectAllow))
+	})
+
+	t.Run("Deny without attributes still applies", func(t *testing.T) {
+		e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicyWithEffect("admin", "api", "/api/v1/users/*", "DELETE", EffectAllow))
+		require.NoError(t, e.AddPolicyWithEffect("admin", "api", "/api/v1/users/*", "DELETE", EffectDeny))
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+		_, err := e.SetPolicyCondition("admin", "api", "/api/v1/users/*", "DELETE", EffectDeny,
+			`!ipInRange(client_ip, "10.0.0.0/8")`)
+		require.NoError(t, err)
+
+		allowed, err := e.EnforceWithAttributes("alice", "api", "/api/v1/users/1", "DELETE",
+			map[string]string{AttributeClientIP: "10.1.2.3"})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = e.EnforceWithAttributes("alice", "api", "/api/v1/users/1", "DELETE",
+			map[string]string{AttributeClientIP: "8.8.8.8"})
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		// The condition belongs to the deny policy only, and a missing attribute cannot lift it
+		allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "DELETE")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Deny with a broken stored expression still applies", func(t *testing.T) {
+		e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicyWithEffect("admin", "api", "/api/v1/users/*", "DELETE", EffectAllow))
+		require.NoError(t, e.AddPolicyWithEffect("admin", "api", "/api/v1/users/*", "DELETE", EffectDeny))
+		require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+
+		// As loadConditions keeps a stored expression that no longer compiles
+		condition := &PolicyCondition{Subject: "admin", Domain: "api", Object: "/api/v1/users/*", Action: "DELETE",
+			Effect: EffectDeny, Expression: "client_ip =="}
+		key := conditionKey{subject: "admin", domain: "api", object: "/api/v1/users/*", action: "DELETE", effect: EffectDeny}
+		e.conditions[key] = &compiledCondition{condition: condition}
+
+		allowed, err := e.EnforceWithAttributes("alice", "api", "/api/v1/users/1", "DELETE",
+			map[string]string{AttributeClientIP: "10.1.2.3"})
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func TestEnforcerRoleHierarchy(t *testing.T) {
+	t.Run("Role inherits policies of its parents", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddPolicy("cms_product_manager", "cms", "/cms/product/*", "edit"))
+		require.NoError(t, e.AddPolicy("cms_order_manager", "cms", "/cms/order/*", "edit"))
+		require.NoError(t, e.AddRoleForUser("alice", "cms_admin", "cms"))
+
+		allowed, err := e.Enforce("alice", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		require.NoError(t, e.AddRoleInheritance("cms_admin", "cms_product_manager", "cms"))
+		require.NoError(t, e.AddRoleInheritance("cms_admin", "cms_order_manager", "cms"))
+
+		// The cached denial is dropped for users of the child role
+		allowed, err = e.Enforce("alice", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		ancestors, err := e.GetRoleAncestors("cms_admin", "cms")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cms_order_manager", "cms_product_manager"}, ancestors)
+
+		descendants, err := e.GetRoleDescendants("cms_order_manager", "cms")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "cms_admin"}, descendants)
+
+		permissions, err := e.GetImplicitPermissionsForUser("cms_admin", "cms")
+		require.NoError(t, err)
+		assert.Len(t, permissions, 2)
+
+		require.NoError(t, e.RemoveRoleInheritance("cms_admin", "cms_order_manager", "cms"))
+		allowed, err = e.Enforce("alice", "cms", "/cms/order/*", "edit")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Error(t, e.RemoveRoleInheritance("cms_admin", "cms_order_manager", "cms"))
+	})
+
+	t.Run("Rejects cycles", func(t *testing.T) {
+		e := newTestEnforcer(t, DefaultDecisionCacheSize)
+		require.NoError(t, e.AddRoleInheritance("admin", "editor", "api"))
+		require.NoError(t, e.AddRoleInheritance("editor", "viewer", "api"))
+
+		assert.Error(t, e.AddRoleInheritance("viewer", "admin", "api"))
+		assert.Error(t, e.AddRoleInheritance("editor", "admin", "api"))
+		assert.Error(t, e.AddRoleInheritance("admin", "admin", "api"))
+
+		// The same roles may be linked the other way round in another domain
+		assert.NoError(t, e.AddRoleInheritance("viewer", "admin", "user"))
+	})
+}
+
+func TestEnforcerLinkWindows(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+	require.NoError(t, e.AddPolicy("viewer", "api", "/api/v1/reports/*", "GET"))
+	require.NoError(t, e.AddRoleInheritance("admin", "viewer", "api"))
+	require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+	require.NoError(t, e.AddRoleForUser("bob", "admin", "api"))
+	require.NoError(t, e.AddRoleForUser("carol", "admin", "api"))
+
+	// A cached decision must not outlive the window
+	allowed, err := e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	now := time.Now()
+	e.setLinkWindows(map[linkKey]LinkWindow{
+		{member: "alice", role: "admin", domain: "api"}: {ExpiresAt: now.Add(-time.Minute)},
+		{member: "bob", role: "admin", domain: "api"}:   {StartsAt: now.Add(time.Hour)},
+		{member: "carol", role: "admin", domain: "api"}: {StartsAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+	})
+	e.invalidateAll()
+
+	for _, tc := range []struct {
+		user    string
+		allowed bool
+	}{
+		{"alice", false},
+		{"bob", false},
+		{"carol", true},
+	} {
+		allowed, err := e.Enforce(tc.user, "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.Equal(t, tc.allowed, allowed, tc.user)
+
+		// Inherited policies follow the same window
+		allowed, err = e.Enforce(tc.user, "api", "/api/v1/reports/1", "GET")
+		require.NoError(t, err)
+		assert.Equal(t, tc.allowed, allowed, tc.user)
+	}
+
+	roles, err := e.GetRolesForUser("alice", "api")
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+
+	users, err := e.GetUsersForRole("admin", "api")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"carol"}, users)
+
+	holders := e.AccessHolders("api", "/api/v1/reports/1", "GET")
+	subjects := make([]string, 0, len(holders))
+	for _, holder := range holders {
+		subjects = append(subjects, holder.Subject)
+	}
+	assert.Equal(t, []string{"admin", "carol", "viewer"}, subjects)
+
+	// A link stops granting once its window closes, without reloading
+	require.NoError(t, e.AddRoleForUser("dave", "admin", "api"))
+	e.setLinkWindows(map[linkKey]LinkWindow{
+		{member: "dave", role: "admin", domain: "api"}: {ExpiresAt: time.Now().Add(50 * time.Millisecond)},
+	})
+	allowed, err = e.Enforce("dave", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	time.Sleep(60 * time.Millisecond)
+	allowed, err = e.Enforce("dave", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestEnforcerEnforceWithRoles(t *testing.T) {
+	e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("cms_viewer", "cms", "/cms/*", "view"))
+	require.NoError(t, e.AddPolicy("inventory_staff", "cms", "/cms/inventory/*", "(view|edit)"))
+	require.NoError(t, e.AddPolicy("warehouse_manager", "cms", "/cms/inventory/*", "adjust"))
+	require.NoError(t, e.AddRoleInheritance("warehouse_manager", "inventory_staff", "cms"))
+	require.NoError(t, e.AddRoleForUser("erin", "cms_viewer", "cms"))
+
+	// Without the extra roles only the user's links count
+	allowed, err := e.EnforceWithRoles("erin", nil, "cms", "/cms/inventory/*", "adjust", nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// Extra roles grant their own and their parents' policies
+	allowed, err = e.EnforceWithRoles("erin", []string{"warehouse_manager"}, "cms", "/cms/inventory/*", "adjust", nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, err = e.EnforceWithRoles("erin", []string{"warehouse_manager"}, "cms", "/cms/inventory/*", "edit", nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// The user's links still count next to the extra roles
+	allowed, err = e.EnforceWithRoles("erin", []string{"warehouse_manager"}, "cms", "/cms/order/*", "view", nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// A deny matched through the user's links overrides an allow from an extra role
+	require.NoError(t, e.AddPolicyWithEffect("cms_viewer", "cms", "/cms/inventory/*", "adjust", EffectDeny))
+	allowed, err = e.EnforceWithRoles("erin", []string{"warehouse_manager"}, "cms", "/cms/inventory/*", "adjust", nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestEnforcerEnforceWithoutRoles(t *testing.T) {
+	e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("refund_requester", "user", "/permissions/refund", "^request$"))
+	require.NoError(t, e.AddPolicy("refund_approver", "user", "/permissions/refund", "^approve$"))
+	require.NoError(t, e.AddPolicy("finance_viewer", "user", "/permissions/report", "^read$"))
+	require.NoError(t, e.AddRoleInheritance("refund_approver", "finance_viewer", "user"))
+	require.NoError(t, e.AddPolicy("frank", "user", "/permissions/profile", "^edit$"))
+	require.NoError(t, e.AddRoleForUser("frank", "refund_requester", "user"))
+	require.NoError(t, e.AddRoleForUser("frank", "refund_approver", "user"))
+
+	// A session that leaves the approver role inactive cannot approve, or read what it inherits
+	allowed, err := e.EnforceWithoutRoles("frank", []string{"refund_approver"}, "user", "/permissions/refund", "approve", nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	allowed, err = e.EnforceWithoutRoles("frank", []string{"refund_approver"}, "user", "/permissions/report", "read", nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// The active roles and the user's own policies still count
+	allowed, err = e.EnforceWithoutRoles("frank", []string{"refund_approver"}, "user", "/permissions/refund", "request", nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, err = e.EnforceWithoutRoles("frank", []string{"refund_approver"}, "user", "/permissions/profile", "edit", nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// So do deny policies of the active roles
+	require.NoError(t, e.AddPolicyWithEffect("refund_requester", "user", "/permissions/profile", "^edit$", EffectDeny))
+	allowed, err = e.EnforceWithoutRoles("frank", []string{"refund_approver"}, "user", "/permissions/profile", "edit", nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestEnforcerFilteredPolicies(t *testing.T) {
+	e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/orders/*", "GET"))
+	require.NoError(t, e.AddPolicyWithEffect("support", "api", "/api/v1/orders/*", "DELETE", EffectDeny))
+
+	assert.Equal(t, [][]string{
+		{"admin", "api", "/api/v1/orders/*", "GET", EffectAllow},
+		{"admin", "api", "/api/v1/users/*", "GET", EffectAllow},
+	}, e.GetFilteredPolicies("admin", "", "", "", ""))
+	assert.Len(t, e.GetFilteredPolicies("", "api", "/api/v1/orders/*", "", ""), 2)
+	assert.Len(t, e.GetFilteredPolicies("", "", "", "", EffectDeny), 1)
+	assert.Empty(t, e.GetFilteredPolicies("", "cms", "", "", ""))
+
+	allowOnly := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, allowOnly.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+	assert.Len(t, allowOnly.GetFilteredPolicies("", "", "", "", EffectAllow), 1)
+	assert.Empty(t, allowOnly.GetFilteredPolicies("", "", "", "", EffectDeny))
+}
+
+func TestEnforcerExportRules(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+	require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+
+	policies, links := e.ExportRules()
+	assert.Equal(t, [][]string{{"admin", "api", "/api/v1/users/*", "GET", EffectAllow}}, policies)
+	assert.Equal(t, [][]string{{"alice", "admin", "api"}}, links)
+
+	err := e.ApplyRuleChanges(context.Background(), &RuleChanges{AddLinks: [][]string{{"bob", "admin", "api"}}}, nil)
+	assert.Error(t, err, "applying changes needs the database")
+}
+
+func TestEnforcerSimulate(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+	require.NoError(t, e.AddRoleForUser("alice", "admin", "api"))
+
+	sandbox, err := e.Simulate(&RuleChanges{
+		RemoveLinks: [][]string{{"alice", "admin", "api"}},
+		AddLinks:    [][]string{{"bob", "admin", "api"}},
+		AddPolicies: [][]string{{"admin", "api", "/api/v1/orders/*", "GET", EffectAllow}},
+	})
+	require.NoError(t, err)
+
+	allowed, err := sandbox.Enforce("alice", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	allowed, err = sandbox.Enforce("bob", "api", "/api/v1/orders/1", "GET")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// The live enforcer is unchanged
+	allowed, err = e.Enforce("alice", "api", "/api/v1/users/1", "GET")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, err = e.Enforce("bob", "api", "/api/v1/orders/1", "GET")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestValidatePolicyPatterns(t *testing.T) {
+	for _, pattern := range []string{"/api/v1/users", "/api/v1/users/*", "/api/v1/users/:id/orders", "/cms/order/*", "/*", "/v1.0/files/~user"} {
+		assert.NoError(t, ValidateObjectPattern(pattern), pattern)
+	}
+	for _, pattern := range []string{"", "api/v1/users", "/api/v1/**", "/api/v1/users*", "/api/:", "/api/(users|orders)", "/api/v1 /users", "/api/a:b"} {
+		assert.Error(t, ValidateObjectPattern(pattern), pattern)
+	}
+
+	for _, pattern := range []string{"GET", "(GET|POST)", "view", ".*"} {
+		assert.NoError(t, ValidateActionPattern(pattern), pattern)
+	}
+	for _, pattern := range []string{"", " ", "(GET", "*"} {
+		assert.Error(t, ValidateActionPattern(pattern), pattern)
+	}
+}
+
+func TestEnforcerConcurrentAccess(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("admin", "api", "/api/v1/users/*", "GET"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				user := fmt.Sprintf("user-%d", j%20)
+				if i == 0 {
+					assert.NoError(t, e.AddRoleForUser(user, "admin", "api"))
+					continue
+				}
+				_, err := e.Enforce(user, "api", "/api/v1/users/1", "GET")
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Every user now holds the role, so no stale denial may remain cached
+	for j := 0; j < 20; j++ {
+		allowed, err := e.Enforce(fmt.Sprintf("user-%d", j), "api", "/api/v1/users/1", "GET")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+}
+
+// loadBenchmarkPolicies adds one API policy per role and spreads users over the roles
+func loadBenchmarkPolicies(b *testing.B, e *Enforcer, rules int) {
+	b.Helper()
+
+	policies := make([][]string, 0, rules)
+	for i := 0; i < rules; i++ {
+		policies = append(policies, []string{fmt.Sprintf("role-%d", i), "api", fmt.Sprintf("/api/v1/resource-%d/*", i), "(GET|POST)"})
+	}
+	links := make([][]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		links = append(links, []string{fmt.Sprintf("user-%d", i), fmt.Sprintf("role-%d", i%rules), "api"})
+	}
+
+	_, err := e.enforcer.AddPolicies(policies)
+	require.NoError(b, err)
+	_, err = e.enforcer.AddGroupingPolicies(links)
+	require.NoError(b, err)
+}
+
+func benchmarkEnforce(b *testing.B, rules, cacheSize int) {
+	e := newTestEnforcer(b, cacheSize)
+	loadBenchmarkPolicies(b, e, rules)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			// 100 distinct users keep the working set small enough to cache
+			user := i % 100
+			if _, err := e.Enforce(fmt.Sprintf("user-%d", user), "api", fmt.Sprintf("/api/v1/resource-%d/1", user%rules), "GET"); err != nil {
+				b.Error(err)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkEnforce(b *testing.B) {
+	for _, rules := range []int{1000, 10000, 50000} {
+		b.Run(fmt.Sprintf("rules=%d/uncached", rules), func(b *testing.B) {
+			benchmarkEnforce(b, rules, 0)
+		})
+		b.Run(fmt.Sprintf("rules=%d/cached", rules), func(b *testing.B) {
+			benchmarkEnforce(b, rules, DefaultDecisionCacheSize)
+		})
+	}
+}
+
+// BenchmarkEnforceWithRoleChanges measures cached checks while roles are assigned concurrently
+func BenchmarkEnforceWithRoleChanges(b *testing.B) {
+	e := newTestEnforcer(b, DefaultDecisionCacheSize)
+	loadBenchmarkPolicies(b, e, 10000)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			user := fmt.Sprintf("user-%d", i%100)
+			if err := e.AddRoleForUser(user, "role-extra", "api"); err != nil {
+				b.Error(err)
+			}
+			if err := e.DeleteRoleForUser(user, "role-extra", "api"); err != nil {
+				b.Error(err)
+			}
+		}
+	}()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			user := i % 100
+			if _, err := e.Enforce(fmt.Sprintf("user-%d", user), "api", fmt.Sprintf("/api/v1/resource-%d/1", user), "GET"); err != nil {
+				b.Error(err)
+			}
+			i++
+		}
+	})
+	b.StopTimer()
+
+	close(stop)
+	<-done
+}
+
+func TestEnforcerRoleReferences(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("editor", "api", "/api/v1/posts/*", "GET"))
+	require.NoError(t, e.AddPolicy("editor", "cms", "tab:posts", "view"))
+	require.NoError(t, e.AddRoleForUser("alice", "editor", "api"))
+	require.NoError(t, e.AddRoleForUser("editor", "viewer", "api"))
+	require.NoError(t, e.AddRoleForUser("bob", "viewer", "api"))
+
+	policies, links := e.RoleReferences("editor", "api")
+	assert.Equal(t, [][]string{{"editor", "api", "/api/v1/posts/*", "GET", EffectAllow}}, policies)
+	assert.ElementsMatch(t, [][]string{{"editor", "viewer", "api"}, {"alice", "editor", "api"}}, links)
+
+	err := e.RenameRole(context.Background(), "editor", "author", []string{"api"}, nil)
+	assert.Error(t, err, "renaming a role needs the database")
+	err = e.DeleteRole(context.Background(), "editor", []string{"api"}, nil)
+	assert.Error(t, err, "deleting a role needs the database")
+}
+
+func TestEnforcerGrants(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("alice", "api", "/api/v1/profile", "GET"))
+	require.NoError(t, e.AddPolicy("editor", "api", "/api/v1/posts/*", "(GET|POST)"))
+	require.NoError(t, e.AddPolicy("viewer", "api", "/api/v1/posts/*", "GET"))
+	require.NoError(t, e.AddPolicy("viewer", "cms", "/cms/posts/*", "view"))
+	require.NoError(t, e.AddRoleForUser("alice", "editor", "api"))
+	require.NoError(t, e.AddRoleInheritance("editor", "viewer", "api"))
+
+	grants := e.Grants("alice", "api")
+	require.Len(t, grants, 3)
+	assert.Equal(t, Grant{Policy: []string{"alice", "api", "/api/v1/profile", "GET", EffectAllow}, RoleChain: []string{"alice"}}, grants[0])
+	assert.Equal(t, []string{"alice", "editor"}, grants[1].RoleChain)
+	assert.Equal(t, []string{"alice", "editor", "viewer"}, grants[2].RoleChain)
+	assert.Equal(t, "viewer", grants[2].Policy[0])
+
+	assert.Empty(t, e.Grants("alice", "cms"), "roles are linked per domain")
+}
+
+func TestEnforcerAccessHolders(t *testing.T) {
+	e := newTestEnforcer(t, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("product_admin", "api", "/api/v1/products/*", "(GET|DELETE)"))
+	require.NoError(t, e.AddPolicy("product_viewer", "api", "/api/v1/products/:id", "GET"))
+	require.NoError(t, e.AddRoleInheritance("catalog_lead", "product_admin", "api"))
+	require.NoError(t, e.AddRoleForUser("alice", "catalog_lead", "api"))
+	require.NoError(t, e.AddRoleForUser("bob", "product_viewer", "api"))
+	require.NoError(t, e.AddRoleForUser("carol", "product_admin", "cms"))
+
+	holders := e.AccessHolders("api", "/api/v1/products/42", "DELETE")
+	require.Len(t, holders, 3)
+	assert.Equal(t, "alice", holders[0].Subject)
+	assert.Equal(t, []string{"alice", "catalog_lead", "product_admin"}, holders[0].RoleChain)
+	assert.Equal(t, "catalog_lead", holders[1].Subject)
+	assert.Equal(t, "product_admin", holders[2].Subject)
+	assert.Equal(t, []string{"product_admin"}, holders[2].RoleChain)
+
+	subjects := func(holders []AccessHolder) []string {
+		var result []string
+		for _, holder := range holders {
+			result = append(result, holder.Subject)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"alice", "bob", "catalog_lead", "product_admin", "product_viewer"},
+		subjects(e.AccessHolders("api", "/api/v1/products/42", "GET")))
+	assert.Empty(t, e.AccessHolders("api", "/api/v1/orders/1", "GET"))
+}
+
+func TestEnforcerAccessHoldersDeny(t *testing.T) {
+	e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("cms_support", "cms", "/cms/*", "(view|edit)"))
+	require.NoError(t, e.AddPolicyWithEffect("cms_intern", "cms", "/cms/refunds/*", "edit", EffectDeny))
+	require.NoError(t, e.AddRoleForUser("dave", "cms_support", "cms"))
+	require.NoError(t, e.AddRoleForUser("erin", "cms_support", "cms"))
+	require.NoError(t, e.AddRoleForUser("erin", "cms_intern", "cms"))
+
+	var subjects []string
+	for _, holder := range e.AccessHolders("cms", "/cms/refunds/*", "edit") {
+		subjects = append(subjects, holder.Subject)
+	}
+	assert.Equal(t, []string{"cms_support", "dave"}, subjects)
+}
+
+func TestEnforcerAccessHoldersThroughRoles(t *testing.T) {
+	e := newTestEnforcerWithModel(t, testDenyModelPath, DefaultDecisionCacheSize)
+	require.NoError(t, e.AddPolicy("cms_support", "cms", "/cms/*", "(view|edit)"))
+	require.NoError(t, e.AddPolicyWithEffect("cms_intern", "cms", "/cms/refunds/*", "edit", EffectDeny))
+	require.NoError(t, e.AddRoleForUser("dave", "cms_support", "cms"))
+
+	// frank and gina hold cms_support outside their links; gina's cms_intern role denies the edit
+	// and dave already holds the access through his links
+	holders := e.AccessHoldersThroughRoles("cms", "/cms/refunds/*", "edit", map[string][]string{
+		"frank": {"cms_support"},
+		"gina":  {"cms_support", "cms_intern"},
+		"dave":  {"cms_support"},
+	})
+	require.Len(t, holders, 1)
+	assert.Equal(t, "frank", holders[0].Subject)
+	assert.Equal(t, []string{"frank", "cms_support"}, holders[0].RoleChain)
+}
@@ -0,0 +1,140 @@
+package casbin
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/casbin/casbin/v2/util"
+)
+
+// maxExplainCandidates limits the candidate policies reported for a denied request
+const maxExplainCandidates = 5
+
+// Fields of a policy rule that can fail to match a request
+const (
+	FieldSubject = "subject"
+	FieldObject  = "object"
+	FieldAction  = "action"
+)
+
+// Explanation describes how an enforcement decision was reached
+type Explanation struct {
+	Allowed bool
+	// MatchedPolicy is the policy rule that granted access, or the deny rule that refused it
+	MatchedPolicy []string
+	// RoleChain leads from the subject to the subject of MatchedPolicy, e.g. [alice editor cms_admin]
+	RoleChain []string
+	// Candidates are the policies of the domain closest to granting a denied request
+	Candidates []PolicyCandidate
+}
+
+// PolicyCandidate is a policy that did not grant a request and the fields that did not match
+type PolicyCandidate struct {
+	Policy []string
+	// RoleChain is set when the subject does reach the policy subject
+	RoleChain    []string
+	FailedFields []string
+}
+
+// Explain enforces a request and reports the matched policy and role chain, or on deny the
+// closest candidate policies. The result is never cached.
+func (e *Enforcer) Explain(subject, domain, object, action string, attributes map[string]string) (*Explanation, error) {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	rc := newRequestContext(subject, attributes)
+	allowed, matched, err := e.enforcer.Enforcer.EnforceEx(e.requestValues(subject, domain, object, action, rc)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enforce policy: %w", err)
+	}
+
+	chains := e.roleChains(subject, domain)
+	explanation := &Explanation{Allowed: allowed}
+	if len(matched) > 0 {
+		explanation.MatchedPolicy = matched
+		explanation.RoleChain = chains[matched[0]]
+	}
+	// Nothing closer can be suggested when access was granted or explicitly denied
+	if allowed || len(matched) > 0 {
+		return explanation, nil
+	}
+
+	explanation.Candidates = e.closestCandidates(chains, rc, domain, object, action)
+	return explanation, nil
+}
+
+// closestCandidates checks every policy of the domain field by field.
+// The checks mirror the matcher in configs/rbac_model.conf.
+func (e *Enforcer) closestCandidates(chains map[string][]string, rc *requestContext, domain, object, action string) []PolicyCandidate {
+	var candidates []PolicyCandidate
+	for _, rule := range e.enforcer.Enforcer.GetFilteredPolicy(1, domain) {
+		// Deny policies can never grant access
+		if len(rule) < 4 || (len(rule) > 4 && rule[4] == EffectDeny) {
+			continue
+		}
+
+		candidate := PolicyCandidate{Policy: rule}
+		if chain, ok := chains[rule[0]]; ok {
+			candidate.RoleChain = chain
+		} else {
+			candidate.FailedFields = append(candidate.FailedFields, FieldSubject)
+		}
+		if !util.KeyMatch2(object, rule[2]) {
+			candidate.FailedFields = append(candidate.FailedFields, FieldObject)
+		}
+		if matched, err := regexp.MatchString(rule[3], action); err != nil || !matched {
+			candidate.FailedFields = append(candidate.FailedFields, FieldAction)
+		}
+		if e.hasContext {
+			if condition := e.ruleCondition(rule); condition != nil && !rc.evaluate(condition) {
+				candidate.FailedFields = append(candidate.FailedFields, FieldCondition)
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	// Fewest failed fields first; policies the subject already reaches win ties
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if len(candidates[i].FailedFields) != len(candidates[j].FailedFields) {
+			return len(candidates[i].FailedFields) < len(candidates[j].FailedFields)
+		}
+		return candidates[i].RoleChain != nil && candidates[j].RoleChain == nil
+	})
+	if len(candidates) > maxExplainCandidates {
+		candidates = candidates[:maxExplainCandidates]
+	}
+	return candidates
+}
+
+// roleChains returns, for the subject and every role it inherits in the domain, the shortest
+// chain of role links leading to it. The caller must hold the enforcer lock.
+func (e *Enforcer) roleChains(subject, domain string) map[string][]string {
+	chains := map[string][]string{subject: {subject}}
+	rm := e.enforcer.Enforcer.GetRoleManager()
+	if rm == nil {
+		return chains
+	}
+
+	queue := []string{subject}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		roles, err := rm.GetRoles(current, domain)
+		if err != nil {
+			continue
+		}
+		for _, role := range roles {
+			if _, seen := chains[role]; seen {
+				continue
+			}
+			chain := make([]string, len(chains[current]), len(chains[current])+1)
+			copy(chain, chains[current])
+			chains[role] = append(chain, role)
+			queue = append(queue, role)
+		}
+	}
+	return chains
+}
@@ -0,0 +1,164 @@
+package casbin
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/casbin/casbin/v2/util"
+)
+
+// AccessHolder is a subject, a user or a role, that the policies allow a request
+type AccessHolder struct {
+	Subject string
+	// Policy is the allow rule granting the request
+	Policy []string
+	// RoleChain leads from Subject to the subject of Policy, e.g. [alice editor cms_admin]
+	RoleChain []string
+	// Condition is the expression Policy is restricted by, if any
+	Condition string
+}
+
+// AccessHolders returns every subject allowed object and action in a domain: the subjects of the
+// matching allow policies and, transitively, every member of those roles. Objects and actions are
+// matched like the model's matcher. Subjects an unconditional deny policy reaches are left out.
+// A subject granted several ways is reported once, preferring unconditional grants and then the
+// shortest role chain; results are sorted by subject.
+func (e *Enforcer) AccessHolders(domain, object, action string) []AccessHolder {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	holders, denied := e.collectAccessHolders(domain, object, action)
+	result := make([]AccessHolder, 0, len(holders))
+	for subject, holder := range holders {
+		if !denied[subject] {
+			result = append(result, holder)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Subject < result[j].Subject })
+	return result
+}
+
+// AccessHoldersThroughRoles returns the subjects allowed object and action in a domain only
+// through roles they hold outside their role links, such as CMS roles assigned within a resource
+// scope; roles maps each subject to those roles. Like EnforceWithRoles, a subject is left out when
+// an unconditional deny policy reaches it or any of the roles. Subjects AccessHolders already
+// reports are left out too. Results are sorted by subject.
+func (e *Enforcer) AccessHoldersThroughRoles(domain, object, action string, roles map[string][]string) []AccessHolder {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	holders, denied := e.collectAccessHolders(domain, object, action)
+	var result []AccessHolder
+	for subject, subjectRoles := range roles {
+		if denied[subject] {
+			continue
+		}
+		if _, ok := holders[subject]; ok {
+			continue
+		}
+
+		var best *AccessHolder
+		for _, role := range subjectRoles {
+			if denied[role] {
+				best = nil
+				break
+			}
+			via, ok := holders[role]
+			if !ok {
+				continue
+			}
+			holder := AccessHolder{
+				Subject:   subject,
+				Policy:    via.Policy,
+				RoleChain: append([]string{subject}, via.RoleChain...),
+				Condition: via.Condition,
+			}
+			if best == nil || betterHolder(holder, *best) {
+				best = &holder
+			}
+		}
+		if best != nil {
+			result = append(result, *best)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Subject < result[j].Subject })
+	return result
+}
+
+// collectAccessHolders returns the best grant of every subject allowed object and action, and
+// the subjects an unconditional deny policy reaches. The caller must hold the enforcer lock.
+func (e *Enforcer) collectAccessHolders(domain, object, action string) (map[string]AccessHolder, map[string]bool) {
+	holders := make(map[string]AccessHolder)
+	denied := make(map[string]bool)
+	for _, rule := range e.enforcer.Enforcer.GetFilteredPolicy(1, domain) {
+		if len(rule) < 4 || !util.KeyMatch2(object, rule[2]) {
+			continue
+		}
+		if matched, err := regexp.MatchString(rule[3], action); err != nil || !matched {
+			continue
+		}
+
+		var condition string
+		if c := e.ruleCondition(rule); c != nil {
+			condition = c.condition.Expression
+		}
+		if len(rule) > 4 && rule[4] == EffectDeny {
+			// Conditional denies only apply to some requests; the holder may still be allowed
+			if condition == "" {
+				for member := range e.memberChains(rule[0], domain) {
+					denied[member] = true
+				}
+			}
+			continue
+		}
+
+		for member, chain := range e.memberChains(rule[0], domain) {
+			holder := AccessHolder{Subject: member, Policy: rule, RoleChain: chain, Condition: condition}
+			if current, ok := holders[member]; ok && !betterHolder(holder, current) {
+				continue
+			}
+			holders[member] = holder
+		}
+	}
+	return holders, denied
+}
+
+// betterHolder reports whether a grant is preferred over the current one
+func betterHolder(candidate, current AccessHolder) bool {
+	if (candidate.Condition == "") != (current.Condition == "") {
+		return candidate.Condition == ""
+	}
+	return len(candidate.RoleChain) < len(current.RoleChain)
+}
+
+// memberChains returns, for the role and every subject inheriting it in the domain, the shortest
+// chain of role links from that subject to the role. The caller must hold the enforcer lock.
+func (e *Enforcer) memberChains(role, domain string) map[string][]string {
+	chains := map[string][]string{role: {role}}
+	rm := e.enforcer.Enforcer.GetRoleManager()
+	if rm == nil {
+		return chains
+	}
+
+	queue := []string{role}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		members, err := rm.GetUsers(current, domain)
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			if _, seen := chains[member]; seen {
+				continue
+			}
+			chain := make([]string, 0, len(chains[current])+1)
+			chains[member] = append(append(chain, member), chains[current]...)
+			queue = append(queue, member)
+		}
+	}
+	return chains
+}
@@ -0,0 +1,45 @@
+package casbin
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// objectParamPattern is a keyMatch2 path parameter segment such as :id
+var objectParamPattern = regexp.MustCompile(`^:[A-Za-z_][A-Za-z0-9_]*$`)
+
+// objectSegmentPattern is a literal path segment; keyMatch2 treats other characters as regex syntax
+var objectSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9._~-]*$`)
+
+// ValidateObjectPattern checks that a policy object is a keyMatch2 path pattern, e.g.
+// /api/v1/users/:id or /api/v1/products/*. Invalid patterns would make the matcher fail.
+func ValidateObjectPattern(pattern string) error {
+	if !strings.HasPrefix(pattern, "/") {
+		return fmt.Errorf("invalid resource pattern %q: must start with /", pattern)
+	}
+
+	for _, segment := range strings.Split(pattern[1:], "/") {
+		switch {
+		case segment == "*":
+		case strings.HasPrefix(segment, ":"):
+			if !objectParamPattern.MatchString(segment) {
+				return fmt.Errorf("invalid resource pattern %q: bad parameter %q", pattern, segment)
+			}
+		case !objectSegmentPattern.MatchString(segment):
+			return fmt.Errorf("invalid resource pattern %q: segment %q may only use letters, digits and . _ ~ -, or be * or :param", pattern, segment)
+		}
+	}
+	return nil
+}
+
+// ValidateActionPattern checks that a policy action is a valid regular expression, e.g. (GET|POST)
+func ValidateActionPattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("action is required")
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid action pattern %q: %w", pattern, err)
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package casbin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ruleColumns is the number of value columns (v0..v5) of casbin_rule
+const ruleColumns = 6
+
+// RuleChanges is a set of policy and role link changes applied together.
+// Policies are (sub, dom, obj, act, eft) and role links (member, role, dom).
+type RuleChanges struct {
+	AddPolicies    [][]string
+	RemovePolicies [][]string
+	AddLinks       [][]string
+	RemoveLinks    [][]string
+}
+
+// ExportRules returns every policy as (sub, dom, obj, act, eft) and every role link as (member, role, dom).
+// Policies of allow-only models are reported with the allow effect.
+func (e *Enforcer) ExportRules() (policies, links [][]string) {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for _, rule := range e.enforcer.Enforcer.GetPolicy() {
+		if len(rule) < 4 {
+			continue
+		}
+		effect := EffectAllow
+		if e.hasEffect && len(rule) > 4 && rule[4] != "" {
+			effect = rule[4]
+		}
+		policies = append(policies, []string{rule[0], rule[1], rule[2], rule[3], effect})
+	}
+	for _, rule := range e.enforcer.Enforcer.GetGroupingPolicy() {
+		if len(rule) < 3 {
+			continue
+		}
+		links = append(links, []string{rule[0], rule[1], rule[2]})
+	}
+	return policies, links
+}
+
+// ApplyRuleChanges stores the changes, and whatever applyExtra writes, in a single transaction.
+// The policies are then reloaded here and on the other instances. Nothing is stored when any
+// step fails.
+func (e *Enforcer) ApplyRuleChanges(ctx context.Context, changes *RuleChanges, applyExtra func(tx *sql.Tx) error) error {
+	return e.inRuleTransaction(ctx, func(tx *sql.Tx) error {
+		if err := e.WriteRuleChanges(ctx, tx, changes); err != nil {
+			return err
+		}
+		if applyExtra != nil {
+			return applyExtra(tx)
+		}
+		return nil
+	})
+}
+
+// WriteRuleChanges stores the changes within tx, typically from the applyExtra of RenameRole or
+// DeleteRole so they commit with it. The policies are reloaded once that transaction commits.
+func (e *Enforcer) WriteRuleChanges(ctx context.Context, tx *sql.Tx, changes *RuleChanges) error {
+	// Store policies in the shape the model expects
+	addPolicies, err := e.policyRules(changes.AddPolicies)
+	if err != nil {
+		return err
+	}
+	removePolicies, err := e.policyRules(changes.RemovePolicies)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range removePolicies {
+		if err := deleteRule(ctx, tx, "p", rule); err != nil {
+			return err
+		}
+	}
+	for _, rule := range changes.RemoveLinks {
+		if err := deleteRule(ctx, tx, "g", rule); err != nil {
+			return err
+		}
+	}
+	for _, rule := range addPolicies {
+		if err := insertRule(ctx, tx, "p", rule); err != nil {
+			return err
+		}
+	}
+	for _, rule := range changes.AddLinks {
+		if err := insertRule(ctx, tx, "g", rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RoleReferences returns the policies granted to a role, as (sub, dom, obj, act, eft), and the
+// role links it takes part in as member or role, as (member, role, dom), within the domains
+func (e *Enforcer) RoleReferences(role string, domains ...string) (policies, links [][]string) {
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for _, domain := range domains {
+		for _, rule := range e.enforcer.Enforcer.GetFilteredPolicy(0, role, domain) {
+			effect := EffectAllow
+			if e.hasEffect && len(rule) > 4 && rule[4] != "" {
+				effect = rule[4]
+			}
+			policies = append(policies, []string{rule[0], rule[1], rule[2], rule[3], effect})
+		}
+		for _, rule := range e.enforcer.Enforcer.GetFilteredGroupingPolicy(0, role, "", domain) {
+			links = append(links, []string{rule[0], rule[1], rule[2]})
+		}
+		for _, rule := range e.enforcer.Enforcer.GetFilteredGroupingPolicy(1, role, domain) {
+			links = append(links, []string{rule[0], rule[1], rule[2]})
+		}
+	}
+	return policies, links
+}
+
+// RenameRole rewrites every policy, role link and policy condition of a role in the domains to
+// the new name, in the same transaction as applyExtra, which typically renames the role itself
+func (e *Enforcer) RenameRole(ctx context.Context, oldName, newName string, domains []string, applyExtra func(tx *sql.Tx) error) error {
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("role names are required")
+	}
+
+	return e.inRuleTransaction(ctx, func(tx *sql.Tx) error {
+		statements := []string{
+			`UPDATE casbin_rule SET v0 = $2 WHERE ptype = 'p' AND v0 = $1 AND v1 = $3`,
+			`UPDATE casbin_rule SET v0 = $2 WHERE ptype = 'g' AND v0 = $1 AND v2 = $3`,
+			`UPDATE casbin_rule SET v1 = $2 WHERE ptype = 'g' AND v1 = $1 AND v2 = $3`,
+			`UPDATE casbin_policy_conditions SET subject = $2, updated_at = NOW() WHERE subject = $1 AND domain = $3`,
+		}
+		for _, domain := range domains {
+			for _, query := range statements {
+				if _, err := tx.ExecContext(ctx, query, oldName, newName, domain); err != nil {
+					return fmt.Errorf("failed to rename role %s to %s in domain %s: %w", oldName, newName, domain, err)
+				}
+			}
+		}
+		if applyExtra != nil {
+			return applyExtra(tx)
+		}
+		return nil
+	})
+}
+
+// DeleteRole removes every policy, role link and policy condition of a role in the domains, in
+// the same transaction as applyExtra, which typically deletes the role itself
+func (e *Enforcer) DeleteRole(ctx context.Context, role string, domains []string, applyExtra func(tx *sql.Tx) error) error {
+	if role == "" {
+		return fmt.Errorf("role name is required")
+	}
+
+	return e.inRuleTransaction(ctx, func(tx *sql.Tx) error {
+		statements := []string{
+			`DELETE FROM casbin_rule WHERE ptype = 'p' AND v0 = $1 AND v1 = $2`,
+			`DELETE FROM casbin_rule WHERE ptype = 'g' AND (v0 = $1 OR v1 = $1) AND v2 = $2`,
+			`DELETE FROM casbin_policy_conditions WHERE subject = $1 AND domain = $2`,
+		}
+		for _, domain := range domains {
+			for _, query := range statements {
+				if _, err := tx.ExecContext(ctx, query, role, domain); err != nil {
+					return fmt.Errorf("failed to delete role %s in domain %s: %w", role, domain, err)
+				}
+			}
+		}
+		if applyExtra != nil {
+			return applyExtra(tx)
+		}
+		return nil
+	})
+}
+
+// inRuleTransaction runs fn in a transaction, then reloads the policies here and on the other
+// instances. Nothing is stored when fn fails.
+func (e *Enforcer) inRuleTransaction(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if e.db == nil {
+		return fmt.Errorf("changing stored rules requires a database")
+	}
+
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			e.logger.Warn("Failed to roll back rule changes", zap.Error(err))
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit rule changes: %w", err)
+	}
+
+	if err := e.LoadPolicy(); err != nil {
+		return fmt.Errorf("rule changes were stored but reloading policies failed: %w", err)
+	}
+	if e.watcher != nil {
+		if err := e.watcher.Update(); err != nil {
+			e.logger.Warn("Failed to publish policy reload", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// policyRules converts (sub, dom, obj, act, eft) policies to the model's policy shape
+func (e *Enforcer) policyRules(policies [][]string) ([][]string, error) {
+	rules := make([][]string, 0, len(policies))
+	for _, policy := range policies {
+		if len(policy) != 5 {
+			return nil, fmt.Errorf("invalid policy %v: expected subject, domain, object, action and effect", policy)
+		}
+		rule, err := e.policyRule(policy[0], policy[1], policy[2], policy[3], policy[4])
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ruleValues pads a rule to the value columns of casbin_rule; unused columns hold empty strings
+func ruleValues(ptype string, rule []string) ([]interface{}, error) {
+	if len(rule) > ruleColumns {
+		return nil, fmt.Errorf("invalid rule %v: too many values", rule)
+	}
+	values := make([]interface{}, ruleColumns+1)
+	values[0] = ptype
+	for i := 0; i < ruleColumns; i++ {
+		values[i+1] = ""
+		if i < len(rule) {
+			values[i+1] = rule[i]
+		}
+	}
+	return values, nil
+}
+
+func insertRule(ctx context.Context, tx *sql.Tx, ptype string, rule []string) error {
+	values, err := ruleValues(ptype, rule)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+		return fmt.Errorf("failed to insert %s rule %v: %w", ptype, rule, err)
+	}
+	return nil
+}
+
+func deleteRule(ctx context.Context, tx *sql.Tx, ptype string, rule []string) error {
+	values, err := ruleValues(ptype, rule)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM casbin_rule
+		WHERE ptype = $1
+		  AND COALESCE(v0, '') = $2 AND COALESCE(v1, '') = $3 AND COALESCE(v2, '') = $4
+		  AND COALESCE(v3, '') = $5 AND COALESCE(v4, '') = $6 AND COALESCE(v5, '') = $7
+	`
+	if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+		return fmt.Errorf("failed to delete %s rule %v: %w", ptype, rule, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package casbin
+
+import (
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+)
+
+// Simulate returns an in-memory copy of the enforcer with the changes applied, for what-if checks.
+// The copy has no database, watcher or decision cache: nothing it does is stored or published.
+// Removing a rule that does not exist and adding one that already exists are no-ops.
+func (e *Enforcer) Simulate(changes *RuleChanges) (*Enforcer, error) {
+	addPolicies, err := e.policyRules(changes.AddPolicies)
+	if err != nil {
+		return nil, err
+	}
+	removePolicies, err := e.policyRules(changes.RemovePolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := e.enforcer.GetLock()
+	lock.RLock()
+	m, err := model.NewModelFromString(e.enforcer.GetModel().ToText())
+	policies := e.enforcer.Enforcer.GetPolicy()
+	links := e.enforcer.Enforcer.GetGroupingPolicy()
+	lock.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy Casbin model: %w", err)
+	}
+
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create simulation enforcer: %w", err)
+	}
+	sandbox := newEnforcer(enforcer, 0, e.logger)
+
+	e.conditionsMu.RLock()
+	for key, condition := range e.conditions {
+		sandbox.conditions[key] = condition
+	}
+	e.conditionsMu.RUnlock()
+
+	e.windowsMu.RLock()
+	windows := make(map[linkKey]LinkWindow, len(e.linkWindows))
+	for key, window := range e.linkWindows {
+		windows[key] = window
+	}
+	e.windowsMu.RUnlock()
+	sandbox.setLinkWindows(windows)
+
+	if len(policies) > 0 {
+		if _, err := enforcer.AddPolicies(policies); err != nil {
+			return nil, fmt.Errorf("failed to copy policies: %w", err)
+		}
+	}
+	if len(links) > 0 {
+		if _, err := enforcer.AddGroupingPolicies(links); err != nil {
+			return nil, fmt.Errorf("failed to copy role links: %w", err)
+		}
+	}
+
+	for _, rule := range removePolicies {
+		if _, err := enforcer.RemovePolicy(rule); err != nil {
+			return nil, fmt.Errorf("failed to remove policy %v: %w", rule, err)
+		}
+	}
+	for _, rule := range changes.RemoveLinks {
+		if _, err := enforcer.RemoveGroupingPolicy(rule); err != nil {
+			return nil, fmt.Errorf("failed to remove role link %v: %w", rule, err)
+		}
+	}
+	for _, rule := range addPolicies {
+		if _, err := enforcer.AddPolicy(rule); err != nil {
+			return nil, fmt.Errorf("failed to add policy %v: %w", rule, err)
+		}
+	}
+	for _, rule := range changes.AddLinks {
+		if _, err := enforcer.AddGroupingPolicy(rule); err != nil {
+			return nil, fmt.Errorf("failed to add role link %v: %w", rule, err)
+		}
+	}
+	return sandbox, nil
+}
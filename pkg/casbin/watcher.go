@@ -0,0 +1,241 @@
+package casbin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// PolicyChannel is the Postgres NOTIFY channel used to broadcast policy changes
+const PolicyChannel = "casbin_policy_updates"
+
+// maxNotifyPayload stays below Postgres' 8000 byte NOTIFY limit
+const maxNotifyPayload = 7900
+
+// Policy update operations
+const (
+	opAddPolicies    = "add"
+	opRemovePolicies = "remove"
+	opRemoveFiltered = "remove_filtered"
+	opReload         = "reload"
+	// opReloadConditions asks other instances to reload policy conditions only
+	opReloadConditions = "reload_conditions"
+	// opReloadLinkWindows asks other instances to reload role link windows only
+	opReloadLinkWindows = "reload_link_windows"
+)
+
+// policyUpdate is the NOTIFY payload describing one policy change
+type policyUpdate struct {
+	Instance    string     `json:"instance"`
+	Op          string     `json:"op"`
+	Sec         string     `json:"sec,omitempty"`
+	PType       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	FieldIndex  int        `json:"field_index,omitempty"`
+	FieldValues []string   `json:"field_values,omitempty"`
+	SentAt      int64      `json:"sent_at"` // unix nanoseconds
+}
+
+// Watcher keeps the policies of several service instances in sync using Postgres LISTEN/NOTIFY.
+// It implements persist.WatcherEx so the enforcer publishes every mutation automatically.
+type Watcher struct {
+	db         *sql.DB
+	listener   *pq.Listener
+	instanceID string
+	metrics    *SyncMetrics
+	logger     *zap.Logger
+
+	mu       sync.RWMutex
+	callback func(string)
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewWatcher creates a watcher publishing on db and listening on a dedicated connection to dsn
+func NewWatcher(db *sql.DB, dsn string, logger *zap.Logger) (*Watcher, error) {
+	w := &Watcher{
+		db:         db,
+		instanceID: uuid.New().String(),
+		metrics:    NewSyncMetrics(),
+		logger:     logger,
+		done:       make(chan struct{}),
+	}
+
+	w.listener = pq.NewListener(dsn, 10*time.Second, time.Minute, w.handleListenerEvent)
+	if err := w.listener.Listen(PolicyChannel); err != nil {
+		_ = w.listener.Close()
+		return nil, fmt.Errorf("failed to listen on %s: %w", PolicyChannel, err)
+	}
+
+	go w.run()
+
+	logger.Info("Casbin policy watcher started",
+		zap.String("channel", PolicyChannel),
+		zap.String("instance_id", w.instanceID))
+
+	return w, nil
+}
+
+// Metrics returns the watcher's sync metrics
+func (w *Watcher) Metrics() *SyncMetrics {
+	return w.metrics
+}
+
+// SetUpdateCallback sets the function receiving raw update payloads from other instances
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+// Update asks other instances to reload all policies
+func (w *Watcher) Update() error {
+	return w.publish(&policyUpdate{Op: opReload})
+}
+
+// UpdateForAddPolicy publishes an added policy rule
+func (w *Watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.publish(&policyUpdate{Op: opAddPolicies, Sec: sec, PType: ptype, Rules: [][]string{params}})
+}
+
+// UpdateForRemovePolicy publishes a removed policy rule
+func (w *Watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.publish(&policyUpdate{Op: opRemovePolicies, Sec: sec, PType: ptype, Rules: [][]string{params}})
+}
+
+// UpdateForRemoveFilteredPolicy publishes a filtered policy removal
+func (w *Watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.publish(&policyUpdate{Op: opRemoveFiltered, Sec: sec, PType: ptype, FieldIndex: fieldIndex, FieldValues: fieldValues})
+}
+
+// UpdateForSavePolicy asks other instances to reload after the whole policy was saved
+func (w *Watcher) UpdateForSavePolicy(_ model.Model) error {
+	return w.Update()
+}
+
+// UpdateForAddPolicies publishes added policy rules
+func (w *Watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&policyUpdate{Op: opAddPolicies, Sec: sec, PType: ptype, Rules: rules})
+}
+
+// UpdateForRemovePolicies publishes removed policy rules
+func (w *Watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&policyUpdate{Op: opRemovePolicies, Sec: sec, PType: ptype, Rules: rules})
+}
+
+// Close stops listening for updates
+func (w *Watcher) Close() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		if err := w.listener.Close(); err != nil {
+			w.logger.Warn("Failed to close policy watcher listener", zap.Error(err))
+		}
+	})
+}
+
+// publish sends the update to other instances, falling back to a reload when it is too large
+func (w *Watcher) publish(update *policyUpdate) error {
+	update.Instance = w.instanceID
+	update.SentAt = time.Now().UnixNano()
+
+	payload, err := json.Marshal(update)
+	if err != nil {
+		w.metrics.RecordError()
+		return fmt.Errorf("failed to encode policy update: %w", err)
+	}
+	if len(payload) > maxNotifyPayload {
+		payload, err = json.Marshal(&policyUpdate{Instance: w.instanceID, Op: opReload, SentAt: update.SentAt})
+		if err != nil {
+			w.metrics.RecordError()
+			return fmt.Errorf("failed to encode policy update: %w", err)
+		}
+	}
+
+	if _, err := w.db.Exec("SELECT pg_notify($1, $2)", PolicyChannel, string(payload)); err != nil {
+		w.metrics.RecordError()
+		return fmt.Errorf("failed to publish policy update: %w", err)
+	}
+
+	w.metrics.RecordPublished()
+	return nil
+}
+
+// run dispatches notifications from other instances until the watcher is closed
+func (w *Watcher) run() {
+	ping := time.NewTicker(90 * time.Second)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case n, ok := <-w.listener.Notify:
+			if !ok {
+				return
+			}
+			// A nil notification follows a reconnect; updates may have been missed
+			if n == nil {
+				w.dispatch(&policyUpdate{Op: opReload})
+				continue
+			}
+			w.handleNotification(n.Extra)
+		case <-ping.C:
+			if err := w.listener.Ping(); err != nil {
+				w.logger.Warn("Policy watcher connection check failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (w *Watcher) handleNotification(payload string) {
+	var update policyUpdate
+	if err := json.Unmarshal([]byte(payload), &update); err != nil {
+		w.metrics.RecordError()
+		w.logger.Error("Failed to decode policy update", zap.Error(err))
+		return
+	}
+
+	// Our own mutations are already applied locally
+	if update.Instance == w.instanceID {
+		return
+	}
+
+	w.metrics.RecordReceived(time.Since(time.Unix(0, update.SentAt)))
+	w.dispatch(&update)
+}
+
+func (w *Watcher) dispatch(update *policyUpdate) {
+	w.mu.RLock()
+	callback := w.callback
+	w.mu.RUnlock()
+	if callback == nil {
+		return
+	}
+
+	payload, err := json.Marshal(update)
+	if err != nil {
+		w.metrics.RecordError()
+		return
+	}
+	callback(string(payload))
+}
+
+func (w *Watcher) handleListenerEvent(event pq.ListenerEventType, err error) {
+	switch event {
+	case pq.ListenerEventDisconnected:
+		w.logger.Warn("Policy watcher disconnected", zap.Error(err))
+	case pq.ListenerEventReconnected:
+		w.logger.Info("Policy watcher reconnected")
+	case pq.ListenerEventConnectionAttemptFailed:
+		w.logger.Warn("Policy watcher reconnect attempt failed", zap.Error(err))
+	}
+}
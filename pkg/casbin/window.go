@@ -0,0 +1,252 @@
+package casbin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/casbin/casbin/v2/rbac"
+	"go.uber.org/zap"
+)
+
+// maxWindowedHierarchyLevel bounds role chains when checking links with windows, like the
+// default role manager's hierarchy level
+const maxWindowedHierarchyLevel = 10
+
+// LinkWindow limits a role link to a period. A zero StartsAt or ExpiresAt leaves that side open.
+type LinkWindow struct {
+	StartsAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Contains reports whether the link is active at t
+func (w LinkWindow) Contains(t time.Time) bool {
+	if !w.StartsAt.IsZero() && t.Before(w.StartsAt) {
+		return false
+	}
+	if !w.ExpiresAt.IsZero() && !t.Before(w.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// linkKey identifies a role link (member, role, domain)
+type linkKey struct {
+	member string
+	role   string
+	domain string
+}
+
+// linkActive reports whether a role link is inside its window, if it has one
+func (e *Enforcer) linkActive(member, role, domain string) bool {
+	e.windowsMu.RLock()
+	defer e.windowsMu.RUnlock()
+
+	window, ok := e.linkWindows[linkKey{member: member, role: role, domain: domain}]
+	return !ok || window.Contains(time.Now())
+}
+
+// hasLinkWindows reports whether any link of the subject is time-bound. Decisions for such
+// subjects are not cached, since they change when a window opens or closes.
+func (e *Enforcer) hasLinkWindows(subject string) bool {
+	e.windowsMu.RLock()
+	defer e.windowsMu.RUnlock()
+	return e.windowedMembers[subject]
+}
+
+// setLinkWindows replaces the in-memory link windows
+func (e *Enforcer) setLinkWindows(windows map[linkKey]LinkWindow) {
+	members := make(map[string]bool, len(windows))
+	for key := range windows {
+		members[key.member] = true
+	}
+
+	e.windowsMu.Lock()
+	e.linkWindows = windows
+	e.windowedMembers = members
+	e.windowsBoundary = nextWindowBoundary(windows, time.Now())
+	e.windowsMu.Unlock()
+
+	e.resetMatcher()
+}
+
+// checkLinkWindows resets the matcher once a window opened or closed since the last check
+func (e *Enforcer) checkLinkWindows() {
+	now := time.Now()
+	e.windowsMu.RLock()
+	boundary := e.windowsBoundary
+	e.windowsMu.RUnlock()
+	if boundary.IsZero() || now.Before(boundary) {
+		return
+	}
+
+	e.windowsMu.Lock()
+	if e.windowsBoundary.IsZero() || now.Before(e.windowsBoundary) {
+		e.windowsMu.Unlock()
+		return
+	}
+	e.windowsBoundary = nextWindowBoundary(e.linkWindows, now)
+	e.windowsMu.Unlock()
+
+	e.resetMatcher()
+}
+
+// resetMatcher drops the compiled matcher. Casbin memoizes the results of g() in it, which
+// would keep reporting links whose window has since changed.
+func (e *Enforcer) resetMatcher() {
+	lock := e.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Setting the role manager again is the only way to reset the matcher from outside casbin
+	if rm := e.enforcer.Enforcer.GetRoleManager(); rm != nil {
+		e.enforcer.Enforcer.SetRoleManager(rm)
+	}
+}
+
+// nextWindowBoundary returns the earliest time after now at which a window opens or closes,
+// or the zero time if none will
+func nextWindowBoundary(windows map[linkKey]LinkWindow, now time.Time) time.Time {
+	var next time.Time
+	for _, window := range windows {
+		for _, t := range []time.Time{window.StartsAt, window.ExpiresAt} {
+			if t.After(now) && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
+// ReloadLinkWindows reloads the link windows after an assignment window changed, here and on
+// the other instances
+func (e *Enforcer) ReloadLinkWindows() error {
+	if err := e.loadLinkWindows(); err != nil {
+		return err
+	}
+	e.invalidateAll()
+
+	if e.watcher != nil {
+		if err := e.watcher.publish(&policyUpdate{Op: opReloadLinkWindows}); err != nil {
+			e.logger.Warn("Failed to publish link window change", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// loadLinkWindows replaces the in-memory link windows with the stored ones.
+// casbin_link_windows exposes the windows of the user and CMS role assignments.
+func (e *Enforcer) loadLinkWindows() error {
+	if e.db == nil {
+		return nil
+	}
+
+	rows, err := e.db.Query(`
+		SELECT member, role, domain, starts_at, expires_at
+		FROM casbin_link_windows
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to load link windows: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e.logger.Warn("Failed to close link window rows", zap.Error(err))
+		}
+	}()
+
+	windows := make(map[linkKey]LinkWindow)
+	for rows.Next() {
+		var key linkKey
+		var startsAt, expiresAt *time.Time
+		if err := rows.Scan(&key.member, &key.role, &key.domain, &startsAt, &expiresAt); err != nil {
+			return fmt.Errorf("failed to load link windows: %w", err)
+		}
+
+		var window LinkWindow
+		if startsAt != nil {
+			window.StartsAt = *startsAt
+		}
+		if expiresAt != nil {
+			window.ExpiresAt = *expiresAt
+		}
+		windows[key] = window
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to load link windows: %w", err)
+	}
+
+	e.setLinkWindows(windows)
+	return nil
+}
+
+// windowedRoleManager hides role links outside their window from the matcher and from every
+// role query, so enforcement ignores them before they are removed
+type windowedRoleManager struct {
+	rbac.RoleManager
+	enforcer *Enforcer
+}
+
+// GetRoles returns the roles a subject holds directly through active links
+func (rm *windowedRoleManager) GetRoles(name string, domain ...string) ([]string, error) {
+	roles, err := rm.RoleManager.GetRoles(name, domain...)
+	if err != nil || len(domain) == 0 || !rm.enforcer.hasLinkWindows(name) {
+		return roles, err
+	}
+
+	active := roles[:0:0]
+	for _, role := range roles {
+		if rm.enforcer.linkActive(name, role, domain[0]) {
+			active = append(active, role)
+		}
+	}
+	return active, nil
+}
+
+// GetUsers returns the subjects holding a role directly through active links
+func (rm *windowedRoleManager) GetUsers(name string, domain ...string) ([]string, error) {
+	users, err := rm.RoleManager.GetUsers(name, domain...)
+	if err != nil || len(domain) == 0 {
+		return users, err
+	}
+
+	active := users[:0:0]
+	for _, user := range users {
+		if !rm.enforcer.hasLinkWindows(user) || rm.enforcer.linkActive(user, name, domain[0]) {
+			active = append(active, user)
+		}
+	}
+	return active, nil
+}
+
+// HasLink reports whether name1 inherits name2 through active links
+func (rm *windowedRoleManager) HasLink(name1, name2 string, domain ...string) (bool, error) {
+	if len(domain) == 0 {
+		return rm.RoleManager.HasLink(name1, name2, domain...)
+	}
+	return rm.hasActiveLink(name1, name2, domain, maxWindowedHierarchyLevel)
+}
+
+// hasActiveLink follows the windowed links of name1 and falls back to the default check for
+// subjects without windows; assignment windows only apply to links from users to roles
+func (rm *windowedRoleManager) hasActiveLink(name1, name2 string, domain []string, level int) (bool, error) {
+	if !rm.enforcer.hasLinkWindows(name1) {
+		return rm.RoleManager.HasLink(name1, name2, domain...)
+	}
+	if name1 == name2 {
+		return true, nil
+	}
+	if level <= 0 {
+		return false, nil
+	}
+
+	roles, err := rm.GetRoles(name1, domain...)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		ok, err := rm.hasActiveLink(role, name2, domain, level-1)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
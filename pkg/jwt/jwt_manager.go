@@ -50,8 +50,15 @@ func (m *JWTManager) GenerateAccessToken(userID, username string, roles []string
 
 // GenerateRefreshToken generates a new refresh token
 func (m *JWTManager) GenerateRefreshToken(userID string) (string, error) {
+	return m.GenerateRefreshTokenWithRoles(userID, nil)
+}
+
+// GenerateRefreshTokenWithRoles generates a new refresh token remembering the roles active in the
+// session, so refreshing keeps them active
+func (m *JWTManager) GenerateRefreshTokenWithRoles(userID string, roles []string) (string, error) {
 	claims := &Claims{
 		UserID: userID,
+		Roles:  roles,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.RefreshTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -32,12 +32,16 @@ type IAMServiceClient interface {
 	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
 	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*RemoveRoleResponse, error)
 	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
+	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error)
 	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
 	// Role Management - Quản lý vai trò
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
 	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error)
+	AddRoleParent(ctx context.Context, in *AddRoleParentRequest, opts ...grpc.CallOption) (*AddRoleParentResponse, error)
+	RemoveRoleParent(ctx context.Context, in *RemoveRoleParentRequest, opts ...grpc.CallOption) (*RemoveRoleParentResponse, error)
+	GetRoleHierarchy(ctx context.Context, in *GetRoleHierarchyRequest, opts ...grpc.CallOption) (*GetRoleHierarchyResponse, error)
 	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
 	// Permission Management - Quản lý quyền
 	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*CreatePermissionResponse, error)
@@ -47,15 +51,84 @@ type IAMServiceClient interface {
 	CheckAPIAccess(ctx context.Context, in *CheckAPIAccessRequest, opts ...grpc.CallOption) (*CheckAPIAccessResponse, error)
 	CheckCMSAccess(ctx context.Context, in *CheckCMSAccessRequest, opts ...grpc.CallOption) (*CheckCMSAccessResponse, error)
 	EnforcePolicy(ctx context.Context, in *EnforcePolicyRequest, opts ...grpc.CallOption) (*EnforcePolicyResponse, error)
+	BatchCheckAPIAccess(ctx context.Context, in *BatchCheckAPIAccessRequest, opts ...grpc.CallOption) (*BatchCheckAPIAccessResponse, error)
+	// Reverse lookup: the users and roles the policies allow an API or a CMS tab
+	ListAPIAccessHolders(ctx context.Context, in *ListAPIAccessHoldersRequest, opts ...grpc.CallOption) (*ListAccessHoldersResponse, error)
+	ListCMSAccessHolders(ctx context.Context, in *ListCMSAccessHoldersRequest, opts ...grpc.CallOption) (*ListAccessHoldersResponse, error)
+	BatchEnforcePolicy(ctx context.Context, in *BatchEnforcePolicyRequest, opts ...grpc.CallOption) (*BatchEnforcePolicyResponse, error)
+	// Policy Management - Quản lý policy
+	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	// Policy Import/Export - Xuất/nhập policy
+	ExportPolicies(ctx context.Context, in *ExportPoliciesRequest, opts ...grpc.CallOption) (*ExportPoliciesResponse, error)
+	ImportPolicies(ctx context.Context, in *ImportPoliciesRequest, opts ...grpc.CallOption) (*ImportPoliciesResponse, error)
+	// Policy Simulation - Mô phỏng thay đổi policy
+	SimulatePolicyChanges(ctx context.Context, in *SimulatePolicyChangesRequest, opts ...grpc.CallOption) (*SimulatePolicyChangesResponse, error)
+	// Policy Conditions - Điều kiện theo thuộc tính (ABAC)
+	SetPolicyCondition(ctx context.Context, in *SetPolicyConditionRequest, opts ...grpc.CallOption) (*SetPolicyConditionResponse, error)
+	DeletePolicyCondition(ctx context.Context, in *DeletePolicyConditionRequest, opts ...grpc.CallOption) (*DeletePolicyConditionResponse, error)
+	ListPolicyConditions(ctx context.Context, in *ListPolicyConditionsRequest, opts ...grpc.CallOption) (*ListPolicyConditionsResponse, error)
 	// CMS Role Management - Quản lý vai trò CMS
 	CreateCMSRole(ctx context.Context, in *CreateCMSRoleRequest, opts ...grpc.CallOption) (*CreateCMSRoleResponse, error)
 	AssignCMSRole(ctx context.Context, in *AssignCMSRoleRequest, opts ...grpc.CallOption) (*AssignCMSRoleResponse, error)
 	RemoveCMSRole(ctx context.Context, in *RemoveCMSRoleRequest, opts ...grpc.CallOption) (*RemoveCMSRoleResponse, error)
 	GetUserCMSTabs(ctx context.Context, in *GetUserCMSTabsRequest, opts ...grpc.CallOption) (*GetUserCMSTabsResponse, error)
+	GetCMSCapabilities(ctx context.Context, in *GetCMSCapabilitiesRequest, opts ...grpc.CallOption) (*GetCMSCapabilitiesResponse, error)
 	ListCMSRoles(ctx context.Context, in *ListCMSRolesRequest, opts ...grpc.CallOption) (*ListCMSRolesResponse, error)
+	GetCMSRole(ctx context.Context, in *GetCMSRoleRequest, opts ...grpc.CallOption) (*GetCMSRoleResponse, error)
+	UpdateCMSRole(ctx context.Context, in *UpdateCMSRoleRequest, opts ...grpc.CallOption) (*UpdateCMSRoleResponse, error)
+	DeleteCMSRole(ctx context.Context, in *DeleteCMSRoleRequest, opts ...grpc.CallOption) (*DeleteCMSRoleResponse, error)
+	// CMS Field Permissions - Quyền theo trường dữ liệu
+	SetCMSRoleFieldPermission(ctx context.Context, in *SetCMSRoleFieldPermissionRequest, opts ...grpc.CallOption) (*SetCMSRoleFieldPermissionResponse, error)
+	ListCMSRoleFieldPermissions(ctx context.Context, in *ListCMSRoleFieldPermissionsRequest, opts ...grpc.CallOption) (*ListCMSRoleFieldPermissionsResponse, error)
+	DeleteCMSRoleFieldPermission(ctx context.Context, in *DeleteCMSRoleFieldPermissionRequest, opts ...grpc.CallOption) (*DeleteCMSRoleFieldPermissionResponse, error)
+	GetUserFieldPermissions(ctx context.Context, in *GetUserFieldPermissionsRequest, opts ...grpc.CallOption) (*GetUserFieldPermissionsResponse, error)
+	// CMS Tab Registry - Danh mục tab CMS
+	CreateCMSTab(ctx context.Context, in *CreateCMSTabRequest, opts ...grpc.CallOption) (*CreateCMSTabResponse, error)
+	GetCMSTab(ctx context.Context, in *GetCMSTabRequest, opts ...grpc.CallOption) (*GetCMSTabResponse, error)
+	ListCMSTabs(ctx context.Context, in *ListCMSTabsRequest, opts ...grpc.CallOption) (*ListCMSTabsResponse, error)
+	UpdateCMSTab(ctx context.Context, in *UpdateCMSTabRequest, opts ...grpc.CallOption) (*UpdateCMSTabResponse, error)
+	DeleteCMSTab(ctx context.Context, in *DeleteCMSTabRequest, opts ...grpc.CallOption) (*DeleteCMSTabResponse, error)
+	// Resource Scopes - Phạm vi tài nguyên
+	CreateResourceScope(ctx context.Context, in *CreateResourceScopeRequest, opts ...grpc.CallOption) (*ResourceScopeResponse, error)
+	ListResourceScopes(ctx context.Context, in *ListResourceScopesRequest, opts ...grpc.CallOption) (*ListResourceScopesResponse, error)
+	DeleteResourceScope(ctx context.Context, in *DeleteResourceScopeRequest, opts ...grpc.CallOption) (*DeleteResourceScopeResponse, error)
+	ListUserScopedCMSRoles(ctx context.Context, in *ListUserScopedCMSRolesRequest, opts ...grpc.CallOption) (*ListUserScopedCMSRolesResponse, error)
 	// API Resource Management - Quản lý tài nguyên API
 	CreateAPIResource(ctx context.Context, in *CreateAPIResourceRequest, opts ...grpc.CallOption) (*CreateAPIResourceResponse, error)
 	ListAPIResources(ctx context.Context, in *ListAPIResourcesRequest, opts ...grpc.CallOption) (*ListAPIResourcesResponse, error)
+	// Access Requests - Yêu cầu cấp quyền
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error)
+	GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *DecideAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error)
+	DenyAccessRequest(ctx context.Context, in *DecideAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error)
+	SetAccessRequestApprovers(ctx context.Context, in *SetAccessRequestApproversRequest, opts ...grpc.CallOption) (*SetAccessRequestApproversResponse, error)
+	ListAccessRequestApprovers(ctx context.Context, in *ListAccessRequestApproversRequest, opts ...grpc.CallOption) (*ListAccessRequestApproversResponse, error)
+	// Separation of Duties - Phân tách nhiệm vụ
+	CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintRequest, opts ...grpc.CallOption) (*SoDConstraintResponse, error)
+	GetSoDConstraint(ctx context.Context, in *GetSoDConstraintRequest, opts ...grpc.CallOption) (*SoDConstraintResponse, error)
+	ListSoDConstraints(ctx context.Context, in *ListSoDConstraintsRequest, opts ...grpc.CallOption) (*ListSoDConstraintsResponse, error)
+	DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintRequest, opts ...grpc.CallOption) (*DeleteSoDConstraintResponse, error)
+	ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error)
+	// Access Reviews - Rà soát quyền truy cập
+	CreateAccessReviewCampaign(ctx context.Context, in *CreateAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error)
+	GetAccessReviewCampaign(ctx context.Context, in *GetAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error)
+	ListAccessReviewCampaigns(ctx context.Context, in *ListAccessReviewCampaignsRequest, opts ...grpc.CallOption) (*ListAccessReviewCampaignsResponse, error)
+	ListAccessReviewItems(ctx context.Context, in *ListAccessReviewItemsRequest, opts ...grpc.CallOption) (*ListAccessReviewItemsResponse, error)
+	DecideAccessReviewItem(ctx context.Context, in *DecideAccessReviewItemRequest, opts ...grpc.CallOption) (*AccessReviewItemResponse, error)
+	CloseAccessReviewCampaign(ctx context.Context, in *CloseAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error)
+	ExportAccessReviewReport(ctx context.Context, in *ExportAccessReviewReportRequest, opts ...grpc.CallOption) (*ExportAccessReviewReportResponse, error)
+	// Relation Tuples - Phân quyền theo quan hệ
+	PutRelationNamespace(ctx context.Context, in *PutRelationNamespaceRequest, opts ...grpc.CallOption) (*RelationNamespaceResponse, error)
+	ListRelationNamespaces(ctx context.Context, in *ListRelationNamespacesRequest, opts ...grpc.CallOption) (*ListRelationNamespacesResponse, error)
+	DeleteRelationNamespace(ctx context.Context, in *DeleteRelationNamespaceRequest, opts ...grpc.CallOption) (*DeleteRelationNamespaceResponse, error)
+	WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*WriteRelationTuplesResponse, error)
+	ReadRelationTuples(ctx context.Context, in *ReadRelationTuplesRequest, opts ...grpc.CallOption) (*ReadRelationTuplesResponse, error)
+	CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error)
+	ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error)
+	ListRelationObjects(ctx context.Context, in *ListRelationObjectsRequest, opts ...grpc.CallOption) (*ListRelationObjectsResponse, error)
 }
 
 type iAMServiceClient struct {
@@ -138,6 +211,15 @@ func (c *iAMServiceClient) GetUserRoles(ctx context.Context, in *GetUserRolesReq
 	return out, nil
 }
 
+func (c *iAMServiceClient) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error) {
+	out := new(GetEffectivePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetEffectivePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
 	out := new(CheckPermissionResponse)
 	err := c.cc.Invoke(ctx, "/iam.IAMService/CheckPermission", in, out, opts...)
@@ -183,6 +265,33 @@ func (c *iAMServiceClient) GetRole(ctx context.Context, in *GetRoleRequest, opts
 	return out, nil
 }
 
+func (c *iAMServiceClient) AddRoleParent(ctx context.Context, in *AddRoleParentRequest, opts ...grpc.CallOption) (*AddRoleParentResponse, error) {
+	out := new(AddRoleParentResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/AddRoleParent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) RemoveRoleParent(ctx context.Context, in *RemoveRoleParentRequest, opts ...grpc.CallOption) (*RemoveRoleParentResponse, error) {
+	out := new(RemoveRoleParentResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/RemoveRoleParent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) GetRoleHierarchy(ctx context.Context, in *GetRoleHierarchyRequest, opts ...grpc.CallOption) (*GetRoleHierarchyResponse, error) {
+	out := new(GetRoleHierarchyResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetRoleHierarchy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
 	out := new(ListRolesResponse)
 	err := c.cc.Invoke(ctx, "/iam.IAMService/ListRoles", in, out, opts...)
@@ -246,6 +355,123 @@ func (c *iAMServiceClient) EnforcePolicy(ctx context.Context, in *EnforcePolicyR
 	return out, nil
 }
 
+func (c *iAMServiceClient) BatchCheckAPIAccess(ctx context.Context, in *BatchCheckAPIAccessRequest, opts ...grpc.CallOption) (*BatchCheckAPIAccessResponse, error) {
+	out := new(BatchCheckAPIAccessResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/BatchCheckAPIAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListAPIAccessHolders(ctx context.Context, in *ListAPIAccessHoldersRequest, opts ...grpc.CallOption) (*ListAccessHoldersResponse, error) {
+	out := new(ListAccessHoldersResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAPIAccessHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListCMSAccessHolders(ctx context.Context, in *ListCMSAccessHoldersRequest, opts ...grpc.CallOption) (*ListAccessHoldersResponse, error) {
+	out := new(ListAccessHoldersResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListCMSAccessHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) BatchEnforcePolicy(ctx context.Context, in *BatchEnforcePolicyRequest, opts ...grpc.CallOption) (*BatchEnforcePolicyResponse, error) {
+	out := new(BatchEnforcePolicyResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/BatchEnforcePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error) {
+	out := new(CreatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error) {
+	out := new(DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeletePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ExportPolicies(ctx context.Context, in *ExportPoliciesRequest, opts ...grpc.CallOption) (*ExportPoliciesResponse, error) {
+	out := new(ExportPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ExportPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ImportPolicies(ctx context.Context, in *ImportPoliciesRequest, opts ...grpc.CallOption) (*ImportPoliciesResponse, error) {
+	out := new(ImportPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ImportPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) SimulatePolicyChanges(ctx context.Context, in *SimulatePolicyChangesRequest, opts ...grpc.CallOption) (*SimulatePolicyChangesResponse, error) {
+	out := new(SimulatePolicyChangesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/SimulatePolicyChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) SetPolicyCondition(ctx context.Context, in *SetPolicyConditionRequest, opts ...grpc.CallOption) (*SetPolicyConditionResponse, error) {
+	out := new(SetPolicyConditionResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/SetPolicyCondition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DeletePolicyCondition(ctx context.Context, in *DeletePolicyConditionRequest, opts ...grpc.CallOption) (*DeletePolicyConditionResponse, error) {
+	out := new(DeletePolicyConditionResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeletePolicyCondition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListPolicyConditions(ctx context.Context, in *ListPolicyConditionsRequest, opts ...grpc.CallOption) (*ListPolicyConditionsResponse, error) {
+	out := new(ListPolicyConditionsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListPolicyConditions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) CreateCMSRole(ctx context.Context, in *CreateCMSRoleRequest, opts ...grpc.CallOption) (*CreateCMSRoleResponse, error) {
 	out := new(CreateCMSRoleResponse)
 	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateCMSRole", in, out, opts...)
@@ -282,6 +508,15 @@ func (c *iAMServiceClient) GetUserCMSTabs(ctx context.Context, in *GetUserCMSTab
 	return out, nil
 }
 
+func (c *iAMServiceClient) GetCMSCapabilities(ctx context.Context, in *GetCMSCapabilitiesRequest, opts ...grpc.CallOption) (*GetCMSCapabilitiesResponse, error) {
+	out := new(GetCMSCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetCMSCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMServiceClient) ListCMSRoles(ctx context.Context, in *ListCMSRolesRequest, opts ...grpc.CallOption) (*ListCMSRolesResponse, error) {
 	out := new(ListCMSRolesResponse)
 	err := c.cc.Invoke(ctx, "/iam.IAMService/ListCMSRoles", in, out, opts...)
@@ -291,645 +526,2386 @@ func (c *iAMServiceClient) ListCMSRoles(ctx context.Context, in *ListCMSRolesReq
 	return out, nil
 }
 
-func (c *iAMServiceClient) CreateAPIResource(ctx context.Context, in *CreateAPIResourceRequest, opts ...grpc.CallOption) (*CreateAPIResourceResponse, error) {
-	out := new(CreateAPIResourceResponse)
-	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateAPIResource", in, out, opts...)
+func (c *iAMServiceClient) GetCMSRole(ctx context.Context, in *GetCMSRoleRequest, opts ...grpc.CallOption) (*GetCMSRoleResponse, error) {
+	out := new(GetCMSRoleResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetCMSRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iAMServiceClient) ListAPIResources(ctx context.Context, in *ListAPIResourcesRequest, opts ...grpc.CallOption) (*ListAPIResourcesResponse, error) {
-	out := new(ListAPIResourcesResponse)
-	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAPIResources", in, out, opts...)
+func (c *iAMServiceClient) UpdateCMSRole(ctx context.Context, in *UpdateCMSRoleRequest, opts ...grpc.CallOption) (*UpdateCMSRoleResponse, error) {
+	out := new(UpdateCMSRoleResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/UpdateCMSRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// IAMServiceServer is the server API for IAMService service.
-// All implementations must embed UnimplementedIAMServiceServer
-// for forward compatibility
-type IAMServiceServer interface {
-	// Authentication - Xác thực
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
-	VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error)
-	// Authorization - Phân quyền
-	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
-	RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error)
-	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
-	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
-	// Role Management - Quản lý vai trò
-	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
-	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
-	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
-	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
-	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
-	// Permission Management - Quản lý quyền
-	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
-	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
-	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
-	// Casbin Authorization - Phân quyền theo Casbin RBAC
-	CheckAPIAccess(context.Context, *CheckAPIAccessRequest) (*CheckAPIAccessResponse, error)
-	CheckCMSAccess(context.Context, *CheckCMSAccessRequest) (*CheckCMSAccessResponse, error)
-	EnforcePolicy(context.Context, *EnforcePolicyRequest) (*EnforcePolicyResponse, error)
-	// CMS Role Management - Quản lý vai trò CMS
-	CreateCMSRole(context.Context, *CreateCMSRoleRequest) (*CreateCMSRoleResponse, error)
-	AssignCMSRole(context.Context, *AssignCMSRoleRequest) (*AssignCMSRoleResponse, error)
-	RemoveCMSRole(context.Context, *RemoveCMSRoleRequest) (*RemoveCMSRoleResponse, error)
-	GetUserCMSTabs(context.Context, *GetUserCMSTabsRequest) (*GetUserCMSTabsResponse, error)
-	ListCMSRoles(context.Context, *ListCMSRolesRequest) (*ListCMSRolesResponse, error)
-	// API Resource Management - Quản lý tài nguyên API
-	CreateAPIResource(context.Context, *CreateAPIResourceRequest) (*CreateAPIResourceResponse, error)
-	ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error)
-	mustEmbedUnimplementedIAMServiceServer()
+func (c *iAMServiceClient) DeleteCMSRole(ctx context.Context, in *DeleteCMSRoleRequest, opts ...grpc.CallOption) (*DeleteCMSRoleResponse, error) {
+	out := new(DeleteCMSRoleResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteCMSRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedIAMServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedIAMServiceServer struct {
+func (c *iAMServiceClient) SetCMSRoleFieldPermission(ctx context.Context, in *SetCMSRoleFieldPermissionRequest, opts ...grpc.CallOption) (*SetCMSRoleFieldPermissionResponse, error) {
+	out := new(SetCMSRoleFieldPermissionResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/SetCMSRoleFieldPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedIAMServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (c *iAMServiceClient) ListCMSRoleFieldPermissions(ctx context.Context, in *ListCMSRoleFieldPermissionsRequest, opts ...grpc.CallOption) (*ListCMSRoleFieldPermissionsResponse, error) {
+	out := new(ListCMSRoleFieldPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListCMSRoleFieldPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *iAMServiceClient) DeleteCMSRoleFieldPermission(ctx context.Context, in *DeleteCMSRoleFieldPermissionRequest, opts ...grpc.CallOption) (*DeleteCMSRoleFieldPermissionResponse, error) {
+	out := new(DeleteCMSRoleFieldPermissionResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteCMSRoleFieldPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *iAMServiceClient) GetUserFieldPermissions(ctx context.Context, in *GetUserFieldPermissionsRequest, opts ...grpc.CallOption) (*GetUserFieldPermissionsResponse, error) {
+	out := new(GetUserFieldPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetUserFieldPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+
+func (c *iAMServiceClient) CreateCMSTab(ctx context.Context, in *CreateCMSTabRequest, opts ...grpc.CallOption) (*CreateCMSTabResponse, error) {
+	out := new(CreateCMSTabResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateCMSTab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyToken not implemented")
+
+func (c *iAMServiceClient) GetCMSTab(ctx context.Context, in *GetCMSTabRequest, opts ...grpc.CallOption) (*GetCMSTabResponse, error) {
+	out := new(GetCMSTabResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetCMSTab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+
+func (c *iAMServiceClient) ListCMSTabs(ctx context.Context, in *ListCMSTabsRequest, opts ...grpc.CallOption) (*ListCMSTabsResponse, error) {
+	out := new(ListCMSTabsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListCMSTabs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRole not implemented")
+
+func (c *iAMServiceClient) UpdateCMSTab(ctx context.Context, in *UpdateCMSTabRequest, opts ...grpc.CallOption) (*UpdateCMSTabResponse, error) {
+	out := new(UpdateCMSTabResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/UpdateCMSTab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserRoles not implemented")
+
+func (c *iAMServiceClient) DeleteCMSTab(ctx context.Context, in *DeleteCMSTabRequest, opts ...grpc.CallOption) (*DeleteCMSTabResponse, error) {
+	out := new(DeleteCMSTabResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteCMSTab", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+
+func (c *iAMServiceClient) CreateResourceScope(ctx context.Context, in *CreateResourceScopeRequest, opts ...grpc.CallOption) (*ResourceScopeResponse, error) {
+	out := new(ResourceScopeResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateResourceScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+
+func (c *iAMServiceClient) ListResourceScopes(ctx context.Context, in *ListResourceScopesRequest, opts ...grpc.CallOption) (*ListResourceScopesResponse, error) {
+	out := new(ListResourceScopesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListResourceScopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+
+func (c *iAMServiceClient) DeleteResourceScope(ctx context.Context, in *DeleteResourceScopeRequest, opts ...grpc.CallOption) (*DeleteResourceScopeResponse, error) {
+	out := new(DeleteResourceScopeResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteResourceScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+
+func (c *iAMServiceClient) ListUserScopedCMSRoles(ctx context.Context, in *ListUserScopedCMSRolesRequest, opts ...grpc.CallOption) (*ListUserScopedCMSRolesResponse, error) {
+	out := new(ListUserScopedCMSRolesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListUserScopedCMSRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+
+func (c *iAMServiceClient) CreateAPIResource(ctx context.Context, in *CreateAPIResourceRequest, opts ...grpc.CallOption) (*CreateAPIResourceResponse, error) {
+	out := new(CreateAPIResourceResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateAPIResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+
+func (c *iAMServiceClient) ListAPIResources(ctx context.Context, in *ListAPIResourcesRequest, opts ...grpc.CallOption) (*ListAPIResourcesResponse, error) {
+	out := new(ListAPIResourcesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAPIResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+
+func (c *iAMServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error) {
+	out := new(AccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
+
+func (c *iAMServiceClient) GetAccessRequest(ctx context.Context, in *GetAccessRequestRequest, opts ...grpc.CallOption) (*GetAccessRequestResponse, error) {
+	out := new(GetAccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+
+func (c *iAMServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAccessRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CheckAPIAccess(context.Context, *CheckAPIAccessRequest) (*CheckAPIAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckAPIAccess not implemented")
+
+func (c *iAMServiceClient) ApproveAccessRequest(ctx context.Context, in *DecideAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error) {
+	out := new(AccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ApproveAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CheckCMSAccess(context.Context, *CheckCMSAccessRequest) (*CheckCMSAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCMSAccess not implemented")
+
+func (c *iAMServiceClient) DenyAccessRequest(ctx context.Context, in *DecideAccessRequestRequest, opts ...grpc.CallOption) (*AccessRequestResponse, error) {
+	out := new(AccessRequestResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DenyAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) EnforcePolicy(context.Context, *EnforcePolicyRequest) (*EnforcePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnforcePolicy not implemented")
+
+func (c *iAMServiceClient) SetAccessRequestApprovers(ctx context.Context, in *SetAccessRequestApproversRequest, opts ...grpc.CallOption) (*SetAccessRequestApproversResponse, error) {
+	out := new(SetAccessRequestApproversResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/SetAccessRequestApprovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CreateCMSRole(context.Context, *CreateCMSRoleRequest) (*CreateCMSRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCMSRole not implemented")
+
+func (c *iAMServiceClient) ListAccessRequestApprovers(ctx context.Context, in *ListAccessRequestApproversRequest, opts ...grpc.CallOption) (*ListAccessRequestApproversResponse, error) {
+	out := new(ListAccessRequestApproversResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAccessRequestApprovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) AssignCMSRole(context.Context, *AssignCMSRoleRequest) (*AssignCMSRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignCMSRole not implemented")
+
+func (c *iAMServiceClient) CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintRequest, opts ...grpc.CallOption) (*SoDConstraintResponse, error) {
+	out := new(SoDConstraintResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateSoDConstraint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) RemoveCMSRole(context.Context, *RemoveCMSRoleRequest) (*RemoveCMSRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCMSRole not implemented")
+
+func (c *iAMServiceClient) GetSoDConstraint(ctx context.Context, in *GetSoDConstraintRequest, opts ...grpc.CallOption) (*SoDConstraintResponse, error) {
+	out := new(SoDConstraintResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetSoDConstraint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) GetUserCMSTabs(context.Context, *GetUserCMSTabsRequest) (*GetUserCMSTabsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserCMSTabs not implemented")
+
+func (c *iAMServiceClient) ListSoDConstraints(ctx context.Context, in *ListSoDConstraintsRequest, opts ...grpc.CallOption) (*ListSoDConstraintsResponse, error) {
+	out := new(ListSoDConstraintsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListSoDConstraints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) ListCMSRoles(context.Context, *ListCMSRolesRequest) (*ListCMSRolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCMSRoles not implemented")
+
+func (c *iAMServiceClient) DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintRequest, opts ...grpc.CallOption) (*DeleteSoDConstraintResponse, error) {
+	out := new(DeleteSoDConstraintResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteSoDConstraint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) CreateAPIResource(context.Context, *CreateAPIResourceRequest) (*CreateAPIResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIResource not implemented")
+
+func (c *iAMServiceClient) ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error) {
+	out := new(ListSoDViolationsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListSoDViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAPIResources not implemented")
+
+func (c *iAMServiceClient) CreateAccessReviewCampaign(ctx context.Context, in *CreateAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error) {
+	out := new(AccessReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CreateAccessReviewCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedIAMServiceServer) mustEmbedUnimplementedIAMServiceServer() {}
 
-// UnsafeIAMServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to IAMServiceServer will
-// result in compilation errors.
-type UnsafeIAMServiceServer interface {
+func (c *iAMServiceClient) GetAccessReviewCampaign(ctx context.Context, in *GetAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error) {
+	out := new(AccessReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/GetAccessReviewCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListAccessReviewCampaigns(ctx context.Context, in *ListAccessReviewCampaignsRequest, opts ...grpc.CallOption) (*ListAccessReviewCampaignsResponse, error) {
+	out := new(ListAccessReviewCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAccessReviewCampaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListAccessReviewItems(ctx context.Context, in *ListAccessReviewItemsRequest, opts ...grpc.CallOption) (*ListAccessReviewItemsResponse, error) {
+	out := new(ListAccessReviewItemsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListAccessReviewItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DecideAccessReviewItem(ctx context.Context, in *DecideAccessReviewItemRequest, opts ...grpc.CallOption) (*AccessReviewItemResponse, error) {
+	out := new(AccessReviewItemResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DecideAccessReviewItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) CloseAccessReviewCampaign(ctx context.Context, in *CloseAccessReviewCampaignRequest, opts ...grpc.CallOption) (*AccessReviewCampaignResponse, error) {
+	out := new(AccessReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CloseAccessReviewCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ExportAccessReviewReport(ctx context.Context, in *ExportAccessReviewReportRequest, opts ...grpc.CallOption) (*ExportAccessReviewReportResponse, error) {
+	out := new(ExportAccessReviewReportResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ExportAccessReviewReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) PutRelationNamespace(ctx context.Context, in *PutRelationNamespaceRequest, opts ...grpc.CallOption) (*RelationNamespaceResponse, error) {
+	out := new(RelationNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/PutRelationNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListRelationNamespaces(ctx context.Context, in *ListRelationNamespacesRequest, opts ...grpc.CallOption) (*ListRelationNamespacesResponse, error) {
+	out := new(ListRelationNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListRelationNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) DeleteRelationNamespace(ctx context.Context, in *DeleteRelationNamespaceRequest, opts ...grpc.CallOption) (*DeleteRelationNamespaceResponse, error) {
+	out := new(DeleteRelationNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/DeleteRelationNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) WriteRelationTuples(ctx context.Context, in *WriteRelationTuplesRequest, opts ...grpc.CallOption) (*WriteRelationTuplesResponse, error) {
+	out := new(WriteRelationTuplesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/WriteRelationTuples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ReadRelationTuples(ctx context.Context, in *ReadRelationTuplesRequest, opts ...grpc.CallOption) (*ReadRelationTuplesResponse, error) {
+	out := new(ReadRelationTuplesResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ReadRelationTuples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) CheckRelation(ctx context.Context, in *CheckRelationRequest, opts ...grpc.CallOption) (*CheckRelationResponse, error) {
+	out := new(CheckRelationResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/CheckRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ExpandRelation(ctx context.Context, in *ExpandRelationRequest, opts ...grpc.CallOption) (*ExpandRelationResponse, error) {
+	out := new(ExpandRelationResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ExpandRelation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMServiceClient) ListRelationObjects(ctx context.Context, in *ListRelationObjectsRequest, opts ...grpc.CallOption) (*ListRelationObjectsResponse, error) {
+	out := new(ListRelationObjectsResponse)
+	err := c.cc.Invoke(ctx, "/iam.IAMService/ListRelationObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IAMServiceServer is the server API for IAMService service.
+// All implementations must embed UnimplementedIAMServiceServer
+// for forward compatibility
+type IAMServiceServer interface {
+	// Authentication - Xác thực
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error)
+	// Authorization - Phân quyền
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error)
+	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
+	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error)
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// Role Management - Quản lý vai trò
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
+	AddRoleParent(context.Context, *AddRoleParentRequest) (*AddRoleParentResponse, error)
+	RemoveRoleParent(context.Context, *RemoveRoleParentRequest) (*RemoveRoleParentResponse, error)
+	GetRoleHierarchy(context.Context, *GetRoleHierarchyRequest) (*GetRoleHierarchyResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// Permission Management - Quản lý quyền
+	CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error)
+	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// Casbin Authorization - Phân quyền theo Casbin RBAC
+	CheckAPIAccess(context.Context, *CheckAPIAccessRequest) (*CheckAPIAccessResponse, error)
+	CheckCMSAccess(context.Context, *CheckCMSAccessRequest) (*CheckCMSAccessResponse, error)
+	EnforcePolicy(context.Context, *EnforcePolicyRequest) (*EnforcePolicyResponse, error)
+	BatchCheckAPIAccess(context.Context, *BatchCheckAPIAccessRequest) (*BatchCheckAPIAccessResponse, error)
+	// Reverse lookup: the users and roles the policies allow an API or a CMS tab
+	ListAPIAccessHolders(context.Context, *ListAPIAccessHoldersRequest) (*ListAccessHoldersResponse, error)
+	ListCMSAccessHolders(context.Context, *ListCMSAccessHoldersRequest) (*ListAccessHoldersResponse, error)
+	BatchEnforcePolicy(context.Context, *BatchEnforcePolicyRequest) (*BatchEnforcePolicyResponse, error)
+	// Policy Management - Quản lý policy
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
+	// Policy Import/Export - Xuất/nhập policy
+	ExportPolicies(context.Context, *ExportPoliciesRequest) (*ExportPoliciesResponse, error)
+	ImportPolicies(context.Context, *ImportPoliciesRequest) (*ImportPoliciesResponse, error)
+	// Policy Simulation - Mô phỏng thay đổi policy
+	SimulatePolicyChanges(context.Context, *SimulatePolicyChangesRequest) (*SimulatePolicyChangesResponse, error)
+	// Policy Conditions - Điều kiện theo thuộc tính (ABAC)
+	SetPolicyCondition(context.Context, *SetPolicyConditionRequest) (*SetPolicyConditionResponse, error)
+	DeletePolicyCondition(context.Context, *DeletePolicyConditionRequest) (*DeletePolicyConditionResponse, error)
+	ListPolicyConditions(context.Context, *ListPolicyConditionsRequest) (*ListPolicyConditionsResponse, error)
+	// CMS Role Management - Quản lý vai trò CMS
+	CreateCMSRole(context.Context, *CreateCMSRoleRequest) (*CreateCMSRoleResponse, error)
+	AssignCMSRole(context.Context, *AssignCMSRoleRequest) (*AssignCMSRoleResponse, error)
+	RemoveCMSRole(context.Context, *RemoveCMSRoleRequest) (*RemoveCMSRoleResponse, error)
+	GetUserCMSTabs(context.Context, *GetUserCMSTabsRequest) (*GetUserCMSTabsResponse, error)
+	GetCMSCapabilities(context.Context, *GetCMSCapabilitiesRequest) (*GetCMSCapabilitiesResponse, error)
+	ListCMSRoles(context.Context, *ListCMSRolesRequest) (*ListCMSRolesResponse, error)
+	GetCMSRole(context.Context, *GetCMSRoleRequest) (*GetCMSRoleResponse, error)
+	UpdateCMSRole(context.Context, *UpdateCMSRoleRequest) (*UpdateCMSRoleResponse, error)
+	DeleteCMSRole(context.Context, *DeleteCMSRoleRequest) (*DeleteCMSRoleResponse, error)
+	// CMS Field Permissions - Quyền theo trường dữ liệu
+	SetCMSRoleFieldPermission(context.Context, *SetCMSRoleFieldPermissionRequest) (*SetCMSRoleFieldPermissionResponse, error)
+	ListCMSRoleFieldPermissions(context.Context, *ListCMSRoleFieldPermissionsRequest) (*ListCMSRoleFieldPermissionsResponse, error)
+	DeleteCMSRoleFieldPermission(context.Context, *DeleteCMSRoleFieldPermissionRequest) (*DeleteCMSRoleFieldPermissionResponse, error)
+	GetUserFieldPermissions(context.Context, *GetUserFieldPermissionsRequest) (*GetUserFieldPermissionsResponse, error)
+	// CMS Tab Registry - Danh mục tab CMS
+	CreateCMSTab(context.Context, *CreateCMSTabRequest) (*CreateCMSTabResponse, error)
+	GetCMSTab(context.Context, *GetCMSTabRequest) (*GetCMSTabResponse, error)
+	ListCMSTabs(context.Context, *ListCMSTabsRequest) (*ListCMSTabsResponse, error)
+	UpdateCMSTab(context.Context, *UpdateCMSTabRequest) (*UpdateCMSTabResponse, error)
+	DeleteCMSTab(context.Context, *DeleteCMSTabRequest) (*DeleteCMSTabResponse, error)
+	// Resource Scopes - Phạm vi tài nguyên
+	CreateResourceScope(context.Context, *CreateResourceScopeRequest) (*ResourceScopeResponse, error)
+	ListResourceScopes(context.Context, *ListResourceScopesRequest) (*ListResourceScopesResponse, error)
+	DeleteResourceScope(context.Context, *DeleteResourceScopeRequest) (*DeleteResourceScopeResponse, error)
+	ListUserScopedCMSRoles(context.Context, *ListUserScopedCMSRolesRequest) (*ListUserScopedCMSRolesResponse, error)
+	// API Resource Management - Quản lý tài nguyên API
+	CreateAPIResource(context.Context, *CreateAPIResourceRequest) (*CreateAPIResourceResponse, error)
+	ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error)
+	// Access Requests - Yêu cầu cấp quyền
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*AccessRequestResponse, error)
+	GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error)
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(context.Context, *DecideAccessRequestRequest) (*AccessRequestResponse, error)
+	DenyAccessRequest(context.Context, *DecideAccessRequestRequest) (*AccessRequestResponse, error)
+	SetAccessRequestApprovers(context.Context, *SetAccessRequestApproversRequest) (*SetAccessRequestApproversResponse, error)
+	ListAccessRequestApprovers(context.Context, *ListAccessRequestApproversRequest) (*ListAccessRequestApproversResponse, error)
+	// Separation of Duties - Phân tách nhiệm vụ
+	CreateSoDConstraint(context.Context, *CreateSoDConstraintRequest) (*SoDConstraintResponse, error)
+	GetSoDConstraint(context.Context, *GetSoDConstraintRequest) (*SoDConstraintResponse, error)
+	ListSoDConstraints(context.Context, *ListSoDConstraintsRequest) (*ListSoDConstraintsResponse, error)
+	DeleteSoDConstraint(context.Context, *DeleteSoDConstraintRequest) (*DeleteSoDConstraintResponse, error)
+	ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error)
+	// Access Reviews - Rà soát quyền truy cập
+	CreateAccessReviewCampaign(context.Context, *CreateAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error)
+	GetAccessReviewCampaign(context.Context, *GetAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error)
+	ListAccessReviewCampaigns(context.Context, *ListAccessReviewCampaignsRequest) (*ListAccessReviewCampaignsResponse, error)
+	ListAccessReviewItems(context.Context, *ListAccessReviewItemsRequest) (*ListAccessReviewItemsResponse, error)
+	DecideAccessReviewItem(context.Context, *DecideAccessReviewItemRequest) (*AccessReviewItemResponse, error)
+	CloseAccessReviewCampaign(context.Context, *CloseAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error)
+	ExportAccessReviewReport(context.Context, *ExportAccessReviewReportRequest) (*ExportAccessReviewReportResponse, error)
+	// Relation Tuples - Phân quyền theo quan hệ
+	PutRelationNamespace(context.Context, *PutRelationNamespaceRequest) (*RelationNamespaceResponse, error)
+	ListRelationNamespaces(context.Context, *ListRelationNamespacesRequest) (*ListRelationNamespacesResponse, error)
+	DeleteRelationNamespace(context.Context, *DeleteRelationNamespaceRequest) (*DeleteRelationNamespaceResponse, error)
+	WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*WriteRelationTuplesResponse, error)
+	ReadRelationTuples(context.Context, *ReadRelationTuplesRequest) (*ReadRelationTuplesResponse, error)
+	CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error)
+	ExpandRelation(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error)
+	ListRelationObjects(context.Context, *ListRelationObjectsRequest) (*ListRelationObjectsResponse, error)
 	mustEmbedUnimplementedIAMServiceServer()
 }
 
-func RegisterIAMServiceServer(s grpc.ServiceRegistrar, srv IAMServiceServer) {
-	s.RegisterService(&IAMService_ServiceDesc, srv)
+// UnimplementedIAMServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIAMServiceServer struct {
 }
 
-func _IAMService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func (UnimplementedIAMServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedIAMServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedIAMServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedIAMServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedIAMServiceServer) VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyToken not implemented")
+}
+func (UnimplementedIAMServiceServer) AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedIAMServiceServer) RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRole not implemented")
+}
+func (UnimplementedIAMServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRoles not implemented")
+}
+func (UnimplementedIAMServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePermissions not implemented")
+}
+func (UnimplementedIAMServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedIAMServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedIAMServiceServer) GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedIAMServiceServer) AddRoleParent(context.Context, *AddRoleParentRequest) (*AddRoleParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoleParent not implemented")
+}
+func (UnimplementedIAMServiceServer) RemoveRoleParent(context.Context, *RemoveRoleParentRequest) (*RemoveRoleParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleParent not implemented")
+}
+func (UnimplementedIAMServiceServer) GetRoleHierarchy(context.Context, *GetRoleHierarchyRequest) (*GetRoleHierarchyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleHierarchy not implemented")
+}
+func (UnimplementedIAMServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedIAMServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+}
+func (UnimplementedIAMServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
+}
+func (UnimplementedIAMServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (UnimplementedIAMServiceServer) CheckAPIAccess(context.Context, *CheckAPIAccessRequest) (*CheckAPIAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAPIAccess not implemented")
+}
+func (UnimplementedIAMServiceServer) CheckCMSAccess(context.Context, *CheckCMSAccessRequest) (*CheckCMSAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCMSAccess not implemented")
+}
+func (UnimplementedIAMServiceServer) EnforcePolicy(context.Context, *EnforcePolicyRequest) (*EnforcePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnforcePolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) BatchCheckAPIAccess(context.Context, *BatchCheckAPIAccessRequest) (*BatchCheckAPIAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckAPIAccess not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAPIAccessHolders(context.Context, *ListAPIAccessHoldersRequest) (*ListAccessHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIAccessHolders not implemented")
+}
+func (UnimplementedIAMServiceServer) ListCMSAccessHolders(context.Context, *ListCMSAccessHoldersRequest) (*ListAccessHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCMSAccessHolders not implemented")
+}
+func (UnimplementedIAMServiceServer) BatchEnforcePolicy(context.Context, *BatchEnforcePolicyRequest) (*BatchEnforcePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEnforcePolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedIAMServiceServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedIAMServiceServer) ExportPolicies(context.Context, *ExportPoliciesRequest) (*ExportPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPolicies not implemented")
+}
+func (UnimplementedIAMServiceServer) ImportPolicies(context.Context, *ImportPoliciesRequest) (*ImportPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPolicies not implemented")
+}
+func (UnimplementedIAMServiceServer) SimulatePolicyChanges(context.Context, *SimulatePolicyChangesRequest) (*SimulatePolicyChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicyChanges not implemented")
+}
+func (UnimplementedIAMServiceServer) SetPolicyCondition(context.Context, *SetPolicyConditionRequest) (*SetPolicyConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPolicyCondition not implemented")
+}
+func (UnimplementedIAMServiceServer) DeletePolicyCondition(context.Context, *DeletePolicyConditionRequest) (*DeletePolicyConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicyCondition not implemented")
+}
+func (UnimplementedIAMServiceServer) ListPolicyConditions(context.Context, *ListPolicyConditionsRequest) (*ListPolicyConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyConditions not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateCMSRole(context.Context, *CreateCMSRoleRequest) (*CreateCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) AssignCMSRole(context.Context, *AssignCMSRoleRequest) (*AssignCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) RemoveCMSRole(context.Context, *RemoveCMSRoleRequest) (*RemoveCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) GetUserCMSTabs(context.Context, *GetUserCMSTabsRequest) (*GetUserCMSTabsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCMSTabs not implemented")
+}
+func (UnimplementedIAMServiceServer) GetCMSCapabilities(context.Context, *GetCMSCapabilitiesRequest) (*GetCMSCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCMSCapabilities not implemented")
+}
+func (UnimplementedIAMServiceServer) ListCMSRoles(context.Context, *ListCMSRolesRequest) (*ListCMSRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCMSRoles not implemented")
+}
+func (UnimplementedIAMServiceServer) GetCMSRole(context.Context, *GetCMSRoleRequest) (*GetCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) UpdateCMSRole(context.Context, *UpdateCMSRoleRequest) (*UpdateCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteCMSRole(context.Context, *DeleteCMSRoleRequest) (*DeleteCMSRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCMSRole not implemented")
+}
+func (UnimplementedIAMServiceServer) SetCMSRoleFieldPermission(context.Context, *SetCMSRoleFieldPermissionRequest) (*SetCMSRoleFieldPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCMSRoleFieldPermission not implemented")
+}
+func (UnimplementedIAMServiceServer) ListCMSRoleFieldPermissions(context.Context, *ListCMSRoleFieldPermissionsRequest) (*ListCMSRoleFieldPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCMSRoleFieldPermissions not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteCMSRoleFieldPermission(context.Context, *DeleteCMSRoleFieldPermissionRequest) (*DeleteCMSRoleFieldPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCMSRoleFieldPermission not implemented")
+}
+func (UnimplementedIAMServiceServer) GetUserFieldPermissions(context.Context, *GetUserFieldPermissionsRequest) (*GetUserFieldPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFieldPermissions not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateCMSTab(context.Context, *CreateCMSTabRequest) (*CreateCMSTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCMSTab not implemented")
+}
+func (UnimplementedIAMServiceServer) GetCMSTab(context.Context, *GetCMSTabRequest) (*GetCMSTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCMSTab not implemented")
+}
+func (UnimplementedIAMServiceServer) ListCMSTabs(context.Context, *ListCMSTabsRequest) (*ListCMSTabsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCMSTabs not implemented")
+}
+func (UnimplementedIAMServiceServer) UpdateCMSTab(context.Context, *UpdateCMSTabRequest) (*UpdateCMSTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCMSTab not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteCMSTab(context.Context, *DeleteCMSTabRequest) (*DeleteCMSTabResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCMSTab not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateResourceScope(context.Context, *CreateResourceScopeRequest) (*ResourceScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceScope not implemented")
+}
+func (UnimplementedIAMServiceServer) ListResourceScopes(context.Context, *ListResourceScopesRequest) (*ListResourceScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceScopes not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteResourceScope(context.Context, *DeleteResourceScopeRequest) (*DeleteResourceScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResourceScope not implemented")
+}
+func (UnimplementedIAMServiceServer) ListUserScopedCMSRoles(context.Context, *ListUserScopedCMSRolesRequest) (*ListUserScopedCMSRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserScopedCMSRoles not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateAPIResource(context.Context, *CreateAPIResourceRequest) (*CreateAPIResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIResource not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIResources not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*AccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) GetAccessRequest(context.Context, *GetAccessRequestRequest) (*GetAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedIAMServiceServer) ApproveAccessRequest(context.Context, *DecideAccessRequestRequest) (*AccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) DenyAccessRequest(context.Context, *DecideAccessRequestRequest) (*AccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
+func (UnimplementedIAMServiceServer) SetAccessRequestApprovers(context.Context, *SetAccessRequestApproversRequest) (*SetAccessRequestApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccessRequestApprovers not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAccessRequestApprovers(context.Context, *ListAccessRequestApproversRequest) (*ListAccessRequestApproversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequestApprovers not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateSoDConstraint(context.Context, *CreateSoDConstraintRequest) (*SoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSoDConstraint not implemented")
+}
+func (UnimplementedIAMServiceServer) GetSoDConstraint(context.Context, *GetSoDConstraintRequest) (*SoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSoDConstraint not implemented")
+}
+func (UnimplementedIAMServiceServer) ListSoDConstraints(context.Context, *ListSoDConstraintsRequest) (*ListSoDConstraintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDConstraints not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteSoDConstraint(context.Context, *DeleteSoDConstraintRequest) (*DeleteSoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoDConstraint not implemented")
+}
+func (UnimplementedIAMServiceServer) ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDViolations not implemented")
+}
+func (UnimplementedIAMServiceServer) CreateAccessReviewCampaign(context.Context, *CreateAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessReviewCampaign not implemented")
+}
+func (UnimplementedIAMServiceServer) GetAccessReviewCampaign(context.Context, *GetAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessReviewCampaign not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAccessReviewCampaigns(context.Context, *ListAccessReviewCampaignsRequest) (*ListAccessReviewCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessReviewCampaigns not implemented")
+}
+func (UnimplementedIAMServiceServer) ListAccessReviewItems(context.Context, *ListAccessReviewItemsRequest) (*ListAccessReviewItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessReviewItems not implemented")
+}
+func (UnimplementedIAMServiceServer) DecideAccessReviewItem(context.Context, *DecideAccessReviewItemRequest) (*AccessReviewItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideAccessReviewItem not implemented")
+}
+func (UnimplementedIAMServiceServer) CloseAccessReviewCampaign(context.Context, *CloseAccessReviewCampaignRequest) (*AccessReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccessReviewCampaign not implemented")
+}
+func (UnimplementedIAMServiceServer) ExportAccessReviewReport(context.Context, *ExportAccessReviewReportRequest) (*ExportAccessReviewReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccessReviewReport not implemented")
+}
+func (UnimplementedIAMServiceServer) PutRelationNamespace(context.Context, *PutRelationNamespaceRequest) (*RelationNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRelationNamespace not implemented")
+}
+func (UnimplementedIAMServiceServer) ListRelationNamespaces(context.Context, *ListRelationNamespacesRequest) (*ListRelationNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelationNamespaces not implemented")
+}
+func (UnimplementedIAMServiceServer) DeleteRelationNamespace(context.Context, *DeleteRelationNamespaceRequest) (*DeleteRelationNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationNamespace not implemented")
+}
+func (UnimplementedIAMServiceServer) WriteRelationTuples(context.Context, *WriteRelationTuplesRequest) (*WriteRelationTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRelationTuples not implemented")
+}
+func (UnimplementedIAMServiceServer) ReadRelationTuples(context.Context, *ReadRelationTuplesRequest) (*ReadRelationTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRelationTuples not implemented")
+}
+func (UnimplementedIAMServiceServer) CheckRelation(context.Context, *CheckRelationRequest) (*CheckRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRelation not implemented")
+}
+func (UnimplementedIAMServiceServer) ExpandRelation(context.Context, *ExpandRelationRequest) (*ExpandRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandRelation not implemented")
+}
+func (UnimplementedIAMServiceServer) ListRelationObjects(context.Context, *ListRelationObjectsRequest) (*ListRelationObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelationObjects not implemented")
+}
+func (UnimplementedIAMServiceServer) mustEmbedUnimplementedIAMServiceServer() {}
+
+// UnsafeIAMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IAMServiceServer will
+// result in compilation errors.
+type UnsafeIAMServiceServer interface {
+	mustEmbedUnimplementedIAMServiceServer()
+}
+
+func RegisterIAMServiceServer(s grpc.ServiceRegistrar, srv IAMServiceServer) {
+	s.RegisterService(&IAMService_ServiceDesc, srv)
+}
+
+func _IAMService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).VerifyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/VerifyToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).VerifyToken(ctx, req.(*VerifyTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/AssignRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).AssignRole(ctx, req.(*AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RemoveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RemoveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/RemoveRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RemoveRole(ctx, req.(*RemoveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetEffectivePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetEffectivePermissions(ctx, req.(*GetEffectivePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CheckPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_AddRoleParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).AddRoleParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/AddRoleParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).AddRoleParent(ctx, req.(*AddRoleParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RemoveRoleParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RemoveRoleParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/RemoveRoleParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RemoveRoleParent(ctx, req.(*RemoveRoleParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetRoleHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetRoleHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetRoleHierarchy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetRoleHierarchy(ctx, req.(*GetRoleHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreatePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreatePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeletePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeletePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CheckAPIAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAPIAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CheckAPIAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CheckAPIAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CheckAPIAccess(ctx, req.(*CheckAPIAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CheckCMSAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCMSAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CheckCMSAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CheckCMSAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CheckCMSAccess(ctx, req.(*CheckCMSAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_EnforcePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnforcePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).EnforcePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/EnforcePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).EnforcePolicy(ctx, req.(*EnforcePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_BatchCheckAPIAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckAPIAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).BatchCheckAPIAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/BatchCheckAPIAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).BatchCheckAPIAccess(ctx, req.(*BatchCheckAPIAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListAPIAccessHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIAccessHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListAPIAccessHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListAPIAccessHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListAPIAccessHolders(ctx, req.(*ListAPIAccessHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListCMSAccessHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCMSAccessHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListCMSAccessHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListCMSAccessHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListCMSAccessHolders(ctx, req.(*ListCMSAccessHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_BatchEnforcePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEnforcePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).BatchEnforcePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/BatchEnforcePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).BatchEnforcePolicy(ctx, req.(*BatchEnforcePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreatePolicy(ctx, req.(*CreatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeletePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ExportPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ExportPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ExportPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ExportPolicies(ctx, req.(*ExportPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ImportPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ImportPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ImportPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ImportPolicies(ctx, req.(*ImportPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_SimulatePolicyChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePolicyChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).SimulatePolicyChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/SimulatePolicyChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).SimulatePolicyChanges(ctx, req.(*SimulatePolicyChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_SetPolicyCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPolicyConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).SetPolicyCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/SetPolicyCondition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).SetPolicyCondition(ctx, req.(*SetPolicyConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeletePolicyCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeletePolicyCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeletePolicyCondition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeletePolicyCondition(ctx, req.(*DeletePolicyConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListPolicyConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListPolicyConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListPolicyConditions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListPolicyConditions(ctx, req.(*ListPolicyConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreateCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateCMSRole(ctx, req.(*CreateCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_AssignCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).AssignCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/AssignCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).AssignCMSRole(ctx, req.(*AssignCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_RemoveCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).RemoveCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/RemoveCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).RemoveCMSRole(ctx, req.(*RemoveCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetUserCMSTabs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCMSTabsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetUserCMSTabs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetUserCMSTabs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetUserCMSTabs(ctx, req.(*GetUserCMSTabsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetCMSCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCMSCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetCMSCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetCMSCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetCMSCapabilities(ctx, req.(*GetCMSCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListCMSRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCMSRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListCMSRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListCMSRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListCMSRoles(ctx, req.(*ListCMSRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetCMSRole(ctx, req.(*GetCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_UpdateCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).UpdateCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/UpdateCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).UpdateCMSRole(ctx, req.(*UpdateCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCMSRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteCMSRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeleteCMSRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteCMSRole(ctx, req.(*DeleteCMSRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_SetCMSRoleFieldPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCMSRoleFieldPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).SetCMSRoleFieldPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/SetCMSRoleFieldPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).SetCMSRoleFieldPermission(ctx, req.(*SetCMSRoleFieldPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListCMSRoleFieldPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCMSRoleFieldPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListCMSRoleFieldPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListCMSRoleFieldPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListCMSRoleFieldPermissions(ctx, req.(*ListCMSRoleFieldPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteCMSRoleFieldPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCMSRoleFieldPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteCMSRoleFieldPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeleteCMSRoleFieldPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteCMSRoleFieldPermission(ctx, req.(*DeleteCMSRoleFieldPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetUserFieldPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFieldPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetUserFieldPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetUserFieldPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetUserFieldPermissions(ctx, req.(*GetUserFieldPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateCMSTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCMSTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateCMSTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreateCMSTab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateCMSTab(ctx, req.(*CreateCMSTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_GetCMSTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCMSTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).GetCMSTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/GetCMSTab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).GetCMSTab(ctx, req.(*GetCMSTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListCMSTabs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCMSTabsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListCMSTabs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListCMSTabs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListCMSTabs(ctx, req.(*ListCMSTabsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_UpdateCMSTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCMSTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).UpdateCMSTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/UpdateCMSTab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).UpdateCMSTab(ctx, req.(*UpdateCMSTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteCMSTab_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCMSTabRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteCMSTab(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeleteCMSTab",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteCMSTab(ctx, req.(*DeleteCMSTabRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateResourceScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateResourceScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreateResourceScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateResourceScope(ctx, req.(*CreateResourceScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListResourceScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListResourceScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListResourceScopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListResourceScopes(ctx, req.(*ListResourceScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_DeleteResourceScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).DeleteResourceScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/DeleteResourceScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).DeleteResourceScope(ctx, req.(*DeleteResourceScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListUserScopedCMSRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserScopedCMSRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListUserScopedCMSRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListUserScopedCMSRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListUserScopedCMSRoles(ctx, req.(*ListUserScopedCMSRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateAPIResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).CreateAPIResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/CreateAPIResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).CreateAPIResource(ctx, req.(*CreateAPIResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_ListAPIResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMServiceServer).ListAPIResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iam.IAMService/ListAPIResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMServiceServer).ListAPIResources(ctx, req.(*ListAPIResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).Register(ctx, in)
+		return srv.(IAMServiceServer).CreateAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/Register",
+		FullMethod: "/iam.IAMService/CreateAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(IAMServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _IAMService_GetAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).Login(ctx, in)
+		return srv.(IAMServiceServer).GetAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/Login",
+		FullMethod: "/iam.IAMService/GetAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(IAMServiceServer).GetAccessRequest(ctx, req.(*GetAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _IAMService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).RefreshToken(ctx, in)
+		return srv.(IAMServiceServer).ListAccessRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/RefreshToken",
+		FullMethod: "/iam.IAMService/ListAccessRequests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(IAMServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _IAMService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).Logout(ctx, in)
+		return srv.(IAMServiceServer).ApproveAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/Logout",
+		FullMethod: "/iam.IAMService/ApproveAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).Logout(ctx, req.(*LogoutRequest))
+		return srv.(IAMServiceServer).ApproveAccessRequest(ctx, req.(*DecideAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyTokenRequest)
+func _IAMService_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).VerifyToken(ctx, in)
+		return srv.(IAMServiceServer).DenyAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/VerifyToken",
+		FullMethod: "/iam.IAMService/DenyAccessRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).VerifyToken(ctx, req.(*VerifyTokenRequest))
+		return srv.(IAMServiceServer).DenyAccessRequest(ctx, req.(*DecideAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignRoleRequest)
+func _IAMService_SetAccessRequestApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccessRequestApproversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).AssignRole(ctx, in)
+		return srv.(IAMServiceServer).SetAccessRequestApprovers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/AssignRole",
+		FullMethod: "/iam.IAMService/SetAccessRequestApprovers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).AssignRole(ctx, req.(*AssignRoleRequest))
+		return srv.(IAMServiceServer).SetAccessRequestApprovers(ctx, req.(*SetAccessRequestApproversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_RemoveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRoleRequest)
+func _IAMService_ListAccessRequestApprovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestApproversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).RemoveRole(ctx, in)
+		return srv.(IAMServiceServer).ListAccessRequestApprovers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/RemoveRole",
+		FullMethod: "/iam.IAMService/ListAccessRequestApprovers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).RemoveRole(ctx, req.(*RemoveRoleRequest))
+		return srv.(IAMServiceServer).ListAccessRequestApprovers(ctx, req.(*ListAccessRequestApproversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRolesRequest)
+func _IAMService_CreateSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSoDConstraintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).GetUserRoles(ctx, in)
+		return srv.(IAMServiceServer).CreateSoDConstraint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/GetUserRoles",
+		FullMethod: "/iam.IAMService/CreateSoDConstraint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+		return srv.(IAMServiceServer).CreateSoDConstraint(ctx, req.(*CreateSoDConstraintRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckPermissionRequest)
+func _IAMService_GetSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSoDConstraintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CheckPermission(ctx, in)
+		return srv.(IAMServiceServer).GetSoDConstraint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CheckPermission",
+		FullMethod: "/iam.IAMService/GetSoDConstraint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+		return srv.(IAMServiceServer).GetSoDConstraint(ctx, req.(*GetSoDConstraintRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoleRequest)
+func _IAMService_ListSoDConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDConstraintsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CreateRole(ctx, in)
+		return srv.(IAMServiceServer).ListSoDConstraints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CreateRole",
+		FullMethod: "/iam.IAMService/ListSoDConstraints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+		return srv.(IAMServiceServer).ListSoDConstraints(ctx, req.(*ListSoDConstraintsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoleRequest)
+func _IAMService_DeleteSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSoDConstraintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).UpdateRole(ctx, in)
+		return srv.(IAMServiceServer).DeleteSoDConstraint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/UpdateRole",
+		FullMethod: "/iam.IAMService/DeleteSoDConstraint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+		return srv.(IAMServiceServer).DeleteSoDConstraint(ctx, req.(*DeleteSoDConstraintRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRoleRequest)
+func _IAMService_ListSoDViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDViolationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).DeleteRole(ctx, in)
+		return srv.(IAMServiceServer).ListSoDViolations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/DeleteRole",
+		FullMethod: "/iam.IAMService/ListSoDViolations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+		return srv.(IAMServiceServer).ListSoDViolations(ctx, req.(*ListSoDViolationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoleRequest)
+func _IAMService_CreateAccessReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).GetRole(ctx, in)
+		return srv.(IAMServiceServer).CreateAccessReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/GetRole",
+		FullMethod: "/iam.IAMService/CreateAccessReviewCampaign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+		return srv.(IAMServiceServer).CreateAccessReviewCampaign(ctx, req.(*CreateAccessReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRolesRequest)
+func _IAMService_GetAccessReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).ListRoles(ctx, in)
+		return srv.(IAMServiceServer).GetAccessReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/ListRoles",
+		FullMethod: "/iam.IAMService/GetAccessReviewCampaign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+		return srv.(IAMServiceServer).GetAccessReviewCampaign(ctx, req.(*GetAccessReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePermissionRequest)
+func _IAMService_ListAccessReviewCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessReviewCampaignsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CreatePermission(ctx, in)
+		return srv.(IAMServiceServer).ListAccessReviewCampaigns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CreatePermission",
+		FullMethod: "/iam.IAMService/ListAccessReviewCampaigns",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CreatePermission(ctx, req.(*CreatePermissionRequest))
+		return srv.(IAMServiceServer).ListAccessReviewCampaigns(ctx, req.(*ListAccessReviewCampaignsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_DeletePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePermissionRequest)
+func _IAMService_ListAccessReviewItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessReviewItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).DeletePermission(ctx, in)
+		return srv.(IAMServiceServer).ListAccessReviewItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/DeletePermission",
+		FullMethod: "/iam.IAMService/ListAccessReviewItems",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).DeletePermission(ctx, req.(*DeletePermissionRequest))
+		return srv.(IAMServiceServer).ListAccessReviewItems(ctx, req.(*ListAccessReviewItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPermissionsRequest)
+func _IAMService_DecideAccessReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAccessReviewItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).ListPermissions(ctx, in)
+		return srv.(IAMServiceServer).DecideAccessReviewItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/ListPermissions",
+		FullMethod: "/iam.IAMService/DecideAccessReviewItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+		return srv.(IAMServiceServer).DecideAccessReviewItem(ctx, req.(*DecideAccessReviewItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CheckAPIAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckAPIAccessRequest)
+func _IAMService_CloseAccessReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccessReviewCampaignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CheckAPIAccess(ctx, in)
+		return srv.(IAMServiceServer).CloseAccessReviewCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CheckAPIAccess",
+		FullMethod: "/iam.IAMService/CloseAccessReviewCampaign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CheckAPIAccess(ctx, req.(*CheckAPIAccessRequest))
+		return srv.(IAMServiceServer).CloseAccessReviewCampaign(ctx, req.(*CloseAccessReviewCampaignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CheckCMSAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCMSAccessRequest)
+func _IAMService_ExportAccessReviewReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccessReviewReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CheckCMSAccess(ctx, in)
+		return srv.(IAMServiceServer).ExportAccessReviewReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CheckCMSAccess",
+		FullMethod: "/iam.IAMService/ExportAccessReviewReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CheckCMSAccess(ctx, req.(*CheckCMSAccessRequest))
+		return srv.(IAMServiceServer).ExportAccessReviewReport(ctx, req.(*ExportAccessReviewReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_EnforcePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnforcePolicyRequest)
+func _IAMService_PutRelationNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRelationNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).EnforcePolicy(ctx, in)
+		return srv.(IAMServiceServer).PutRelationNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/EnforcePolicy",
+		FullMethod: "/iam.IAMService/PutRelationNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).EnforcePolicy(ctx, req.(*EnforcePolicyRequest))
+		return srv.(IAMServiceServer).PutRelationNamespace(ctx, req.(*PutRelationNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CreateCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCMSRoleRequest)
+func _IAMService_ListRelationNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationNamespacesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CreateCMSRole(ctx, in)
+		return srv.(IAMServiceServer).ListRelationNamespaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CreateCMSRole",
+		FullMethod: "/iam.IAMService/ListRelationNamespaces",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CreateCMSRole(ctx, req.(*CreateCMSRoleRequest))
+		return srv.(IAMServiceServer).ListRelationNamespaces(ctx, req.(*ListRelationNamespacesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_AssignCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignCMSRoleRequest)
+func _IAMService_DeleteRelationNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).AssignCMSRole(ctx, in)
+		return srv.(IAMServiceServer).DeleteRelationNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/AssignCMSRole",
+		FullMethod: "/iam.IAMService/DeleteRelationNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).AssignCMSRole(ctx, req.(*AssignCMSRoleRequest))
+		return srv.(IAMServiceServer).DeleteRelationNamespace(ctx, req.(*DeleteRelationNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_RemoveCMSRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveCMSRoleRequest)
+func _IAMService_WriteRelationTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRelationTuplesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).RemoveCMSRole(ctx, in)
+		return srv.(IAMServiceServer).WriteRelationTuples(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/RemoveCMSRole",
+		FullMethod: "/iam.IAMService/WriteRelationTuples",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).RemoveCMSRole(ctx, req.(*RemoveCMSRoleRequest))
+		return srv.(IAMServiceServer).WriteRelationTuples(ctx, req.(*WriteRelationTuplesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_GetUserCMSTabs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCMSTabsRequest)
+func _IAMService_ReadRelationTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRelationTuplesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).GetUserCMSTabs(ctx, in)
+		return srv.(IAMServiceServer).ReadRelationTuples(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/GetUserCMSTabs",
+		FullMethod: "/iam.IAMService/ReadRelationTuples",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).GetUserCMSTabs(ctx, req.(*GetUserCMSTabsRequest))
+		return srv.(IAMServiceServer).ReadRelationTuples(ctx, req.(*ReadRelationTuplesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_ListCMSRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCMSRolesRequest)
+func _IAMService_CheckRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).ListCMSRoles(ctx, in)
+		return srv.(IAMServiceServer).CheckRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/ListCMSRoles",
+		FullMethod: "/iam.IAMService/CheckRelation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).ListCMSRoles(ctx, req.(*ListCMSRolesRequest))
+		return srv.(IAMServiceServer).CheckRelation(ctx, req.(*CheckRelationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_CreateAPIResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAPIResourceRequest)
+func _IAMService_ExpandRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).CreateAPIResource(ctx, in)
+		return srv.(IAMServiceServer).ExpandRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/CreateAPIResource",
+		FullMethod: "/iam.IAMService/ExpandRelation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).CreateAPIResource(ctx, req.(*CreateAPIResourceRequest))
+		return srv.(IAMServiceServer).ExpandRelation(ctx, req.(*ExpandRelationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IAMService_ListAPIResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAPIResourcesRequest)
+func _IAMService_ListRelationObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationObjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IAMServiceServer).ListAPIResources(ctx, in)
+		return srv.(IAMServiceServer).ListRelationObjects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/iam.IAMService/ListAPIResources",
+		FullMethod: "/iam.IAMService/ListRelationObjects",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IAMServiceServer).ListAPIResources(ctx, req.(*ListAPIResourcesRequest))
+		return srv.(IAMServiceServer).ListRelationObjects(ctx, req.(*ListRelationObjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -973,6 +2949,10 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserRoles",
 			Handler:    _IAMService_GetUserRoles_Handler,
 		},
+		{
+			MethodName: "GetEffectivePermissions",
+			Handler:    _IAMService_GetEffectivePermissions_Handler,
+		},
 		{
 			MethodName: "CheckPermission",
 			Handler:    _IAMService_CheckPermission_Handler,
@@ -993,6 +2973,18 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRole",
 			Handler:    _IAMService_GetRole_Handler,
 		},
+		{
+			MethodName: "AddRoleParent",
+			Handler:    _IAMService_AddRoleParent_Handler,
+		},
+		{
+			MethodName: "RemoveRoleParent",
+			Handler:    _IAMService_RemoveRoleParent_Handler,
+		},
+		{
+			MethodName: "GetRoleHierarchy",
+			Handler:    _IAMService_GetRoleHierarchy_Handler,
+		},
 		{
 			MethodName: "ListRoles",
 			Handler:    _IAMService_ListRoles_Handler,
@@ -1021,6 +3013,58 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnforcePolicy",
 			Handler:    _IAMService_EnforcePolicy_Handler,
 		},
+		{
+			MethodName: "BatchCheckAPIAccess",
+			Handler:    _IAMService_BatchCheckAPIAccess_Handler,
+		},
+		{
+			MethodName: "ListAPIAccessHolders",
+			Handler:    _IAMService_ListAPIAccessHolders_Handler,
+		},
+		{
+			MethodName: "ListCMSAccessHolders",
+			Handler:    _IAMService_ListCMSAccessHolders_Handler,
+		},
+		{
+			MethodName: "BatchEnforcePolicy",
+			Handler:    _IAMService_BatchEnforcePolicy_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _IAMService_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _IAMService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _IAMService_ListPolicies_Handler,
+		},
+		{
+			MethodName: "ExportPolicies",
+			Handler:    _IAMService_ExportPolicies_Handler,
+		},
+		{
+			MethodName: "ImportPolicies",
+			Handler:    _IAMService_ImportPolicies_Handler,
+		},
+		{
+			MethodName: "SimulatePolicyChanges",
+			Handler:    _IAMService_SimulatePolicyChanges_Handler,
+		},
+		{
+			MethodName: "SetPolicyCondition",
+			Handler:    _IAMService_SetPolicyCondition_Handler,
+		},
+		{
+			MethodName: "DeletePolicyCondition",
+			Handler:    _IAMService_DeletePolicyCondition_Handler,
+		},
+		{
+			MethodName: "ListPolicyConditions",
+			Handler:    _IAMService_ListPolicyConditions_Handler,
+		},
 		{
 			MethodName: "CreateCMSRole",
 			Handler:    _IAMService_CreateCMSRole_Handler,
@@ -1037,10 +3081,78 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserCMSTabs",
 			Handler:    _IAMService_GetUserCMSTabs_Handler,
 		},
+		{
+			MethodName: "GetCMSCapabilities",
+			Handler:    _IAMService_GetCMSCapabilities_Handler,
+		},
 		{
 			MethodName: "ListCMSRoles",
 			Handler:    _IAMService_ListCMSRoles_Handler,
 		},
+		{
+			MethodName: "GetCMSRole",
+			Handler:    _IAMService_GetCMSRole_Handler,
+		},
+		{
+			MethodName: "UpdateCMSRole",
+			Handler:    _IAMService_UpdateCMSRole_Handler,
+		},
+		{
+			MethodName: "DeleteCMSRole",
+			Handler:    _IAMService_DeleteCMSRole_Handler,
+		},
+		{
+			MethodName: "SetCMSRoleFieldPermission",
+			Handler:    _IAMService_SetCMSRoleFieldPermission_Handler,
+		},
+		{
+			MethodName: "ListCMSRoleFieldPermissions",
+			Handler:    _IAMService_ListCMSRoleFieldPermissions_Handler,
+		},
+		{
+			MethodName: "DeleteCMSRoleFieldPermission",
+			Handler:    _IAMService_DeleteCMSRoleFieldPermission_Handler,
+		},
+		{
+			MethodName: "GetUserFieldPermissions",
+			Handler:    _IAMService_GetUserFieldPermissions_Handler,
+		},
+		{
+			MethodName: "CreateCMSTab",
+			Handler:    _IAMService_CreateCMSTab_Handler,
+		},
+		{
+			MethodName: "GetCMSTab",
+			Handler:    _IAMService_GetCMSTab_Handler,
+		},
+		{
+			MethodName: "ListCMSTabs",
+			Handler:    _IAMService_ListCMSTabs_Handler,
+		},
+		{
+			MethodName: "UpdateCMSTab",
+			Handler:    _IAMService_UpdateCMSTab_Handler,
+		},
+		{
+			MethodName: "DeleteCMSTab",
+			Handler:    _IAMService_DeleteCMSTab_Handler,
+		},
+		{
+			MethodName: "CreateResourceScope",
+			Handler:    _IAMService_CreateResourceScope_Handler,
+		},
+		{
+			MethodName: "ListResourceScopes",
+			Handler:    _IAMService_ListResourceScopes_Handler,
+		},
+		{
+			MethodName: "DeleteResourceScope",
+			Handler:    _IAMService_DeleteResourceScope_Handler,
+		},
+		{
+			MethodName: "ListUserScopedCMSRoles",
+			Handler:    _IAMService_ListUserScopedCMSRoles_Handler,
+		},
 		{
 			MethodName: "CreateAPIResource",
 			Handler:    _IAMService_CreateAPIResource_Handler,
@@ -1049,6 +3161,114 @@ var IAMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAPIResources",
 			Handler:    _IAMService_ListAPIResources_Handler,
 		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _IAMService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "GetAccessRequest",
+			Handler:    _IAMService_GetAccessRequest_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _IAMService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _IAMService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyAccessRequest",
+			Handler:    _IAMService_DenyAccessRequest_Handler,
+		},
+		{
+			MethodName: "SetAccessRequestApprovers",
+			Handler:    _IAMService_SetAccessRequestApprovers_Handler,
+		},
+		{
+			MethodName: "ListAccessRequestApprovers",
+			Handler:    _IAMService_ListAccessRequestApprovers_Handler,
+		},
+		{
+			MethodName: "CreateSoDConstraint",
+			Handler:    _IAMService_CreateSoDConstraint_Handler,
+		},
+		{
+			MethodName: "GetSoDConstraint",
+			Handler:    _IAMService_GetSoDConstraint_Handler,
+		},
+		{
+			MethodName: "ListSoDConstraints",
+			Handler:    _IAMService_ListSoDConstraints_Handler,
+		},
+		{
+			MethodName: "DeleteSoDConstraint",
+			Handler:    _IAMService_DeleteSoDConstraint_Handler,
+		},
+		{
+			MethodName: "ListSoDViolations",
+			Handler:    _IAMService_ListSoDViolations_Handler,
+		},
+		{
+			MethodName: "CreateAccessReviewCampaign",
+			Handler:    _IAMService_CreateAccessReviewCampaign_Handler,
+		},
+		{
+			MethodName: "GetAccessReviewCampaign",
+			Handler:    _IAMService_GetAccessReviewCampaign_Handler,
+		},
+		{
+			MethodName: "ListAccessReviewCampaigns",
+			Handler:    _IAMService_ListAccessReviewCampaigns_Handler,
+		},
+		{
+			MethodName: "ListAccessReviewItems",
+			Handler:    _IAMService_ListAccessReviewItems_Handler,
+		},
+		{
+			MethodName: "DecideAccessReviewItem",
+			Handler:    _IAMService_DecideAccessReviewItem_Handler,
+		},
+		{
+			MethodName: "CloseAccessReviewCampaign",
+			Handler:    _IAMService_CloseAccessReviewCampaign_Handler,
+		},
+		{
+			MethodName: "ExportAccessReviewReport",
+			Handler:    _IAMService_ExportAccessReviewReport_Handler,
+		},
+		{
+			MethodName: "PutRelationNamespace",
+			Handler:    _IAMService_PutRelationNamespace_Handler,
+		},
+		{
+			MethodName: "ListRelationNamespaces",
+			Handler:    _IAMService_ListRelationNamespaces_Handler,
+		},
+		{
+			MethodName: "DeleteRelationNamespace",
+			Handler:    _IAMService_DeleteRelationNamespace_Handler,
+		},
+		{
+			MethodName: "WriteRelationTuples",
+			Handler:    _IAMService_WriteRelationTuples_Handler,
+		},
+		{
+			MethodName: "ReadRelationTuples",
+			Handler:    _IAMService_ReadRelationTuples_Handler,
+		},
+		{
+			MethodName: "CheckRelation",
+			Handler:    _IAMService_CheckRelation_Handler,
+		},
+		{
+			MethodName: "ExpandRelation",
+			Handler:    _IAMService_ExpandRelation_Handler,
+		},
+		{
+			MethodName: "ListRelationObjects",
+			Handler:    _IAMService_ListRelationObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/iam.proto",